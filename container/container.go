@@ -2,18 +2,34 @@ package container
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/config"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/aggregate"
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/repository"
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/bus"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/clock"
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/database/client"
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/database/eventstore"
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/database/eventstore/deserializer"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/database/readmodel"
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/database/transaction"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/database/webhooks"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/metrics"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/projector/tags"
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/projector/todo"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/projector/userlists"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/scheduler"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/webhook"
 	commandUseCase "github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/commandbus"
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/gateway"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/idempotencystore"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/processstore"
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/readmodelstore"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/schedulestore"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/webhookstore"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/processmanager"
 	queryUseCase "github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/query"
 )
 
@@ -25,16 +41,75 @@ type Container struct {
 	Transaction  repository.Transaction
 	EventStore   repository.EventStore
 	Deserializer repository.EventDeserializer
+	TodoLists    repository.AggregateRepository[*aggregate.TodoListAggregate]
 
 	// Gateway implementation
+	Clock         gateway.Clock
 	EventBus      gateway.EventBus
+	EventStream   *bus.EventStream
 	TodoProjector gateway.Projector
 	TodoViewRepo  readmodelstore.TodoListStore
 
+	UserTodoListsProjector gateway.Projector
+	UserTodoListStore      readmodelstore.UserTodoListStore
+
+	TagIndexProjector gateway.Projector
+	TagIndexStore     readmodelstore.TagIndexStore
+
+	// Webhooks
+	WebhookSubscriptions webhookstore.WebhookSubscriptionStore
+	WebhookDeliveries    webhookstore.WebhookDeliveryLog
+	WebhookDispatcher    gateway.WebhookDispatcher
+
+	// Command bus
+	CommandMetrics   *metrics.CommandMetricsImpl
+	IdempotencyStore idempotencystore.IdempotencyStore
+	CommandBus       *commandbus.Bus
+
+	// Schedulers
+	OverdueScheduler *scheduler.OverdueScheduler
+	WakeUpScheduler  *scheduler.WakeUpScheduler
+	ProcessStore     processstore.ProcessStore
+	ProcessManager   *processmanager.Manager
+
+	// Scheduled commands
+	ScheduledCommands         schedulestore.ScheduledCommandStore
+	ScheduledCommandRunner    commandUseCase.ScheduledCommandRunnerInterface
+	ScheduledCommandScheduler *scheduler.ScheduledCommandScheduler
+
 	// Use case layer (CQRS)
-	TodoListCreateCommand commandUseCase.TodoListCreateCommandInterface
-	TodoAddItemCommand    commandUseCase.TodoAddItemCommandInterface
-	QueryUseCase          queryUseCase.TodoListQueryInterface
+	TodoListCreateCommand                 commandUseCase.TodoListCreateCommandInterface
+	TodoListRenameCommand                 commandUseCase.TodoListRenameCommandInterface
+	TodoListArchiveCommand                commandUseCase.TodoListArchiveCommandInterface
+	TodoListRestoreCommand                commandUseCase.TodoListRestoreCommandInterface
+	TodoListDeleteCommand                 commandUseCase.TodoListDeleteCommandInterface
+	TodoListUndoCommand                   commandUseCase.TodoListUndoCommandInterface
+	TodoAddItemCommand                    commandUseCase.TodoAddItemCommandInterface
+	TodoBatchCommand                      commandUseCase.TodoBatchCommandInterface
+	TodoSetDueDateCommand                 commandUseCase.TodoSetDueDateCommandInterface
+	TodoMarkOverdueCommand                commandUseCase.TodoMarkOverdueCommandInterface
+	TodoSetPriorityCommand                commandUseCase.TodoSetPriorityCommandInterface
+	TodoReorderItemsCommand               commandUseCase.TodoReorderItemsCommandInterface
+	TodoMoveItemCommand                   commandUseCase.TodoMoveItemCommandInterface
+	TodoTagCommand                        commandUseCase.TodoTagCommandInterface
+	TodoUntagCommand                      commandUseCase.TodoUntagCommandInterface
+	TodoSetCompletionCommand              commandUseCase.TodoSetCompletionCommandInterface
+	TodoAddChecklistItemCommand           commandUseCase.TodoAddChecklistItemCommandInterface
+	TodoCompleteChecklistItemCommand      commandUseCase.TodoCompleteChecklistItemCommandInterface
+	TodoRemoveChecklistItemCommand        commandUseCase.TodoRemoveChecklistItemCommandInterface
+	TodoScheduleRecurringCommand          commandUseCase.TodoScheduleRecurringCommandInterface
+	TodoCancelRecurringCommand            commandUseCase.TodoCancelRecurringCommandInterface
+	TodoListInviteCollaboratorCommand     commandUseCase.TodoListInviteCollaboratorCommandInterface
+	TodoListChangeCollaboratorRoleCommand commandUseCase.TodoListChangeCollaboratorRoleCommandInterface
+	TodoListRemoveCollaboratorCommand     commandUseCase.TodoListRemoveCollaboratorCommandInterface
+	WebhookSubscribe                      commandUseCase.WebhookSubscribeCommandInterface
+	ScheduledCommandCreateCommand         commandUseCase.ScheduledCommandCreateCommandInterface
+	ScheduledCommandCancelCommand         commandUseCase.ScheduledCommandCancelCommandInterface
+	QueryUseCase                          queryUseCase.TodoListQueryInterface
+	UserTodoListsQuery                    queryUseCase.UserTodoListsQueryInterface
+	UserTagsQuery                         queryUseCase.UserTagsQueryInterface
+	ScheduledCommandsQuery                queryUseCase.ScheduledCommandsQueryInterface
+	TodoListHistoryQuery                  queryUseCase.TodoListHistoryQueryInterface
 }
 
 func NewContainer() *Container {
@@ -53,21 +128,112 @@ func (c *Container) Inject(ctx context.Context, cfg *config.Config) error {
 	c.Transaction = transaction.NewTransaction(databaseClient.GetDB())
 	c.Deserializer = deserializer.NewEventDeserializer()
 	c.EventStore = eventstore.NewEventStore(c.Deserializer)
+	// Todo lists are not snapshotted: undo rebuilds earlier states of a list
+	// from its full history.
+	c.TodoLists = eventstore.NewAggregateRepository(c.EventStore, aggregate.NewTodoListAggregate, nil)
+
+	c.Clock = clock.NewSystemClock()
 
 	// Event Bus and Projector
 	c.EventBus = bus.NewInMemoryEventBus()
+	c.EventStream = bus.NewEventStream()
 	viewRepo := todo.NewInMemoryTodoListViewRepository()
 	c.TodoViewRepo = viewRepo
 	c.TodoProjector = todo.NewTodoProjector(viewRepo)
 
+	switch cfg.ReadModelConfig.Store {
+	case config.ReadModelStoreMemory:
+		c.UserTodoListStore = userlists.NewInMemoryUserTodoListRepository()
+	case config.ReadModelStoreMySQL:
+		c.UserTodoListStore = readmodel.NewUserTodoListStore(databaseClient.GetDB())
+	default:
+		return fmt.Errorf("unknown read model store: %s", cfg.ReadModelConfig.Store)
+	}
+	c.UserTodoListsProjector = userlists.NewUserTodoListsProjector(c.UserTodoListStore)
+
+	c.TagIndexStore = tags.NewInMemoryTagIndexRepository()
+	c.TagIndexProjector = tags.NewTagIndexProjector(c.TagIndexStore)
+
+	// Webhooks
+	c.WebhookSubscriptions = webhooks.NewSubscriptionStore(databaseClient.GetDB())
+	c.WebhookDeliveries = webhooks.NewDeliveryLog(databaseClient.GetDB())
+	c.WebhookDispatcher = webhook.NewWebhookDispatcher(c.WebhookSubscriptions, c.WebhookDeliveries, c.TodoViewRepo, cfg.WebhookConfig)
+
+	// Command bus
+	c.CommandMetrics = metrics.NewCommandMetrics()
+	c.IdempotencyStore = eventstore.NewIdempotencyStore(c.Deserializer)
+	c.CommandBus = commandbus.NewBus(
+		commandbus.Logging(),
+		commandbus.Metrics(c.CommandMetrics),
+		commandbus.Validation(),
+		commandbus.Retry(commandbus.RetryPolicy{
+			MaxAttempts: cfg.RetryMaxAttempts,
+			BaseBackoff: cfg.RetryBaseBackoff,
+			MaxBackoff:  cfg.RetryMaxBackoff,
+			Jitter:      cfg.RetryJitter,
+		}, c.Transaction, c.CommandMetrics),
+		commandbus.Transaction(c.Transaction, c.EventBus),
+		commandbus.Idempotency(c.IdempotencyStore, cfg.IdempotencyKeyTTL),
+	)
+	commandUseCase.RegisterTodoListHandlers(c.CommandBus, c.TodoLists)
+
 	// Use case layer (CQRS)
-	c.TodoListCreateCommand = commandUseCase.NewTodoListCreateCommand(c.Transaction, c.EventStore, c.EventBus)
-	c.TodoAddItemCommand = commandUseCase.NewTodoAddItemCommand(c.Transaction, c.EventStore, c.EventBus)
-	c.QueryUseCase = queryUseCase.NewTodoListQuery(c.TodoViewRepo)
+	c.TodoListCreateCommand = commandUseCase.NewTodoListCreateCommand(c.CommandBus)
+	c.TodoAddItemCommand = commandUseCase.NewTodoAddItemCommand(c.CommandBus)
+	c.TodoBatchCommand = commandUseCase.NewTodoBatchCommand(c.Transaction, c.TodoListCreateCommand, c.TodoAddItemCommand)
+	c.TodoSetDueDateCommand = commandUseCase.NewTodoSetDueDateCommand(c.CommandBus)
+	c.TodoSetPriorityCommand = commandUseCase.NewTodoSetPriorityCommand(c.CommandBus)
+	c.TodoReorderItemsCommand = commandUseCase.NewTodoReorderItemsCommand(c.CommandBus)
+	c.TodoMoveItemCommand = commandUseCase.NewTodoMoveItemCommand(c.CommandBus)
+	c.TodoTagCommand = commandUseCase.NewTodoTagCommand(c.CommandBus)
+	c.TodoUntagCommand = commandUseCase.NewTodoUntagCommand(c.CommandBus)
+	c.TodoSetCompletionCommand = commandUseCase.NewTodoSetCompletionCommand(c.CommandBus)
+	c.TodoAddChecklistItemCommand = commandUseCase.NewTodoAddChecklistItemCommand(c.CommandBus)
+	c.TodoCompleteChecklistItemCommand = commandUseCase.NewTodoCompleteChecklistItemCommand(c.CommandBus)
+	c.TodoRemoveChecklistItemCommand = commandUseCase.NewTodoRemoveChecklistItemCommand(c.CommandBus)
+	c.TodoScheduleRecurringCommand = commandUseCase.NewTodoScheduleRecurringCommand(c.CommandBus)
+	c.TodoCancelRecurringCommand = commandUseCase.NewTodoCancelRecurringCommand(c.CommandBus)
+	c.TodoMarkOverdueCommand = commandUseCase.NewTodoMarkOverdueCommand(c.CommandBus)
+	c.TodoListRenameCommand = commandUseCase.NewTodoListRenameCommand(c.CommandBus)
+	c.TodoListArchiveCommand = commandUseCase.NewTodoListArchiveCommand(c.CommandBus)
+	c.TodoListRestoreCommand = commandUseCase.NewTodoListRestoreCommand(c.CommandBus)
+	c.TodoListDeleteCommand = commandUseCase.NewTodoListDeleteCommand(c.CommandBus)
+	c.TodoListUndoCommand = commandUseCase.NewTodoListUndoCommand(c.CommandBus, cfg.UndoWindow)
+	c.TodoListInviteCollaboratorCommand = commandUseCase.NewTodoListInviteCollaboratorCommand(c.CommandBus)
+	c.TodoListChangeCollaboratorRoleCommand = commandUseCase.NewTodoListChangeCollaboratorRoleCommand(c.CommandBus)
+	c.TodoListRemoveCollaboratorCommand = commandUseCase.NewTodoListRemoveCollaboratorCommand(c.CommandBus)
+	c.WebhookSubscribe = commandUseCase.NewWebhookSubscribeCommand(c.WebhookSubscriptions, c.TodoViewRepo)
+	c.ScheduledCommands = eventstore.NewScheduledCommandStore()
+	c.ScheduledCommandCreateCommand = commandUseCase.NewScheduledCommandCreateCommand(c.ScheduledCommands, c.Transaction, c.Clock)
+	c.ScheduledCommandCancelCommand = commandUseCase.NewScheduledCommandCancelCommand(c.ScheduledCommands, c.Transaction, c.Clock)
+	c.QueryUseCase = queryUseCase.NewTodoListQuery(c.TodoViewRepo, cfg.MinVersionTimeout)
+	c.UserTodoListsQuery = queryUseCase.NewUserTodoListsQuery(c.UserTodoListStore)
+	c.UserTagsQuery = queryUseCase.NewUserTagsQuery(c.TagIndexStore)
+	c.ScheduledCommandsQuery = queryUseCase.NewScheduledCommandsQuery(c.ScheduledCommands, c.Transaction)
+	c.TodoListHistoryQuery = queryUseCase.NewTodoListHistoryQuery(c.TodoViewRepo, c.EventStore, c.Transaction)
+
+	// Schedulers
+	c.OverdueScheduler = scheduler.NewOverdueScheduler(viewRepo, c.TodoMarkOverdueCommand, cfg.SchedulerConfig)
+	c.ScheduledCommandRunner = commandUseCase.NewScheduledCommandRunner(c.ScheduledCommands, c.Transaction, c.Clock, c.TodoListCreateCommand, c.TodoAddItemCommand, cfg.ScheduledCommandMaxAttempts)
+	c.ScheduledCommandScheduler = scheduler.NewScheduledCommandScheduler(c.ScheduledCommandRunner, cfg.SchedulerConfig)
+
+	// Process managers
+	c.ProcessStore = eventstore.NewProcessStore()
+	c.injectProcessManager(cfg)
 
 	return nil
 }
 
+// injectProcessManager registers the workflows that react to events with
+// commands. It needs the process store and the use cases they issue commands
+// to.
+func (c *Container) injectProcessManager(cfg *config.Config) {
+	c.ProcessManager = processmanager.NewManager(c.ProcessStore, c.Transaction, c.EventStore, cfg.ProcessConfig.MaxAttempts,
+		processmanager.NewRecurringTodoProcess(c.TodoAddItemCommand, c.Clock),
+	)
+	c.WakeUpScheduler = scheduler.NewWakeUpScheduler(c.ProcessManager, cfg.SchedulerConfig)
+}
+
 func (c *Container) RestoreReadModels(ctx context.Context) error {
 	return c.Transaction.RWTx(ctx, func(txCtx context.Context) error {
 		events, err := c.EventStore.GetAllEvents(txCtx)
@@ -79,6 +245,12 @@ func (c *Container) RestoreReadModels(ctx context.Context) error {
 			if err := c.TodoProjector.Handle(ctx, event); err != nil {
 				return err
 			}
+			if err := c.UserTodoListsProjector.Handle(ctx, event); err != nil {
+				return err
+			}
+			if err := c.TagIndexProjector.Handle(ctx, event); err != nil {
+				return err
+			}
 		}
 
 		return nil
@@ -0,0 +1,233 @@
+package container
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/config"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/bus"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/database/eventstore"
+	commandUseCase "github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/commandbus"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/processstore/dto"
+)
+
+type memoryTxKey struct{}
+
+// memoryTransaction runs fn directly and its after-commit hooks once fn
+// succeeded, joining a transaction already in ctx.
+type memoryTransaction struct{}
+
+func (t *memoryTransaction) RWTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if t.InTx(ctx) {
+		return fn(ctx)
+	}
+
+	var hooks []func() error
+	if err := fn(context.WithValue(ctx, memoryTxKey{}, &hooks)); err != nil {
+		return err
+	}
+	for _, hook := range hooks {
+		if err := hook(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *memoryTransaction) AfterCommit(ctx context.Context, fn func() error) error {
+	hooks, ok := ctx.Value(memoryTxKey{}).(*[]func() error)
+	if !ok {
+		return errors.New("transaction not found in context")
+	}
+	*hooks = append(*hooks, fn)
+	return nil
+}
+
+func (t *memoryTransaction) InTx(ctx context.Context) bool {
+	return ctx.Value(memoryTxKey{}) != nil
+}
+
+type memoryEventStore struct {
+	mu     sync.Mutex
+	events []event.Event
+}
+
+func (s *memoryEventStore) SaveEvents(ctx context.Context, aggregateID uuid.UUID, events []event.Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, events...)
+	return nil
+}
+
+func (s *memoryEventStore) LoadEvents(ctx context.Context, aggregateID uuid.UUID) ([]event.Event, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var events []event.Event
+	for _, e := range s.events {
+		if e.GetAggregateID() == aggregateID {
+			events = append(events, e)
+		}
+	}
+	return events, nil
+}
+
+func (s *memoryEventStore) GetAllEvents(ctx context.Context) ([]event.Event, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]event.Event(nil), s.events...), nil
+}
+
+func (s *memoryEventStore) GetEventsAfter(ctx context.Context, position int64) ([]event.Event, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]event.Event(nil), s.events[min(position, int64(len(s.events))):]...), nil
+}
+
+func (s *memoryEventStore) LastPosition(ctx context.Context) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return int64(len(s.events)), nil
+}
+
+type memoryProcessStore struct {
+	mu          sync.Mutex
+	steps       map[string][]*dto.ProcessStepDTO
+	wakeUps     map[string]map[string]*dto.WakeUpDTO
+	checkpoints map[string]int64
+}
+
+func (s *memoryProcessStore) Load(ctx context.Context, process, correlationID string) ([]*dto.ProcessStepDTO, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.steps[process+"/"+correlationID], nil
+}
+
+func (s *memoryProcessStore) Append(ctx context.Context, step *dto.ProcessStepDTO) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := step.Process + "/" + step.CorrelationID
+	s.steps[key] = append(s.steps[key], step)
+	if s.wakeUps[key] == nil {
+		s.wakeUps[key] = map[string]*dto.WakeUpDTO{}
+	}
+	for _, name := range step.Cancel {
+		delete(s.wakeUps[key], name)
+	}
+	for _, wakeUp := range step.Schedule {
+		s.wakeUps[key][wakeUp.Name] = &wakeUp
+	}
+	if step.Done {
+		clear(s.wakeUps[key])
+	}
+	return nil
+}
+
+func (s *memoryProcessStore) DueWakeUps(ctx context.Context, now time.Time) ([]*dto.WakeUpDTO, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var due []*dto.WakeUpDTO
+	for _, wakeUps := range s.wakeUps {
+		for wakeUp := range maps.Values(wakeUps) {
+			if !wakeUp.At.After(now) {
+				due = append(due, wakeUp)
+			}
+		}
+	}
+	return due, nil
+}
+
+func (s *memoryProcessStore) Checkpoint(ctx context.Context, process string) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.checkpoints[process], nil
+}
+
+func (s *memoryProcessStore) SaveCheckpoint(ctx context.Context, process string, position int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.checkpoints == nil {
+		s.checkpoints = map[string]int64{}
+	}
+	s.checkpoints[process] = position
+	return nil
+}
+
+type fixedClock struct {
+	now time.Time
+}
+
+func (c *fixedClock) Now() time.Time {
+	return c.now
+}
+
+type capturingPresenter struct {
+	aggregateID string
+}
+
+func (p *capturingPresenter) PresentSuccess(ctx context.Context, aggregateID string, version int, events []event.Event) error {
+	p.aggregateID = aggregateID
+	return nil
+}
+
+func (p *capturingPresenter) PresentError(ctx context.Context, err error) error {
+	return err
+}
+
+func TestContainer_InjectProcessManager(t *testing.T) {
+	// Arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	clock := &fixedClock{now: time.Date(2025, 3, 31, 8, 0, 0, 0, time.UTC)}
+	c := &Container{
+		Transaction: &memoryTransaction{},
+		EventStore:  &memoryEventStore{},
+		Clock:       clock,
+		EventBus:    bus.NewInMemoryEventBus(),
+		ProcessStore: &memoryProcessStore{
+			steps:   map[string][]*dto.ProcessStepDTO{},
+			wakeUps: map[string]map[string]*dto.WakeUpDTO{},
+		},
+	}
+	c.TodoLists = eventstore.NewAggregateRepository(c.EventStore, aggregate.NewTodoListAggregate, nil)
+	c.CommandBus = commandbus.NewBus(commandbus.Transaction(c.Transaction, c.EventBus))
+	commandUseCase.RegisterTodoListHandlers(c.CommandBus, c.TodoLists)
+	c.TodoListCreateCommand = commandUseCase.NewTodoListCreateCommand(c.CommandBus)
+	c.TodoAddItemCommand = commandUseCase.NewTodoAddItemCommand(c.CommandBus)
+	c.TodoScheduleRecurringCommand = commandUseCase.NewTodoScheduleRecurringCommand(c.CommandBus)
+	c.injectProcessManager(&config.Config{ProcessConfig: config.ProcessConfig{MaxAttempts: 3}})
+	require.NoError(t, c.ProcessManager.Start(ctx, c.EventBus))
+
+	created := &capturingPresenter{}
+	require.NoError(t, c.TodoListCreateCommand.Execute(ctx, &input.CreateTodoListInput{UserID: "owner", Title: "Daily"}, created))
+	require.NoError(t, c.TodoScheduleRecurringCommand.Execute(ctx, &input.ScheduleRecurringTodoInput{
+		AggregateID: created.aggregateID,
+		UserID:      "owner",
+		Todo:        "Stand-up",
+		Rule:        "daily",
+		StartDate:   "2025-03-31",
+	}, &capturingPresenter{}))
+	c.ProcessManager.Drain(ctx)
+
+	// Act
+	now := time.Date(2025, 3, 31, 9, 0, 0, 0, time.UTC)
+	clock.now = now
+	require.NoError(t, c.ProcessManager.RunOnce(ctx, now))
+
+	// Assert
+	list, err := c.TodoLists.Load(ctx, uuid.MustParse(created.aggregateID))
+	require.NoError(t, err)
+	items := list.GetItems()
+	require.Len(t, items, 1)
+	require.Equal(t, "Stand-up", items[0].Text.String())
+	require.Equal(t, "2025-03-31", items[0].DueDate.String())
+}
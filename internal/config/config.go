@@ -1,12 +1,26 @@
 package config
 
 import (
+	"time"
+
 	"github.com/kelseyhightower/envconfig"
 )
 
 type Config struct {
 	HTTPPort string `required:"true" envconfig:"HTTP_PORT"`
+	// GRPCPort serves the gRPC API next to the HTTP one.
+	GRPCPort string `default:"9090" envconfig:"GRPC_PORT"`
+	// DebugAddr serves /debug/vars apart from the API. It is meant to be
+	// reachable only from the host or an internal network.
+	DebugAddr string `default:"127.0.0.1:6060" envconfig:"DEBUG_ADDR"`
 	DatabaseConfig
+	WebhookConfig
+	QueryConfig
+	CommandConfig
+	ReadModelConfig
+	AuthConfig
+	SchedulerConfig
+	ProcessConfig
 }
 
 func NewConfig() (*Config, error) {
@@ -26,6 +40,71 @@ type DatabaseConfig struct {
 	Name     string `required:"true" envconfig:"MYSQL_DATABASE"`
 }
 
+type WebhookConfig struct {
+	MaxAttempts  int           `default:"3" envconfig:"WEBHOOK_MAX_ATTEMPTS"`
+	RetryBackoff time.Duration `default:"500ms" envconfig:"WEBHOOK_RETRY_BACKOFF"`
+	Timeout      time.Duration `default:"5s" envconfig:"WEBHOOK_TIMEOUT"`
+	// AllowPrivateTargets lets deliveries connect to loopback, private and
+	// other non-public addresses. It is meant for local development only.
+	AllowPrivateTargets bool `default:"false" envconfig:"WEBHOOK_ALLOW_PRIVATE_TARGETS"`
+}
+
+type CommandConfig struct {
+	// UndoWindow is how long after a command it can still be undone.
+	UndoWindow time.Duration `default:"5m" envconfig:"UNDO_WINDOW"`
+	// IdempotencyKeyTTL is how long the result of a command sent with an
+	// Idempotency-Key header is kept for requests repeating it.
+	IdempotencyKeyTTL time.Duration `default:"24h" envconfig:"IDEMPOTENCY_KEY_TTL"`
+	// The retry policy for commands that lost an optimistic-lock race with
+	// another command on the same aggregate.
+	RetryMaxAttempts int           `default:"3" envconfig:"COMMAND_RETRY_MAX_ATTEMPTS"`
+	RetryBaseBackoff time.Duration `default:"10ms" envconfig:"COMMAND_RETRY_BASE_BACKOFF"`
+	RetryMaxBackoff  time.Duration `default:"200ms" envconfig:"COMMAND_RETRY_MAX_BACKOFF"`
+	RetryJitter      float64       `default:"0.5" envconfig:"COMMAND_RETRY_JITTER"`
+}
+
+type QueryConfig struct {
+	MinVersionTimeout time.Duration `default:"2s" envconfig:"QUERY_MIN_VERSION_TIMEOUT"`
+}
+
+const (
+	ReadModelStoreMemory = "memory"
+	ReadModelStoreMySQL  = "mysql"
+)
+
+type ReadModelConfig struct {
+	Store string `default:"memory" envconfig:"READ_MODEL_STORE"`
+}
+
+// AuthConfig lists the keys accepted for bearer tokens. At least one of the
+// HS256 secret, the RS256 public key file or the JWKS file must be set.
+type AuthConfig struct {
+	HS256Secret        string `envconfig:"JWT_HS256_SECRET"`
+	RS256PublicKeyFile string `envconfig:"JWT_RS256_PUBLIC_KEY_FILE"`
+	JWKSFile           string `envconfig:"JWT_JWKS_FILE"`
+	Issuer             string `envconfig:"JWT_ISSUER"`
+	Audience           string `envconfig:"JWT_AUDIENCE"`
+}
+
+type SchedulerConfig struct {
+	OverdueCheckInterval time.Duration `default:"1m" envconfig:"OVERDUE_CHECK_INTERVAL"`
+	// WakeUpCheckInterval is how often due process wake-ups are fired and
+	// failed process deliveries retried.
+	WakeUpCheckInterval time.Duration `default:"1m" envconfig:"WAKE_UP_CHECK_INTERVAL"`
+	// ScheduledCommandCheckInterval is how often due scheduled commands are
+	// run, and so how late after its run_at one may run.
+	ScheduledCommandCheckInterval time.Duration `default:"10s" envconfig:"SCHEDULED_COMMAND_CHECK_INTERVAL"`
+	// ScheduledCommandMaxAttempts is how often a scheduled command that failed
+	// for a transient reason is run before it is marked failed.
+	ScheduledCommandMaxAttempts int `default:"3" envconfig:"SCHEDULED_COMMAND_MAX_ATTEMPTS"`
+}
+
+type ProcessConfig struct {
+	// MaxAttempts is how often a process manager tries to deliver an event or
+	// a wake-up before it gives up on it.
+	MaxAttempts int `default:"5" envconfig:"PROCESS_MAX_ATTEMPTS"`
+}
+
 type TestDatabaseConfig struct {
 	User     string `required:"true" envconfig:"MYSQL_USER"`
 	Password string `required:"true" envconfig:"MYSQL_PASSWORD"`
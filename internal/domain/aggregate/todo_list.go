@@ -2,6 +2,7 @@ package aggregate
 
 import (
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
@@ -12,19 +13,57 @@ import (
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
 )
 
-var ErrTooManyTodos = errors.UnpermittedOp.New("cannot add more than 3 todos per day")
+var (
+	ErrTooManyTodos         = errors.UnpermittedOp.New("cannot add more than 3 todos per day")
+	ErrNotListOwner         = errors.Forbidden.New("only owners can manage this todo list")
+	ErrNotListEditor        = errors.Forbidden.New("only owners and editors can modify this todo list")
+	ErrCollaboratorIsOwner  = errors.InvalidParameter.New("the creator of the todo list cannot be managed as a collaborator")
+	ErrAlreadyCollaborator  = errors.InvalidParameter.New("user is already a collaborator")
+	ErrCollaboratorNotFound = errors.NotFound.New("collaborator not found")
+	ErrTodoListArchived     = errors.Archived.New("todo list is archived")
+	ErrTodoListNotArchived  = errors.InvalidParameter.New("todo list is not archived")
+	ErrTodoListDeleted      = errors.Deleted.New("todo list has been deleted")
+	ErrTodoNotFound         = errors.NotFound.New("todo not found")
+	ErrInvalidTodoOrder     = errors.InvalidParameter.New("order must list every todo on the list exactly once")
+	ErrTooManyTags          = errors.InvalidParameter.New("a todo cannot have more than 10 tags")
+	ErrTooManyChecklist     = errors.InvalidParameter.New("a todo cannot have more than 20 checklist entries")
+	ErrChecklistNotFound    = errors.NotFound.New("checklist entry not found")
+	ErrRecurringNotFound    = errors.NotFound.New("recurring todo not found")
+	ErrOccurrenceDueDate    = errors.InvalidParameter.New("an occurrence of a recurring todo needs a due date")
+	ErrMoveToSameList       = errors.InvalidParameter.New("a todo cannot be moved to the list it is on")
+	ErrNothingToUndo        = errors.InvalidParameter.New("there is no command to undo")
+	ErrUndoNotActor         = errors.Forbidden.New("only the user who issued the last command can undo it")
+	ErrUndoWindowExpired    = errors.InvalidParameter.New("the last command is too old to undo")
+)
+
+const (
+	maxTagsPerTodo      = 10
+	maxChecklistPerTodo = 20
+)
 
 type TodoListAggregate struct {
-	aggregateID       uuid.UUID
-	userID            value.UserID
-	items             []*entity.TodoItem
+	aggregateID   uuid.UUID
+	userID        value.UserID
+	title         value.TodoListTitle
+	description   value.TodoListDescription
+	archived      bool
+	deleted       bool
+	collaborators map[value.UserID]value.Role
+	items         []*entity.TodoItem
+	recurring     map[uuid.UUID]*entity.RecurringTodo
+	// history holds every applied event.
+	history []event.Event
+	// undoable lists the commands that can still be undone, most recent last.
+	undoable          []undoableCommand
 	version           int
 	uncommittedEvents []event.Event
 }
 
 func NewTodoListAggregate() *TodoListAggregate {
 	return &TodoListAggregate{
+		collaborators:     make(map[value.UserID]value.Role),
 		items:             make([]*entity.TodoItem, 0),
+		recurring:         make(map[uuid.UUID]*entity.RecurringTodo),
 		uncommittedEvents: make([]event.Event, 0),
 	}
 }
@@ -37,10 +76,48 @@ func (a *TodoListAggregate) GetUserID() value.UserID {
 	return a.userID
 }
 
+func (a *TodoListAggregate) GetTitle() value.TodoListTitle {
+	return a.title
+}
+
+func (a *TodoListAggregate) GetDescription() value.TodoListDescription {
+	return a.description
+}
+
+func (a *TodoListAggregate) IsArchived() bool {
+	return a.archived
+}
+
+func (a *TodoListAggregate) IsDeleted() bool {
+	return a.deleted
+}
+
+func (a *TodoListAggregate) GetCollaborators() map[value.UserID]value.Role {
+	return a.collaborators
+}
+
+// RoleOf returns the role userID holds on the list. The creator is always an owner.
+func (a *TodoListAggregate) RoleOf(userID value.UserID) (value.Role, bool) {
+	if userID == a.userID {
+		return value.RoleOwner, true
+	}
+	role, ok := a.collaborators[userID]
+	return role, ok
+}
+
 func (a *TodoListAggregate) GetItems() []*entity.TodoItem {
 	return a.items
 }
 
+// FindItem returns the todo with todoID, or nil if the list has none.
+func (a *TodoListAggregate) FindItem(todoID uuid.UUID) *entity.TodoItem {
+	return a.findItem(todoID)
+}
+
+func (a *TodoListAggregate) GetRecurringTodos() map[uuid.UUID]*entity.RecurringTodo {
+	return a.recurring
+}
+
 func (a *TodoListAggregate) GetVersion() int {
 	return a.version
 }
@@ -66,6 +143,42 @@ func (a *TodoListAggregate) ExecuteCreateTodoListCommand(cmd command.CreateTodoL
 	evt := event.TodoListCreatedEvent{
 		AggregateID: uuid.New(),
 		UserID:      cmd.UserID,
+		Title:       cmd.Title,
+		Description: cmd.Description,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     a.version + 1,
+	}
+
+	return a.applyEvent(evt, true)
+}
+
+// ExecuteRenameTodoListCommand records a new title and description. Renaming
+// to the current values is a no-op and produces no event.
+func (a *TodoListAggregate) ExecuteRenameTodoListCommand(cmd command.RenameTodoListCommand) error {
+	if err := a.ensureWritable(); err != nil {
+		return err
+	}
+	if !a.hasRole(cmd.UserID, value.RoleEditor) {
+		return ErrNotListEditor
+	}
+
+	title, description := a.title, a.description
+	if cmd.Title != nil {
+		title = *cmd.Title
+	}
+	if cmd.Description != nil {
+		description = *cmd.Description
+	}
+	if title == a.title && description == a.description {
+		return nil
+	}
+
+	evt := event.TodoListRenamedEvent{
+		AggregateID: cmd.AggregateID,
+		UserID:      cmd.UserID,
+		Title:       title,
+		Description: description,
 		EventID:     uuid.New(),
 		Timestamp:   time.Now(),
 		Version:     a.version + 1,
@@ -75,15 +188,631 @@ func (a *TodoListAggregate) ExecuteCreateTodoListCommand(cmd command.CreateTodoL
 }
 
 func (a *TodoListAggregate) ExecuteAddTodoCommand(cmd command.AddTodoCommand) error {
-	// set a limit of only three items per day for Todo.
-	if len(a.items) >= 3 {
+	if err := a.ensureWritable(); err != nil {
+		return err
+	}
+	if !a.hasRole(cmd.UserID, value.RoleEditor) {
+		return ErrNotListEditor
+	}
+
+	if cmd.RecurrenceID != uuid.Nil {
+		template, ok := a.recurring[cmd.RecurrenceID]
+		if !ok {
+			return ErrRecurringNotFound
+		}
+		if cmd.DueDate.IsZero() {
+			return ErrOccurrenceDueDate
+		}
+		// Occurrences are added by the scheduler, which may retry after a
+		// restart; an occurrence that already exists is not added again.
+		if cmd.DueDate <= template.LastOccurrence {
+			return nil
+		}
+	} else if len(a.items) >= 3 {
+		// set a limit of only three items per day for Todo.
+		// Occurrences of recurring todos do not count against it.
 		return ErrTooManyTodos
 	}
 
 	evt := event.TodoAddedEvent{
+		AggregateID:  cmd.AggregateID,
+		UserID:       cmd.UserID,
+		TodoID:       uuid.New(),
+		TodoText:     cmd.TodoText,
+		DueDate:      cmd.DueDate,
+		Priority:     cmd.Priority,
+		RecurrenceID: cmd.RecurrenceID,
+		EventID:      uuid.New(),
+		Timestamp:    time.Now(),
+		Version:      a.version + 1,
+	}
+
+	return a.applyEvent(evt, true)
+}
+
+// ExecuteScheduleRecurringTodoCommand adds a recurring todo template. The
+// todos themselves are added later, one per occurrence.
+func (a *TodoListAggregate) ExecuteScheduleRecurringTodoCommand(cmd command.ScheduleRecurringTodoCommand) error {
+	if err := a.ensureWritable(); err != nil {
+		return err
+	}
+	if !a.hasRole(cmd.UserID, value.RoleEditor) {
+		return ErrNotListEditor
+	}
+
+	evt := event.RecurringTodoScheduledEvent{
+		AggregateID:  cmd.AggregateID,
+		UserID:       cmd.UserID,
+		RecurrenceID: uuid.New(),
+		TodoText:     cmd.TodoText,
+		Rule:         cmd.Rule,
+		StartDate:    cmd.StartDate,
+		Priority:     cmd.Priority,
+		EventID:      uuid.New(),
+		Timestamp:    time.Now(),
+		Version:      a.version + 1,
+	}
+
+	return a.applyEvent(evt, true)
+}
+
+// ExecuteCancelRecurringTodoCommand stops a recurring todo. Todos already
+// added for past occurrences are kept.
+func (a *TodoListAggregate) ExecuteCancelRecurringTodoCommand(cmd command.CancelRecurringTodoCommand) error {
+	if err := a.ensureWritable(); err != nil {
+		return err
+	}
+	if !a.hasRole(cmd.UserID, value.RoleEditor) {
+		return ErrNotListEditor
+	}
+	if _, ok := a.recurring[cmd.RecurrenceID]; !ok {
+		return ErrRecurringNotFound
+	}
+
+	evt := event.RecurringTodoCancelledEvent{
+		AggregateID:  cmd.AggregateID,
+		UserID:       cmd.UserID,
+		RecurrenceID: cmd.RecurrenceID,
+		EventID:      uuid.New(),
+		Timestamp:    time.Now(),
+		Version:      a.version + 1,
+	}
+
+	return a.applyEvent(evt, true)
+}
+
+func (a *TodoListAggregate) ExecuteSetTodoDueDateCommand(cmd command.SetTodoDueDateCommand) error {
+	if err := a.ensureWritable(); err != nil {
+		return err
+	}
+	if !a.hasRole(cmd.UserID, value.RoleEditor) {
+		return ErrNotListEditor
+	}
+
+	item := a.findItem(cmd.TodoID)
+	if item == nil {
+		return ErrTodoNotFound
+	}
+	if item.DueDate == cmd.DueDate {
+		return nil
+	}
+
+	evt := event.TodoDueDateSetEvent{
+		AggregateID: cmd.AggregateID,
+		UserID:      cmd.UserID,
+		TodoID:      cmd.TodoID,
+		DueDate:     cmd.DueDate,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     a.version + 1,
+	}
+
+	return a.applyEvent(evt, true)
+}
+
+func (a *TodoListAggregate) ExecuteSetTodoPriorityCommand(cmd command.SetTodoPriorityCommand) error {
+	if err := a.ensureWritable(); err != nil {
+		return err
+	}
+	if !a.hasRole(cmd.UserID, value.RoleEditor) {
+		return ErrNotListEditor
+	}
+
+	item := a.findItem(cmd.TodoID)
+	if item == nil {
+		return ErrTodoNotFound
+	}
+	if item.Priority == cmd.Priority {
+		return nil
+	}
+
+	evt := event.TodoPriorityChangedEvent{
+		AggregateID: cmd.AggregateID,
+		UserID:      cmd.UserID,
+		TodoID:      cmd.TodoID,
+		Priority:    cmd.Priority,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     a.version + 1,
+	}
+
+	return a.applyEvent(evt, true)
+}
+
+// ExecuteTagTodoCommand adds a tag to a todo. Adding a tag the todo already
+// has is a no-op.
+func (a *TodoListAggregate) ExecuteTagTodoCommand(cmd command.TagTodoCommand) error {
+	if err := a.ensureWritable(); err != nil {
+		return err
+	}
+	if !a.hasRole(cmd.UserID, value.RoleEditor) {
+		return ErrNotListEditor
+	}
+
+	item := a.findItem(cmd.TodoID)
+	if item == nil {
+		return ErrTodoNotFound
+	}
+	if item.HasTag(cmd.Tag) {
+		return nil
+	}
+	if len(item.Tags) >= maxTagsPerTodo {
+		return ErrTooManyTags
+	}
+
+	evt := event.TodoTaggedEvent{
+		AggregateID: cmd.AggregateID,
+		UserID:      cmd.UserID,
+		TodoID:      cmd.TodoID,
+		Tag:         cmd.Tag,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     a.version + 1,
+	}
+
+	return a.applyEvent(evt, true)
+}
+
+// ExecuteUntagTodoCommand removes a tag from a todo. Removing a tag the todo
+// does not have is a no-op.
+func (a *TodoListAggregate) ExecuteUntagTodoCommand(cmd command.UntagTodoCommand) error {
+	if err := a.ensureWritable(); err != nil {
+		return err
+	}
+	if !a.hasRole(cmd.UserID, value.RoleEditor) {
+		return ErrNotListEditor
+	}
+
+	item := a.findItem(cmd.TodoID)
+	if item == nil {
+		return ErrTodoNotFound
+	}
+	if !item.HasTag(cmd.Tag) {
+		return nil
+	}
+
+	evt := event.TodoUntaggedEvent{
+		AggregateID: cmd.AggregateID,
+		UserID:      cmd.UserID,
+		TodoID:      cmd.TodoID,
+		Tag:         cmd.Tag,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     a.version + 1,
+	}
+
+	return a.applyEvent(evt, true)
+}
+
+// ExecuteSetTodoCompletionCommand completes or reopens a todo. Setting the
+// state the todo is already in is a no-op.
+func (a *TodoListAggregate) ExecuteSetTodoCompletionCommand(cmd command.SetTodoCompletionCommand) error {
+	if err := a.ensureWritable(); err != nil {
+		return err
+	}
+	if !a.hasRole(cmd.UserID, value.RoleEditor) {
+		return ErrNotListEditor
+	}
+
+	item := a.findItem(cmd.TodoID)
+	if item == nil {
+		return ErrTodoNotFound
+	}
+	if item.Completed == cmd.Completed {
+		return nil
+	}
+
+	if cmd.Completed {
+		return a.applyEvent(a.todoCompleted(cmd.AggregateID, cmd.UserID, item.ID, false), true)
+	}
+	return a.applyEvent(a.todoReopened(cmd.AggregateID, cmd.UserID, item.ID, false), true)
+}
+
+// ExecuteAddChecklistItemCommand adds an open checklist entry to a todo,
+// reopening the todo if it was completed.
+func (a *TodoListAggregate) ExecuteAddChecklistItemCommand(cmd command.AddChecklistItemCommand) error {
+	if err := a.ensureWritable(); err != nil {
+		return err
+	}
+	if !a.hasRole(cmd.UserID, value.RoleEditor) {
+		return ErrNotListEditor
+	}
+
+	item := a.findItem(cmd.TodoID)
+	if item == nil {
+		return ErrTodoNotFound
+	}
+	if len(item.Checklist) >= maxChecklistPerTodo {
+		return ErrTooManyChecklist
+	}
+
+	evt := event.ChecklistItemAddedEvent{
+		AggregateID:     cmd.AggregateID,
+		UserID:          cmd.UserID,
+		TodoID:          cmd.TodoID,
+		ChecklistItemID: uuid.New(),
+		Text:            cmd.Text,
+		EventID:         uuid.New(),
+		Timestamp:       time.Now(),
+		Version:         a.version + 1,
+	}
+	if err := a.applyEvent(evt, true); err != nil {
+		return err
+	}
+
+	if item.Completed {
+		return a.applyEvent(a.todoReopened(cmd.AggregateID, cmd.UserID, item.ID, true), true)
+	}
+	return nil
+}
+
+// ExecuteCompleteChecklistItemCommand completes a checklist entry. Completing
+// the last open entry completes the todo as well.
+func (a *TodoListAggregate) ExecuteCompleteChecklistItemCommand(cmd command.CompleteChecklistItemCommand) error {
+	if err := a.ensureWritable(); err != nil {
+		return err
+	}
+	if !a.hasRole(cmd.UserID, value.RoleEditor) {
+		return ErrNotListEditor
+	}
+
+	item := a.findItem(cmd.TodoID)
+	if item == nil {
+		return ErrTodoNotFound
+	}
+	entry := item.FindChecklistItem(cmd.ChecklistItemID)
+	if entry == nil {
+		return ErrChecklistNotFound
+	}
+	if entry.Completed {
+		return nil
+	}
+
+	evt := event.ChecklistItemCompletedEvent{
+		AggregateID:     cmd.AggregateID,
+		UserID:          cmd.UserID,
+		TodoID:          cmd.TodoID,
+		ChecklistItemID: cmd.ChecklistItemID,
+		EventID:         uuid.New(),
+		Timestamp:       time.Now(),
+		Version:         a.version + 1,
+	}
+	if err := a.applyEvent(evt, true); err != nil {
+		return err
+	}
+
+	return a.completeIfChecklistDone(cmd.AggregateID, cmd.UserID, item)
+}
+
+// ExecuteRemoveChecklistItemCommand removes a checklist entry. If only
+// completed entries remain, the todo is completed.
+func (a *TodoListAggregate) ExecuteRemoveChecklistItemCommand(cmd command.RemoveChecklistItemCommand) error {
+	if err := a.ensureWritable(); err != nil {
+		return err
+	}
+	if !a.hasRole(cmd.UserID, value.RoleEditor) {
+		return ErrNotListEditor
+	}
+
+	item := a.findItem(cmd.TodoID)
+	if item == nil {
+		return ErrTodoNotFound
+	}
+	if item.FindChecklistItem(cmd.ChecklistItemID) == nil {
+		return ErrChecklistNotFound
+	}
+
+	evt := event.ChecklistItemRemovedEvent{
+		AggregateID:     cmd.AggregateID,
+		UserID:          cmd.UserID,
+		TodoID:          cmd.TodoID,
+		ChecklistItemID: cmd.ChecklistItemID,
+		EventID:         uuid.New(),
+		Timestamp:       time.Now(),
+		Version:         a.version + 1,
+	}
+	if err := a.applyEvent(evt, true); err != nil {
+		return err
+	}
+
+	return a.completeIfChecklistDone(cmd.AggregateID, cmd.UserID, item)
+}
+
+// ExecuteReorderTodoItemsCommand records a new order for the list's todos.
+// The order must be a permutation of the current todos; keeping the current
+// order is a no-op.
+func (a *TodoListAggregate) ExecuteReorderTodoItemsCommand(cmd command.ReorderTodoItemsCommand) error {
+	if err := a.ensureWritable(); err != nil {
+		return err
+	}
+	if !a.hasRole(cmd.UserID, value.RoleEditor) {
+		return ErrNotListEditor
+	}
+	if len(cmd.TodoIDs) != len(a.items) {
+		return ErrInvalidTodoOrder
+	}
+
+	seen := make(map[uuid.UUID]struct{}, len(cmd.TodoIDs))
+	unchanged := true
+	for i, todoID := range cmd.TodoIDs {
+		if _, dup := seen[todoID]; dup || a.findItem(todoID) == nil {
+			return ErrInvalidTodoOrder
+		}
+		seen[todoID] = struct{}{}
+		unchanged = unchanged && a.items[i].ID == todoID
+	}
+	if unchanged {
+		return nil
+	}
+
+	evt := event.TodoItemsReorderedEvent{
+		AggregateID: cmd.AggregateID,
+		UserID:      cmd.UserID,
+		TodoIDs:     append([]uuid.UUID(nil), cmd.TodoIDs...),
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     a.version + 1,
+	}
+
+	return a.applyEvent(evt, true)
+}
+
+// ExecuteMoveTodoOutCommand takes a todo off the list for a move. The target
+// list must record the matching TodoMovedInEvent in the same transaction.
+func (a *TodoListAggregate) ExecuteMoveTodoOutCommand(cmd command.MoveTodoOutCommand) error {
+	if err := a.ensureWritable(); err != nil {
+		return err
+	}
+	if !a.hasRole(cmd.UserID, value.RoleEditor) {
+		return ErrNotListEditor
+	}
+	if cmd.TargetAggregateID == a.aggregateID {
+		return ErrMoveToSameList
+	}
+	if a.findItem(cmd.TodoID) == nil {
+		return ErrTodoNotFound
+	}
+
+	evt := event.TodoMovedOutEvent{
+		AggregateID:       cmd.AggregateID,
+		UserID:            cmd.UserID,
+		TodoID:            cmd.TodoID,
+		TargetAggregateID: cmd.TargetAggregateID,
+		EventID:           uuid.New(),
+		Timestamp:         time.Now(),
+		Version:           a.version + 1,
+	}
+
+	return a.applyEvent(evt, true)
+}
+
+// ExecuteMoveTodoInCommand adds a todo moved from another list. The todo
+// counts against the list's limit like any todo added to it.
+func (a *TodoListAggregate) ExecuteMoveTodoInCommand(cmd command.MoveTodoInCommand) error {
+	if err := a.ensureWritable(); err != nil {
+		return err
+	}
+	if !a.hasRole(cmd.UserID, value.RoleEditor) {
+		return ErrNotListEditor
+	}
+	if cmd.SourceAggregateID == a.aggregateID {
+		return ErrMoveToSameList
+	}
+	if len(a.items) >= 3 {
+		return ErrTooManyTodos
+	}
+
+	checklist := make([]event.MovedChecklistItem, 0, len(cmd.Todo.Checklist))
+	for _, entry := range cmd.Todo.Checklist {
+		checklist = append(checklist, event.MovedChecklistItem{
+			ID:        entry.ID,
+			Text:      entry.Text,
+			Completed: entry.Completed,
+		})
+	}
+
+	evt := event.TodoMovedInEvent{
+		AggregateID:       cmd.AggregateID,
+		UserID:            cmd.UserID,
+		TodoID:            cmd.Todo.ID,
+		SourceAggregateID: cmd.SourceAggregateID,
+		TodoText:          cmd.Todo.Text,
+		DueDate:           cmd.Todo.DueDate,
+		Priority:          cmd.Todo.Priority,
+		Tags:              slices.Clone(cmd.Todo.Tags),
+		Completed:         cmd.Todo.Completed,
+		Checklist:         checklist,
+		EventID:           uuid.New(),
+		Timestamp:         time.Now(),
+		Version:           a.version + 1,
+	}
+
+	return a.applyEvent(evt, true)
+}
+
+// ExecuteMarkOverdueTodosCommand records a TodoBecameOverdueEvent for every
+// todo that is past its due date at cmd.Now and has not been reported yet.
+// Archived and deleted lists are left alone.
+func (a *TodoListAggregate) ExecuteMarkOverdueTodosCommand(cmd command.MarkOverdueTodosCommand) error {
+	if a.archived || a.deleted {
+		return nil
+	}
+
+	for _, item := range a.items {
+		if item.Overdue || item.Completed || !item.DueDate.IsOverdue(cmd.Now) {
+			continue
+		}
+
+		evt := event.TodoBecameOverdueEvent{
+			AggregateID: cmd.AggregateID,
+			TodoID:      item.ID,
+			DueDate:     item.DueDate,
+			EventID:     uuid.New(),
+			Timestamp:   cmd.Now,
+			Version:     a.version + 1,
+		}
+		if err := a.applyEvent(evt, true); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (a *TodoListAggregate) ExecuteInviteCollaboratorCommand(cmd command.InviteCollaboratorCommand) error {
+	if err := a.ensureWritable(); err != nil {
+		return err
+	}
+	if !a.hasRole(cmd.UserID, value.RoleOwner) {
+		return ErrNotListOwner
+	}
+	if cmd.CollaboratorID == a.userID {
+		return ErrCollaboratorIsOwner
+	}
+	if _, ok := a.collaborators[cmd.CollaboratorID]; ok {
+		return ErrAlreadyCollaborator
+	}
+
+	evt := event.CollaboratorInvitedEvent{
+		AggregateID:    cmd.AggregateID,
+		UserID:         cmd.UserID,
+		CollaboratorID: cmd.CollaboratorID,
+		Role:           cmd.Role,
+		EventID:        uuid.New(),
+		Timestamp:      time.Now(),
+		Version:        a.version + 1,
+	}
+
+	return a.applyEvent(evt, true)
+}
+
+func (a *TodoListAggregate) ExecuteChangeCollaboratorRoleCommand(cmd command.ChangeCollaboratorRoleCommand) error {
+	if err := a.ensureWritable(); err != nil {
+		return err
+	}
+	if !a.hasRole(cmd.UserID, value.RoleOwner) {
+		return ErrNotListOwner
+	}
+	if cmd.CollaboratorID == a.userID {
+		return ErrCollaboratorIsOwner
+	}
+	if _, ok := a.collaborators[cmd.CollaboratorID]; !ok {
+		return ErrCollaboratorNotFound
+	}
+
+	evt := event.CollaboratorRoleChangedEvent{
+		AggregateID:    cmd.AggregateID,
+		UserID:         cmd.UserID,
+		CollaboratorID: cmd.CollaboratorID,
+		Role:           cmd.Role,
+		EventID:        uuid.New(),
+		Timestamp:      time.Now(),
+		Version:        a.version + 1,
+	}
+
+	return a.applyEvent(evt, true)
+}
+
+func (a *TodoListAggregate) ExecuteRemoveCollaboratorCommand(cmd command.RemoveCollaboratorCommand) error {
+	if err := a.ensureWritable(); err != nil {
+		return err
+	}
+	if !a.hasRole(cmd.UserID, value.RoleOwner) {
+		return ErrNotListOwner
+	}
+	if cmd.CollaboratorID == a.userID {
+		return ErrCollaboratorIsOwner
+	}
+	if _, ok := a.collaborators[cmd.CollaboratorID]; !ok {
+		return ErrCollaboratorNotFound
+	}
+
+	evt := event.CollaboratorRemovedEvent{
+		AggregateID:    cmd.AggregateID,
+		UserID:         cmd.UserID,
+		CollaboratorID: cmd.CollaboratorID,
+		EventID:        uuid.New(),
+		Timestamp:      time.Now(),
+		Version:        a.version + 1,
+	}
+
+	return a.applyEvent(evt, true)
+}
+
+func (a *TodoListAggregate) ExecuteArchiveTodoListCommand(cmd command.ArchiveTodoListCommand) error {
+	if err := a.ensureWritable(); err != nil {
+		return err
+	}
+	if !a.hasRole(cmd.UserID, value.RoleOwner) {
+		return ErrNotListOwner
+	}
+
+	evt := event.TodoListArchivedEvent{
+		AggregateID: cmd.AggregateID,
+		UserID:      cmd.UserID,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     a.version + 1,
+	}
+
+	return a.applyEvent(evt, true)
+}
+
+func (a *TodoListAggregate) ExecuteRestoreTodoListCommand(cmd command.RestoreTodoListCommand) error {
+	if a.deleted {
+		return ErrTodoListDeleted
+	}
+	if !a.hasRole(cmd.UserID, value.RoleOwner) {
+		return ErrNotListOwner
+	}
+	if !a.archived {
+		return ErrTodoListNotArchived
+	}
+
+	evt := event.TodoListRestoredEvent{
+		AggregateID: cmd.AggregateID,
+		UserID:      cmd.UserID,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     a.version + 1,
+	}
+
+	return a.applyEvent(evt, true)
+}
+
+// ExecuteDeleteTodoListCommand tombstones the list. Archived lists may be
+// deleted too; nothing can be done to a list afterwards.
+func (a *TodoListAggregate) ExecuteDeleteTodoListCommand(cmd command.DeleteTodoListCommand) error {
+	if a.deleted {
+		return ErrTodoListDeleted
+	}
+	if !a.hasRole(cmd.UserID, value.RoleOwner) {
+		return ErrNotListOwner
+	}
+
+	evt := event.TodoListDeletedEvent{
 		AggregateID: cmd.AggregateID,
 		UserID:      cmd.UserID,
-		TodoText:    cmd.TodoText,
 		EventID:     uuid.New(),
 		Timestamp:   time.Now(),
 		Version:     a.version + 1,
@@ -92,16 +821,127 @@ func (a *TodoListAggregate) ExecuteAddTodoCommand(cmd command.AddTodoCommand) er
 	return a.applyEvent(evt, true)
 }
 
+// completeIfChecklistDone derives the todo's completion from its checklist
+// and records it, so replaying the events yields the same state.
+func (a *TodoListAggregate) completeIfChecklistDone(aggregateID uuid.UUID, userID value.UserID, item *entity.TodoItem) error {
+	if item.Completed || !item.ChecklistDone() {
+		return nil
+	}
+	return a.applyEvent(a.todoCompleted(aggregateID, userID, item.ID, true), true)
+}
+
+func (a *TodoListAggregate) todoCompleted(aggregateID uuid.UUID, userID value.UserID, todoID uuid.UUID, derived bool) event.TodoCompletedEvent {
+	return event.TodoCompletedEvent{
+		AggregateID: aggregateID,
+		UserID:      userID,
+		TodoID:      todoID,
+		Derived:     derived,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     a.version + 1,
+	}
+}
+
+func (a *TodoListAggregate) todoReopened(aggregateID uuid.UUID, userID value.UserID, todoID uuid.UUID, derived bool) event.TodoReopenedEvent {
+	return event.TodoReopenedEvent{
+		AggregateID: aggregateID,
+		UserID:      userID,
+		TodoID:      todoID,
+		Derived:     derived,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     a.version + 1,
+	}
+}
+
+func (a *TodoListAggregate) findItem(todoID uuid.UUID) *entity.TodoItem {
+	for _, item := range a.items {
+		if item.ID == todoID {
+			return item
+		}
+	}
+	return nil
+}
+
+// ensureWritable rejects changes to lists that are archived or deleted.
+func (a *TodoListAggregate) ensureWritable() error {
+	if a.deleted {
+		return ErrTodoListDeleted
+	}
+	if a.archived {
+		return ErrTodoListArchived
+	}
+	return nil
+}
+
+func (a *TodoListAggregate) hasRole(userID value.UserID, required value.Role) bool {
+	role, ok := a.RoleOf(userID)
+	return ok && role.Includes(required)
+}
+
 func (a *TodoListAggregate) applyEvent(evt event.Event, isNew bool) error {
 	switch e := evt.(type) {
 	case event.TodoListCreatedEvent:
 		a.onTodoListCreated(e)
+	case event.TodoListRenamedEvent:
+		a.onTodoListRenamed(e)
 	case event.TodoAddedEvent:
 		a.onTodoAdded(e)
+	case event.TodoRemovedEvent:
+		a.onTodoRemoved(e)
+	case event.TodoMovedOutEvent:
+		a.onTodoMovedOut(e)
+	case event.TodoMovedInEvent:
+		a.onTodoMovedIn(e)
+	case event.TodoDueDateSetEvent:
+		a.onTodoDueDateSet(e)
+	case event.TodoBecameOverdueEvent:
+		a.onTodoBecameOverdue(e)
+	case event.TodoPriorityChangedEvent:
+		a.onTodoPriorityChanged(e)
+	case event.TodoItemsReorderedEvent:
+		a.onTodoItemsReordered(e)
+	case event.TodoTaggedEvent:
+		a.onTodoTagged(e)
+	case event.TodoUntaggedEvent:
+		a.onTodoUntagged(e)
+	case event.TodoCompletedEvent:
+		a.onTodoCompleted(e)
+	case event.TodoReopenedEvent:
+		a.onTodoReopened(e)
+	case event.ChecklistItemAddedEvent:
+		a.onChecklistItemAdded(e)
+	case event.ChecklistItemCompletedEvent:
+		a.onChecklistItemCompleted(e)
+	case event.ChecklistItemReopenedEvent:
+		a.onChecklistItemReopened(e)
+	case event.ChecklistItemRemovedEvent:
+		a.onChecklistItemRemoved(e)
+	case event.RecurringTodoScheduledEvent:
+		a.onRecurringTodoScheduled(e)
+	case event.RecurringTodoCancelledEvent:
+		a.onRecurringTodoCancelled(e)
+	case event.CollaboratorInvitedEvent:
+		a.onCollaboratorInvited(e)
+	case event.CollaboratorRoleChangedEvent:
+		a.onCollaboratorRoleChanged(e)
+	case event.CollaboratorRemovedEvent:
+		a.onCollaboratorRemoved(e)
+	case event.TodoListArchivedEvent:
+		a.onTodoListArchived(e)
+	case event.TodoListRestoredEvent:
+		a.onTodoListRestored(e)
+	case event.TodoListDeletedEvent:
+		a.onTodoListDeleted(e)
+	case event.CommandUndoneEvent:
+		// The compensating events before it already changed the state.
 	default:
 		return fmt.Errorf("unknown event type: %T", evt)
 	}
 
+	a.history = append(a.history, evt)
+	a.trackUndoable(evt)
+
 	if isNew {
 		a.uncommittedEvents = append(a.uncommittedEvents, evt)
 	}
@@ -113,9 +953,170 @@ func (a *TodoListAggregate) applyEvent(evt event.Event, isNew bool) error {
 func (a *TodoListAggregate) onTodoListCreated(evt event.TodoListCreatedEvent) {
 	a.aggregateID = evt.AggregateID
 	a.userID = evt.UserID
+	a.title = evt.Title
+	a.description = evt.Description
+}
+
+func (a *TodoListAggregate) onTodoListRenamed(evt event.TodoListRenamedEvent) {
+	a.title = evt.Title
+	a.description = evt.Description
 }
 
 func (a *TodoListAggregate) onTodoAdded(evt event.TodoAddedEvent) {
-	todoItem := entity.NewTodoItem(evt.TodoText)
+	todoItem := entity.NewTodoItem(evt.ItemID(), evt.TodoText, evt.DueDate, evt.Priority.OrDefault(), evt.Timestamp)
+	todoItem.RecurrenceID = evt.RecurrenceID
+	a.items = append(a.items, todoItem)
+
+	if template, ok := a.recurring[evt.RecurrenceID]; ok && evt.DueDate > template.LastOccurrence {
+		template.LastOccurrence = evt.DueDate
+	}
+}
+
+func (a *TodoListAggregate) onTodoRemoved(evt event.TodoRemovedEvent) {
+	a.items = slices.DeleteFunc(a.items, func(item *entity.TodoItem) bool {
+		return item.ID == evt.TodoID
+	})
+}
+
+func (a *TodoListAggregate) onTodoMovedOut(evt event.TodoMovedOutEvent) {
+	a.items = slices.DeleteFunc(a.items, func(item *entity.TodoItem) bool {
+		return item.ID == evt.TodoID
+	})
+}
+
+func (a *TodoListAggregate) onTodoMovedIn(evt event.TodoMovedInEvent) {
+	todoItem := entity.NewTodoItem(evt.TodoID, evt.TodoText, evt.DueDate, evt.Priority.OrDefault(), evt.Timestamp)
+	todoItem.Tags = slices.Clone(evt.Tags)
+	todoItem.Completed = evt.Completed
+	for _, entry := range evt.Checklist {
+		checklistItem := entity.NewChecklistItem(entry.ID, entry.Text)
+		checklistItem.Completed = entry.Completed
+		todoItem.Checklist = append(todoItem.Checklist, checklistItem)
+	}
 	a.items = append(a.items, todoItem)
 }
+
+func (a *TodoListAggregate) onTodoDueDateSet(evt event.TodoDueDateSetEvent) {
+	if item := a.findItem(evt.TodoID); item != nil {
+		item.DueDate = evt.DueDate
+		item.Overdue = false
+	}
+}
+
+func (a *TodoListAggregate) onTodoBecameOverdue(evt event.TodoBecameOverdueEvent) {
+	if item := a.findItem(evt.TodoID); item != nil {
+		item.Overdue = true
+	}
+}
+
+func (a *TodoListAggregate) onTodoPriorityChanged(evt event.TodoPriorityChangedEvent) {
+	if item := a.findItem(evt.TodoID); item != nil {
+		item.Priority = evt.Priority
+	}
+}
+
+func (a *TodoListAggregate) onTodoItemsReordered(evt event.TodoItemsReorderedEvent) {
+	reordered := make([]*entity.TodoItem, 0, len(a.items))
+	for _, todoID := range evt.TodoIDs {
+		if item := a.findItem(todoID); item != nil {
+			reordered = append(reordered, item)
+		}
+	}
+	a.items = reordered
+}
+
+func (a *TodoListAggregate) onTodoTagged(evt event.TodoTaggedEvent) {
+	if item := a.findItem(evt.TodoID); item != nil {
+		item.Tags = append(item.Tags, evt.Tag)
+	}
+}
+
+func (a *TodoListAggregate) onTodoUntagged(evt event.TodoUntaggedEvent) {
+	if item := a.findItem(evt.TodoID); item != nil {
+		item.Tags = slices.DeleteFunc(item.Tags, func(tag value.Tag) bool {
+			return tag == evt.Tag
+		})
+	}
+}
+
+func (a *TodoListAggregate) onTodoCompleted(evt event.TodoCompletedEvent) {
+	if item := a.findItem(evt.TodoID); item != nil {
+		item.Completed = true
+	}
+}
+
+func (a *TodoListAggregate) onTodoReopened(evt event.TodoReopenedEvent) {
+	if item := a.findItem(evt.TodoID); item != nil {
+		item.Completed = false
+	}
+}
+
+func (a *TodoListAggregate) onChecklistItemAdded(evt event.ChecklistItemAddedEvent) {
+	if item := a.findItem(evt.TodoID); item != nil {
+		item.Checklist = append(item.Checklist, entity.NewChecklistItem(evt.ChecklistItemID, evt.Text))
+	}
+}
+
+func (a *TodoListAggregate) onChecklistItemCompleted(evt event.ChecklistItemCompletedEvent) {
+	if item := a.findItem(evt.TodoID); item != nil {
+		if entry := item.FindChecklistItem(evt.ChecklistItemID); entry != nil {
+			entry.Completed = true
+		}
+	}
+}
+
+func (a *TodoListAggregate) onChecklistItemReopened(evt event.ChecklistItemReopenedEvent) {
+	if item := a.findItem(evt.TodoID); item != nil {
+		if entry := item.FindChecklistItem(evt.ChecklistItemID); entry != nil {
+			entry.Completed = false
+		}
+	}
+}
+
+func (a *TodoListAggregate) onChecklistItemRemoved(evt event.ChecklistItemRemovedEvent) {
+	if item := a.findItem(evt.TodoID); item != nil {
+		item.Checklist = slices.DeleteFunc(item.Checklist, func(entry *entity.ChecklistItem) bool {
+			return entry.ID == evt.ChecklistItemID
+		})
+	}
+}
+
+func (a *TodoListAggregate) onRecurringTodoScheduled(evt event.RecurringTodoScheduledEvent) {
+	recurring := entity.NewRecurringTodo(evt.RecurrenceID, evt.TodoText, evt.Rule, evt.StartDate, evt.Priority)
+	// A recurring todo scheduled again by an undo keeps the occurrences it
+	// already has.
+	for _, item := range a.items {
+		if item.RecurrenceID == evt.RecurrenceID && item.DueDate > recurring.LastOccurrence {
+			recurring.LastOccurrence = item.DueDate
+		}
+	}
+	a.recurring[evt.RecurrenceID] = recurring
+}
+
+func (a *TodoListAggregate) onRecurringTodoCancelled(evt event.RecurringTodoCancelledEvent) {
+	delete(a.recurring, evt.RecurrenceID)
+}
+
+func (a *TodoListAggregate) onCollaboratorInvited(evt event.CollaboratorInvitedEvent) {
+	a.collaborators[evt.CollaboratorID] = evt.Role
+}
+
+func (a *TodoListAggregate) onCollaboratorRoleChanged(evt event.CollaboratorRoleChangedEvent) {
+	a.collaborators[evt.CollaboratorID] = evt.Role
+}
+
+func (a *TodoListAggregate) onCollaboratorRemoved(evt event.CollaboratorRemovedEvent) {
+	delete(a.collaborators, evt.CollaboratorID)
+}
+
+func (a *TodoListAggregate) onTodoListArchived(event.TodoListArchivedEvent) {
+	a.archived = true
+}
+
+func (a *TodoListAggregate) onTodoListRestored(event.TodoListRestoredEvent) {
+	a.archived = false
+}
+
+func (a *TodoListAggregate) onTodoListDeleted(event.TodoListDeletedEvent) {
+	a.deleted = true
+}
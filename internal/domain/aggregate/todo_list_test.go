@@ -3,12 +3,15 @@ package aggregate_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/aggregate"
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
 )
 
 func TestTodoListAggregate_CreateTodoList(t *testing.T) {
@@ -189,3 +192,960 @@ func TestTodoListAggregate_ExecuteAddTodoCommand_ExceedsLimit(t *testing.T) {
 		})
 	}
 }
+
+func TestTodoListAggregate_ExecuteAddTodoCommand_Ownership(t *testing.T) {
+	tests := map[string]struct {
+		callerID      string
+		expectedError error
+		wantErr       bool
+	}{
+		"owner can add todo": {
+			callerID: "user123",
+			wantErr:  false,
+		},
+		"non-owner is rejected": {
+			callerID:      "intruder",
+			expectedError: aggregate.ErrNotListEditor,
+			wantErr:       true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ownerID, err := value.NewUserID("user123")
+			require.NoError(t, err)
+			agg := aggregate.NewTodoListAggregate()
+			err = agg.ExecuteCreateTodoListCommand(command.CreateTodoListCommand{UserID: ownerID})
+			require.NoError(t, err)
+
+			callerID, err := value.NewUserID(tt.callerID)
+			require.NoError(t, err)
+			todoText, err := value.NewTodoText("Learn Event Sourcing")
+			require.NoError(t, err)
+
+			// Act
+			err = agg.ExecuteAddTodoCommand(command.AddTodoCommand{
+				AggregateID: agg.GetAggregateID(),
+				UserID:      callerID,
+				TodoText:    todoText,
+			})
+
+			// Assert
+			if tt.wantErr {
+				require.ErrorIs(t, err, tt.expectedError)
+				require.True(t, errors.IsCode(err, errors.Forbidden))
+				require.Len(t, agg.GetUncommittedEvents(), 1)
+			} else {
+				require.NoError(t, err)
+				require.Len(t, agg.GetUncommittedEvents(), 2)
+			}
+		})
+	}
+}
+
+func TestTodoListAggregate_Collaborators(t *testing.T) {
+	tests := map[string]struct {
+		act           func(agg *aggregate.TodoListAggregate, owner, alice, bob value.UserID) error
+		expectedError error
+		expectedRole  value.Role
+		isMember      bool
+	}{
+		"invited editor can add todo": {
+			act: func(agg *aggregate.TodoListAggregate, _, alice, _ value.UserID) error {
+				return agg.ExecuteAddTodoCommand(command.AddTodoCommand{
+					AggregateID: agg.GetAggregateID(), UserID: alice, TodoText: value.TodoText("Shared work"),
+				})
+			},
+			expectedRole: value.RoleEditor,
+			isMember:     true,
+		},
+		"owner changes role": {
+			act: func(agg *aggregate.TodoListAggregate, owner, alice, _ value.UserID) error {
+				return agg.ExecuteChangeCollaboratorRoleCommand(command.ChangeCollaboratorRoleCommand{
+					AggregateID: agg.GetAggregateID(), UserID: owner, CollaboratorID: alice, Role: value.RoleOwner,
+				})
+			},
+			expectedRole: value.RoleOwner,
+			isMember:     true,
+		},
+		"owner removes collaborator": {
+			act: func(agg *aggregate.TodoListAggregate, owner, alice, _ value.UserID) error {
+				return agg.ExecuteRemoveCollaboratorCommand(command.RemoveCollaboratorCommand{
+					AggregateID: agg.GetAggregateID(), UserID: owner, CollaboratorID: alice,
+				})
+			},
+			isMember: false,
+		},
+		"editor cannot invite": {
+			act: func(agg *aggregate.TodoListAggregate, _, alice, bob value.UserID) error {
+				return agg.ExecuteInviteCollaboratorCommand(command.InviteCollaboratorCommand{
+					AggregateID: agg.GetAggregateID(), UserID: alice, CollaboratorID: bob, Role: value.RoleViewer,
+				})
+			},
+			expectedError: aggregate.ErrNotListOwner,
+		},
+		"cannot invite twice": {
+			act: func(agg *aggregate.TodoListAggregate, owner, alice, _ value.UserID) error {
+				return agg.ExecuteInviteCollaboratorCommand(command.InviteCollaboratorCommand{
+					AggregateID: agg.GetAggregateID(), UserID: owner, CollaboratorID: alice, Role: value.RoleViewer,
+				})
+			},
+			expectedError: aggregate.ErrAlreadyCollaborator,
+		},
+		"cannot manage creator": {
+			act: func(agg *aggregate.TodoListAggregate, owner, _, _ value.UserID) error {
+				return agg.ExecuteRemoveCollaboratorCommand(command.RemoveCollaboratorCommand{
+					AggregateID: agg.GetAggregateID(), UserID: owner, CollaboratorID: owner,
+				})
+			},
+			expectedError: aggregate.ErrCollaboratorIsOwner,
+		},
+		"cannot remove unknown collaborator": {
+			act: func(agg *aggregate.TodoListAggregate, owner, _, bob value.UserID) error {
+				return agg.ExecuteRemoveCollaboratorCommand(command.RemoveCollaboratorCommand{
+					AggregateID: agg.GetAggregateID(), UserID: owner, CollaboratorID: bob,
+				})
+			},
+			expectedError: aggregate.ErrCollaboratorNotFound,
+		},
+		"viewer cannot add todo": {
+			act: func(agg *aggregate.TodoListAggregate, owner, alice, bob value.UserID) error {
+				err := agg.ExecuteInviteCollaboratorCommand(command.InviteCollaboratorCommand{
+					AggregateID: agg.GetAggregateID(), UserID: owner, CollaboratorID: bob, Role: value.RoleViewer,
+				})
+				if err != nil {
+					return err
+				}
+				return agg.ExecuteAddTodoCommand(command.AddTodoCommand{
+					AggregateID: agg.GetAggregateID(), UserID: bob, TodoText: value.TodoText("Read only"),
+				})
+			},
+			expectedError: aggregate.ErrNotListEditor,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			owner, alice, bob := value.UserID("user123"), value.UserID("alice"), value.UserID("bob")
+			agg := aggregate.NewTodoListAggregate()
+			require.NoError(t, agg.ExecuteCreateTodoListCommand(command.CreateTodoListCommand{UserID: owner}))
+			require.NoError(t, agg.ExecuteInviteCollaboratorCommand(command.InviteCollaboratorCommand{
+				AggregateID: agg.GetAggregateID(), UserID: owner, CollaboratorID: alice, Role: value.RoleEditor,
+			}))
+
+			// Act
+			err := tt.act(agg, owner, alice, bob)
+
+			// Assert
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			role, ok := agg.RoleOf(alice)
+			require.Equal(t, tt.isMember, ok)
+			require.Equal(t, tt.expectedRole, role)
+		})
+	}
+}
+
+func TestTodoListAggregate_ExecuteRenameTodoListCommand(t *testing.T) {
+	newTitle := value.TodoListTitle("Weekend groceries")
+	sameTitle := value.TodoListTitle("Groceries")
+	newDescription := value.TodoListDescription("Saturday shopping")
+
+	tests := map[string]struct {
+		callerID        string
+		title           *value.TodoListTitle
+		description     *value.TodoListDescription
+		expectedError   error
+		wantEvents      int
+		wantTitle       value.TodoListTitle
+		wantDescription value.TodoListDescription
+	}{
+		"rename title only": {
+			callerID:   "user123",
+			title:      &newTitle,
+			wantEvents: 2,
+			wantTitle:  newTitle,
+		},
+		"change description only": {
+			callerID:        "user123",
+			description:     &newDescription,
+			wantEvents:      2,
+			wantTitle:       sameTitle,
+			wantDescription: newDescription,
+		},
+		"same title is a no-op": {
+			callerID:   "user123",
+			title:      &sameTitle,
+			wantEvents: 1,
+			wantTitle:  sameTitle,
+		},
+		"non-member is rejected": {
+			callerID:      "intruder",
+			title:         &newTitle,
+			expectedError: aggregate.ErrNotListEditor,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			agg := aggregate.NewTodoListAggregate()
+			require.NoError(t, agg.ExecuteCreateTodoListCommand(command.CreateTodoListCommand{
+				UserID: value.UserID("user123"),
+				Title:  sameTitle,
+			}))
+
+			// Act
+			err := agg.ExecuteRenameTodoListCommand(command.RenameTodoListCommand{
+				AggregateID: agg.GetAggregateID(),
+				UserID:      value.UserID(tt.callerID),
+				Title:       tt.title,
+				Description: tt.description,
+			})
+
+			// Assert
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, agg.GetUncommittedEvents(), tt.wantEvents)
+			require.Equal(t, tt.wantTitle, agg.GetTitle())
+			require.Equal(t, tt.wantDescription, agg.GetDescription())
+		})
+	}
+}
+
+func TestTodoListAggregate_Lifecycle(t *testing.T) {
+	owner := value.UserID("user123")
+
+	archive := func(agg *aggregate.TodoListAggregate) error {
+		return agg.ExecuteArchiveTodoListCommand(command.ArchiveTodoListCommand{AggregateID: agg.GetAggregateID(), UserID: owner})
+	}
+	restore := func(agg *aggregate.TodoListAggregate) error {
+		return agg.ExecuteRestoreTodoListCommand(command.RestoreTodoListCommand{AggregateID: agg.GetAggregateID(), UserID: owner})
+	}
+	remove := func(agg *aggregate.TodoListAggregate) error {
+		return agg.ExecuteDeleteTodoListCommand(command.DeleteTodoListCommand{AggregateID: agg.GetAggregateID(), UserID: owner})
+	}
+	addTodo := func(agg *aggregate.TodoListAggregate) error {
+		return agg.ExecuteAddTodoCommand(command.AddTodoCommand{AggregateID: agg.GetAggregateID(), UserID: owner, TodoText: value.TodoText("Learn Event Sourcing")})
+	}
+
+	tests := map[string]struct {
+		setup         []func(*aggregate.TodoListAggregate) error
+		act           func(*aggregate.TodoListAggregate) error
+		expectedError error
+		errCode       errors.ErrCode
+		wantArchived  bool
+		wantDeleted   bool
+	}{
+		"archive active list": {
+			act:          archive,
+			wantArchived: true,
+		},
+		"archived list rejects new todos": {
+			setup:         []func(*aggregate.TodoListAggregate) error{archive},
+			act:           addTodo,
+			expectedError: aggregate.ErrTodoListArchived,
+			errCode:       errors.Archived,
+		},
+		"restore archived list": {
+			setup: []func(*aggregate.TodoListAggregate) error{archive},
+			act:   restore,
+		},
+		"restore active list is rejected": {
+			act:           restore,
+			expectedError: aggregate.ErrTodoListNotArchived,
+			errCode:       errors.InvalidParameter,
+		},
+		"delete archived list": {
+			setup:        []func(*aggregate.TodoListAggregate) error{archive},
+			act:          remove,
+			wantArchived: true,
+			wantDeleted:  true,
+		},
+		"deleted list rejects new todos": {
+			setup:         []func(*aggregate.TodoListAggregate) error{remove},
+			act:           addTodo,
+			expectedError: aggregate.ErrTodoListDeleted,
+			errCode:       errors.Deleted,
+		},
+		"deleted list cannot be restored": {
+			setup:         []func(*aggregate.TodoListAggregate) error{archive, remove},
+			act:           restore,
+			expectedError: aggregate.ErrTodoListDeleted,
+			errCode:       errors.Deleted,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			agg := aggregate.NewTodoListAggregate()
+			require.NoError(t, agg.ExecuteCreateTodoListCommand(command.CreateTodoListCommand{UserID: owner}))
+			for _, step := range tt.setup {
+				require.NoError(t, step(agg))
+			}
+
+			// Act
+			err := tt.act(agg)
+
+			// Assert
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				require.True(t, errors.IsCode(err, tt.errCode))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantArchived, agg.IsArchived())
+			require.Equal(t, tt.wantDeleted, agg.IsDeleted())
+		})
+	}
+}
+
+func TestTodoListAggregate_Lifecycle_RequiresOwner(t *testing.T) {
+	// Arrange
+	agg := aggregate.NewTodoListAggregate()
+	require.NoError(t, agg.ExecuteCreateTodoListCommand(command.CreateTodoListCommand{UserID: value.UserID("user123")}))
+	require.NoError(t, agg.ExecuteInviteCollaboratorCommand(command.InviteCollaboratorCommand{
+		AggregateID: agg.GetAggregateID(), UserID: value.UserID("user123"), CollaboratorID: value.UserID("alice"), Role: value.RoleEditor,
+	}))
+
+	// Act
+	err := agg.ExecuteDeleteTodoListCommand(command.DeleteTodoListCommand{AggregateID: agg.GetAggregateID(), UserID: value.UserID("alice")})
+
+	// Assert
+	require.ErrorIs(t, err, aggregate.ErrNotListOwner)
+	require.False(t, agg.IsDeleted())
+}
+
+func TestTodoListAggregate_DueDates(t *testing.T) {
+	owner := value.UserID("user123")
+	now := time.Date(2025, 3, 31, 9, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		addDueDate    value.DueDate
+		setDueDate    *value.DueDate
+		markTwice     bool
+		expectedError error
+		wantOverdue   int
+	}{
+		"past due date is reported once": {
+			addDueDate:  value.DueDate("2025-03-30"),
+			markTwice:   true,
+			wantOverdue: 1,
+		},
+		"due today is not overdue": {
+			addDueDate:  value.DueDate("2025-03-31"),
+			wantOverdue: 0,
+		},
+		"no due date is never overdue": {
+			wantOverdue: 0,
+		},
+		"moving the due date back makes it overdue": {
+			addDueDate:  value.DueDate("2025-04-30"),
+			setDueDate:  dueDatePtr("2025-03-01"),
+			wantOverdue: 1,
+		},
+		"clearing the due date avoids overdue": {
+			addDueDate:  value.DueDate("2025-03-01"),
+			setDueDate:  dueDatePtr(""),
+			wantOverdue: 0,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			agg := aggregate.NewTodoListAggregate()
+			require.NoError(t, agg.ExecuteCreateTodoListCommand(command.CreateTodoListCommand{UserID: owner}))
+			require.NoError(t, agg.ExecuteAddTodoCommand(command.AddTodoCommand{
+				AggregateID: agg.GetAggregateID(),
+				UserID:      owner,
+				TodoText:    value.TodoText("File taxes"),
+				DueDate:     tt.addDueDate,
+			}))
+			todoID := agg.GetItems()[0].ID
+			if tt.setDueDate != nil {
+				require.NoError(t, agg.ExecuteSetTodoDueDateCommand(command.SetTodoDueDateCommand{
+					AggregateID: agg.GetAggregateID(),
+					UserID:      owner,
+					TodoID:      todoID,
+					DueDate:     *tt.setDueDate,
+				}))
+			}
+			agg.MarkEventsAsCommitted()
+
+			// Act
+			mark := command.MarkOverdueTodosCommand{AggregateID: agg.GetAggregateID(), Now: now}
+			require.NoError(t, agg.ExecuteMarkOverdueTodosCommand(mark))
+			if tt.markTwice {
+				require.NoError(t, agg.ExecuteMarkOverdueTodosCommand(mark))
+			}
+
+			// Assert
+			require.Len(t, agg.GetUncommittedEvents(), tt.wantOverdue)
+			require.Equal(t, tt.wantOverdue == 1, agg.GetItems()[0].Overdue)
+		})
+	}
+}
+
+func TestTodoListAggregate_ExecuteSetTodoDueDateCommand_UnknownTodo(t *testing.T) {
+	// Arrange
+	agg := aggregate.NewTodoListAggregate()
+	require.NoError(t, agg.ExecuteCreateTodoListCommand(command.CreateTodoListCommand{UserID: value.UserID("user123")}))
+
+	// Act
+	err := agg.ExecuteSetTodoDueDateCommand(command.SetTodoDueDateCommand{
+		AggregateID: agg.GetAggregateID(),
+		UserID:      value.UserID("user123"),
+		TodoID:      uuid.New(),
+		DueDate:     value.DueDate("2025-03-31"),
+	})
+
+	// Assert
+	require.ErrorIs(t, err, aggregate.ErrTodoNotFound)
+}
+
+func TestTodoListAggregate_ExecuteReorderTodoItemsCommand(t *testing.T) {
+	owner := value.UserID("user123")
+
+	tests := map[string]struct {
+		order         func(ids []uuid.UUID) []uuid.UUID
+		expectedError error
+		wantEvent     bool
+	}{
+		"reverse order": {
+			order: func(ids []uuid.UUID) []uuid.UUID {
+				return []uuid.UUID{ids[2], ids[1], ids[0]}
+			},
+			wantEvent: true,
+		},
+		"same order is a no-op": {
+			order: func(ids []uuid.UUID) []uuid.UUID {
+				return []uuid.UUID{ids[0], ids[1], ids[2]}
+			},
+		},
+		"missing todo": {
+			order: func(ids []uuid.UUID) []uuid.UUID {
+				return []uuid.UUID{ids[1], ids[0]}
+			},
+			expectedError: aggregate.ErrInvalidTodoOrder,
+		},
+		"duplicate todo": {
+			order: func(ids []uuid.UUID) []uuid.UUID {
+				return []uuid.UUID{ids[0], ids[0], ids[1]}
+			},
+			expectedError: aggregate.ErrInvalidTodoOrder,
+		},
+		"unknown todo": {
+			order: func(ids []uuid.UUID) []uuid.UUID {
+				return []uuid.UUID{ids[0], ids[1], uuid.New()}
+			},
+			expectedError: aggregate.ErrInvalidTodoOrder,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			agg := aggregate.NewTodoListAggregate()
+			require.NoError(t, agg.ExecuteCreateTodoListCommand(command.CreateTodoListCommand{UserID: owner}))
+			ids := make([]uuid.UUID, 0, 3)
+			for _, text := range []string{"first", "second", "third"} {
+				require.NoError(t, agg.ExecuteAddTodoCommand(command.AddTodoCommand{
+					AggregateID: agg.GetAggregateID(),
+					UserID:      owner,
+					TodoText:    value.TodoText(text),
+				}))
+				ids = append(ids, agg.GetItems()[len(agg.GetItems())-1].ID)
+			}
+			history := agg.GetUncommittedEvents()
+			agg.MarkEventsAsCommitted()
+			order := tt.order(ids)
+
+			// Act
+			err := agg.ExecuteReorderTodoItemsCommand(command.ReorderTodoItemsCommand{
+				AggregateID: agg.GetAggregateID(),
+				UserID:      owner,
+				TodoIDs:     order,
+			})
+
+			// Assert
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				require.Empty(t, agg.GetUncommittedEvents())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantEvent, len(agg.GetUncommittedEvents()) == 1)
+
+			got := make([]uuid.UUID, 0, len(agg.GetItems()))
+			for _, item := range agg.GetItems() {
+				got = append(got, item.ID)
+			}
+			require.Equal(t, order, got)
+
+			replayed := aggregate.NewTodoListAggregate()
+			require.NoError(t, replayed.Hydration(append(history, agg.GetUncommittedEvents()...)))
+			for i, item := range replayed.GetItems() {
+				require.Equal(t, order[i], item.ID)
+			}
+		})
+	}
+}
+
+func TestTodoListAggregate_ExecuteSetTodoPriorityCommand(t *testing.T) {
+	// Arrange
+	owner := value.UserID("user123")
+	agg := aggregate.NewTodoListAggregate()
+	require.NoError(t, agg.ExecuteCreateTodoListCommand(command.CreateTodoListCommand{UserID: owner}))
+	require.NoError(t, agg.ExecuteAddTodoCommand(command.AddTodoCommand{
+		AggregateID: agg.GetAggregateID(),
+		UserID:      owner,
+		TodoText:    value.TodoText("File taxes"),
+	}))
+	item := agg.GetItems()[0]
+	require.Equal(t, value.PriorityMedium, item.Priority)
+	agg.MarkEventsAsCommitted()
+
+	// Act
+	err := agg.ExecuteSetTodoPriorityCommand(command.SetTodoPriorityCommand{
+		AggregateID: agg.GetAggregateID(),
+		UserID:      owner,
+		TodoID:      item.ID,
+		Priority:    value.PriorityHigh,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, value.PriorityHigh, item.Priority)
+	require.Len(t, agg.GetUncommittedEvents(), 1)
+	require.IsType(t, event.TodoPriorityChangedEvent{}, agg.GetUncommittedEvents()[0])
+}
+
+func TestTodoListAggregate_Tags(t *testing.T) {
+	owner := value.UserID("user123")
+
+	tests := map[string]struct {
+		tag           []value.Tag
+		untag         []value.Tag
+		wantTags      []value.Tag
+		wantEvents    int
+		expectedError error
+	}{
+		"tag twice records one event": {
+			tag:        []value.Tag{"urgent", "urgent"},
+			wantTags:   []value.Tag{"urgent"},
+			wantEvents: 1,
+		},
+		"untag removes the tag": {
+			tag:        []value.Tag{"urgent", "home"},
+			untag:      []value.Tag{"urgent"},
+			wantTags:   []value.Tag{"home"},
+			wantEvents: 3,
+		},
+		"untag missing tag is a no-op": {
+			untag:      []value.Tag{"home"},
+			wantTags:   nil,
+			wantEvents: 0,
+		},
+		"too many tags": {
+			tag:           []value.Tag{"t0", "t1", "t2", "t3", "t4", "t5", "t6", "t7", "t8", "t9", "t10"},
+			expectedError: aggregate.ErrTooManyTags,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			agg := aggregate.NewTodoListAggregate()
+			require.NoError(t, agg.ExecuteCreateTodoListCommand(command.CreateTodoListCommand{UserID: owner}))
+			require.NoError(t, agg.ExecuteAddTodoCommand(command.AddTodoCommand{
+				AggregateID: agg.GetAggregateID(),
+				UserID:      owner,
+				TodoText:    value.TodoText("Water the plants"),
+			}))
+			todoID := agg.GetItems()[0].ID
+			agg.MarkEventsAsCommitted()
+
+			// Act
+			var err error
+			for _, tag := range tt.tag {
+				if err = agg.ExecuteTagTodoCommand(command.TagTodoCommand{AggregateID: agg.GetAggregateID(), UserID: owner, TodoID: todoID, Tag: tag}); err != nil {
+					break
+				}
+			}
+			for _, tag := range tt.untag {
+				require.NoError(t, agg.ExecuteUntagTodoCommand(command.UntagTodoCommand{AggregateID: agg.GetAggregateID(), UserID: owner, TodoID: todoID, Tag: tag}))
+			}
+
+			// Assert
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantTags, agg.GetItems()[0].Tags)
+			require.Len(t, agg.GetUncommittedEvents(), tt.wantEvents)
+		})
+	}
+}
+
+func TestTodoListAggregate_Checklist(t *testing.T) {
+	owner := value.UserID("user123")
+
+	tests := map[string]struct {
+		act           func(t *testing.T, agg *aggregate.TodoListAggregate, todoID uuid.UUID, entries []uuid.UUID)
+		wantCompleted bool
+		wantEvents    []string
+	}{
+		"completing every entry completes the todo": {
+			act: func(t *testing.T, agg *aggregate.TodoListAggregate, todoID uuid.UUID, entries []uuid.UUID) {
+				for _, id := range entries {
+					require.NoError(t, agg.ExecuteCompleteChecklistItemCommand(command.CompleteChecklistItemCommand{AggregateID: agg.GetAggregateID(), UserID: owner, TodoID: todoID, ChecklistItemID: id}))
+				}
+			},
+			wantCompleted: true,
+			wantEvents:    []string{"ChecklistItemCompletedEvent", "ChecklistItemCompletedEvent", "TodoCompletedEvent"},
+		},
+		"removing the only open entry completes the todo": {
+			act: func(t *testing.T, agg *aggregate.TodoListAggregate, todoID uuid.UUID, entries []uuid.UUID) {
+				require.NoError(t, agg.ExecuteCompleteChecklistItemCommand(command.CompleteChecklistItemCommand{AggregateID: agg.GetAggregateID(), UserID: owner, TodoID: todoID, ChecklistItemID: entries[0]}))
+				require.NoError(t, agg.ExecuteRemoveChecklistItemCommand(command.RemoveChecklistItemCommand{AggregateID: agg.GetAggregateID(), UserID: owner, TodoID: todoID, ChecklistItemID: entries[1]}))
+			},
+			wantCompleted: true,
+			wantEvents:    []string{"ChecklistItemCompletedEvent", "ChecklistItemRemovedEvent", "TodoCompletedEvent"},
+		},
+		"adding an entry reopens a completed todo": {
+			act: func(t *testing.T, agg *aggregate.TodoListAggregate, todoID uuid.UUID, entries []uuid.UUID) {
+				require.NoError(t, agg.ExecuteSetTodoCompletionCommand(command.SetTodoCompletionCommand{AggregateID: agg.GetAggregateID(), UserID: owner, TodoID: todoID, Completed: true}))
+				require.NoError(t, agg.ExecuteAddChecklistItemCommand(command.AddChecklistItemCommand{AggregateID: agg.GetAggregateID(), UserID: owner, TodoID: todoID, Text: value.TodoText("Pack")}))
+			},
+			wantCompleted: false,
+			wantEvents:    []string{"TodoCompletedEvent", "ChecklistItemAddedEvent", "TodoReopenedEvent"},
+		},
+		"explicit completion ignores open entries": {
+			act: func(t *testing.T, agg *aggregate.TodoListAggregate, todoID uuid.UUID, entries []uuid.UUID) {
+				require.NoError(t, agg.ExecuteSetTodoCompletionCommand(command.SetTodoCompletionCommand{AggregateID: agg.GetAggregateID(), UserID: owner, TodoID: todoID, Completed: true}))
+			},
+			wantCompleted: true,
+			wantEvents:    []string{"TodoCompletedEvent"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			agg := aggregate.NewTodoListAggregate()
+			require.NoError(t, agg.ExecuteCreateTodoListCommand(command.CreateTodoListCommand{UserID: owner}))
+			require.NoError(t, agg.ExecuteAddTodoCommand(command.AddTodoCommand{
+				AggregateID: agg.GetAggregateID(),
+				UserID:      owner,
+				TodoText:    value.TodoText("Move house"),
+			}))
+			item := agg.GetItems()[0]
+			for _, text := range []string{"Book van", "Label boxes"} {
+				require.NoError(t, agg.ExecuteAddChecklistItemCommand(command.AddChecklistItemCommand{
+					AggregateID: agg.GetAggregateID(),
+					UserID:      owner,
+					TodoID:      item.ID,
+					Text:        value.TodoText(text),
+				}))
+			}
+			entries := []uuid.UUID{item.Checklist[0].ID, item.Checklist[1].ID}
+			history := agg.GetUncommittedEvents()
+			agg.MarkEventsAsCommitted()
+
+			// Act
+			tt.act(t, agg, item.ID, entries)
+
+			// Assert
+			require.Equal(t, tt.wantCompleted, item.Completed)
+			types := make([]string, 0, len(agg.GetUncommittedEvents()))
+			for _, e := range agg.GetUncommittedEvents() {
+				types = append(types, e.GetEventType())
+			}
+			require.Equal(t, tt.wantEvents, types)
+
+			replayed := aggregate.NewTodoListAggregate()
+			require.NoError(t, replayed.Hydration(append(history, agg.GetUncommittedEvents()...)))
+			require.Equal(t, tt.wantCompleted, replayed.GetItems()[0].Completed)
+		})
+	}
+}
+
+func TestTodoListAggregate_RecurringTodos(t *testing.T) {
+	owner := value.UserID("user123")
+
+	tests := map[string]struct {
+		act        func(t *testing.T, agg *aggregate.TodoListAggregate, recurrenceID uuid.UUID) error
+		wantErr    error
+		wantItems  int
+		wantEvents []string
+	}{
+		"adds an occurrence once per day": {
+			act: func(t *testing.T, agg *aggregate.TodoListAggregate, recurrenceID uuid.UUID) error {
+				for range 2 {
+					if err := agg.ExecuteAddTodoCommand(command.AddTodoCommand{AggregateID: agg.GetAggregateID(), UserID: owner, TodoText: value.TodoText("Stand-up"), DueDate: value.DueDate("2025-03-31"), RecurrenceID: recurrenceID}); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			wantItems:  1,
+			wantEvents: []string{"TodoAddedEvent"},
+		},
+		"occurrences do not count against the todo limit": {
+			act: func(t *testing.T, agg *aggregate.TodoListAggregate, recurrenceID uuid.UUID) error {
+				for _, day := range []string{"2025-03-28", "2025-03-29", "2025-03-30", "2025-03-31"} {
+					if err := agg.ExecuteAddTodoCommand(command.AddTodoCommand{AggregateID: agg.GetAggregateID(), UserID: owner, TodoText: value.TodoText("Stand-up"), DueDate: value.DueDate(day), RecurrenceID: recurrenceID}); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			wantItems:  4,
+			wantEvents: []string{"TodoAddedEvent", "TodoAddedEvent", "TodoAddedEvent", "TodoAddedEvent"},
+		},
+		"an occurrence needs a due date": {
+			act: func(t *testing.T, agg *aggregate.TodoListAggregate, recurrenceID uuid.UUID) error {
+				return agg.ExecuteAddTodoCommand(command.AddTodoCommand{AggregateID: agg.GetAggregateID(), UserID: owner, TodoText: value.TodoText("Stand-up"), RecurrenceID: recurrenceID})
+			},
+			wantErr:    aggregate.ErrOccurrenceDueDate,
+			wantEvents: []string{},
+		},
+		"a cancelled recurring todo adds no more occurrences": {
+			act: func(t *testing.T, agg *aggregate.TodoListAggregate, recurrenceID uuid.UUID) error {
+				require.NoError(t, agg.ExecuteCancelRecurringTodoCommand(command.CancelRecurringTodoCommand{AggregateID: agg.GetAggregateID(), UserID: owner, RecurrenceID: recurrenceID}))
+				return agg.ExecuteAddTodoCommand(command.AddTodoCommand{AggregateID: agg.GetAggregateID(), UserID: owner, TodoText: value.TodoText("Stand-up"), DueDate: value.DueDate("2025-03-31"), RecurrenceID: recurrenceID})
+			},
+			wantErr:    aggregate.ErrRecurringNotFound,
+			wantEvents: []string{"RecurringTodoCancelledEvent"},
+		},
+		"viewers cannot cancel a recurring todo": {
+			act: func(t *testing.T, agg *aggregate.TodoListAggregate, recurrenceID uuid.UUID) error {
+				return agg.ExecuteCancelRecurringTodoCommand(command.CancelRecurringTodoCommand{AggregateID: agg.GetAggregateID(), UserID: value.UserID("stranger"), RecurrenceID: recurrenceID})
+			},
+			wantErr:    aggregate.ErrNotListEditor,
+			wantEvents: []string{},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			agg := aggregate.NewTodoListAggregate()
+			require.NoError(t, agg.ExecuteCreateTodoListCommand(command.CreateTodoListCommand{UserID: owner}))
+			require.NoError(t, agg.ExecuteScheduleRecurringTodoCommand(command.ScheduleRecurringTodoCommand{
+				AggregateID: agg.GetAggregateID(),
+				UserID:      owner,
+				TodoText:    value.TodoText("Stand-up"),
+				Rule:        value.RecurrenceRule("FREQ=DAILY;INTERVAL=1"),
+				StartDate:   value.DueDate("2025-03-01"),
+			}))
+			var recurrenceID uuid.UUID
+			for id := range agg.GetRecurringTodos() {
+				recurrenceID = id
+			}
+			history := agg.GetUncommittedEvents()
+			agg.MarkEventsAsCommitted()
+
+			// Act
+			err := tt.act(t, agg, recurrenceID)
+
+			// Assert
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Len(t, agg.GetItems(), tt.wantItems)
+			types := make([]string, 0, len(agg.GetUncommittedEvents()))
+			for _, e := range agg.GetUncommittedEvents() {
+				types = append(types, e.GetEventType())
+			}
+			require.Equal(t, tt.wantEvents, types)
+
+			replayed := aggregate.NewTodoListAggregate()
+			require.NoError(t, replayed.Hydration(append(history, agg.GetUncommittedEvents()...)))
+			require.Len(t, replayed.GetItems(), tt.wantItems)
+		})
+	}
+}
+
+func TestTodoListAggregate_RecurringTodos_ReplayedOccurrence(t *testing.T) {
+	// Arrange
+	owner := value.UserID("user123")
+	agg := aggregate.NewTodoListAggregate()
+	require.NoError(t, agg.ExecuteCreateTodoListCommand(command.CreateTodoListCommand{UserID: owner}))
+	require.NoError(t, agg.ExecuteScheduleRecurringTodoCommand(command.ScheduleRecurringTodoCommand{
+		AggregateID: agg.GetAggregateID(),
+		UserID:      owner,
+		TodoText:    value.TodoText("Stand-up"),
+		Rule:        value.RecurrenceRule("FREQ=DAILY;INTERVAL=1"),
+		StartDate:   value.DueDate("2025-03-01"),
+	}))
+	recurrenceID := agg.GetUncommittedEvents()[1].(event.RecurringTodoScheduledEvent).RecurrenceID
+	occurrence := command.AddTodoCommand{
+		AggregateID:  agg.GetAggregateID(),
+		UserID:       owner,
+		TodoText:     value.TodoText("Stand-up"),
+		DueDate:      value.DueDate("2025-03-31"),
+		RecurrenceID: recurrenceID,
+	}
+	require.NoError(t, agg.ExecuteAddTodoCommand(occurrence))
+
+	// A restarted scheduler works on a list rebuilt from its events.
+	replayed := aggregate.NewTodoListAggregate()
+	require.NoError(t, replayed.Hydration(agg.GetUncommittedEvents()))
+
+	// Act
+	err := replayed.ExecuteAddTodoCommand(occurrence)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, replayed.GetItems(), 1)
+	require.Empty(t, replayed.GetUncommittedEvents())
+	require.Equal(t, value.DueDate("2025-03-31"), replayed.GetRecurringTodos()[recurrenceID].LastOccurrence)
+}
+
+func TestTodoListAggregate_MoveTodo(t *testing.T) {
+	owner := value.UserID("user123")
+
+	tests := map[string]struct {
+		targetTodos    int
+		sameList       bool
+		wantErr        error
+		wantSourceTodo bool
+	}{
+		"moves the todo with its state": {
+			targetTodos: 2,
+		},
+		"respects the target's todo limit": {
+			targetTodos:    3,
+			wantErr:        aggregate.ErrTooManyTodos,
+			wantSourceTodo: true,
+		},
+		"rejects moving to the same list": {
+			sameList:       true,
+			wantErr:        aggregate.ErrMoveToSameList,
+			wantSourceTodo: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			source := aggregate.NewTodoListAggregate()
+			require.NoError(t, source.ExecuteCreateTodoListCommand(command.CreateTodoListCommand{UserID: owner}))
+			require.NoError(t, source.ExecuteAddTodoCommand(command.AddTodoCommand{
+				AggregateID: source.GetAggregateID(),
+				UserID:      owner,
+				TodoText:    value.TodoText("Move house"),
+				DueDate:     value.DueDate("2025-03-30"),
+				Priority:    value.PriorityHigh,
+			}))
+			todo := source.GetItems()[0]
+			require.NoError(t, source.ExecuteTagTodoCommand(command.TagTodoCommand{AggregateID: source.GetAggregateID(), UserID: owner, TodoID: todo.ID, Tag: value.Tag("home")}))
+			require.NoError(t, source.ExecuteAddChecklistItemCommand(command.AddChecklistItemCommand{AggregateID: source.GetAggregateID(), UserID: owner, TodoID: todo.ID, Text: value.TodoText("Book van")}))
+			require.NoError(t, source.ExecuteCompleteChecklistItemCommand(command.CompleteChecklistItemCommand{AggregateID: source.GetAggregateID(), UserID: owner, TodoID: todo.ID, ChecklistItemID: todo.Checklist[0].ID}))
+
+			target := aggregate.NewTodoListAggregate()
+			require.NoError(t, target.ExecuteCreateTodoListCommand(command.CreateTodoListCommand{UserID: owner}))
+			for i := range tt.targetTodos {
+				require.NoError(t, target.ExecuteAddTodoCommand(command.AddTodoCommand{
+					AggregateID: target.GetAggregateID(),
+					UserID:      owner,
+					TodoText:    value.TodoText(fmt.Sprintf("Todo %d", i)),
+				}))
+			}
+			if tt.sameList {
+				target = source
+			}
+			sourceHistory := source.GetUncommittedEvents()
+			targetHistory := target.GetUncommittedEvents()
+			source.MarkEventsAsCommitted()
+			target.MarkEventsAsCommitted()
+
+			// Act
+			err := source.ExecuteMoveTodoOutCommand(command.MoveTodoOutCommand{
+				AggregateID:       source.GetAggregateID(),
+				UserID:            owner,
+				TodoID:            todo.ID,
+				TargetAggregateID: target.GetAggregateID(),
+			})
+			if err == nil {
+				err = target.ExecuteMoveTodoInCommand(command.MoveTodoInCommand{
+					AggregateID:       target.GetAggregateID(),
+					UserID:            owner,
+					SourceAggregateID: source.GetAggregateID(),
+					Todo:              todo,
+				})
+			}
+
+			// Assert
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				// The use case discards both lists, so only the saved
+				// history counts.
+				replayed := aggregate.NewTodoListAggregate()
+				require.NoError(t, replayed.Hydration(sourceHistory))
+				require.Equal(t, tt.wantSourceTodo, replayed.FindItem(todo.ID) != nil)
+				return
+			}
+			require.NoError(t, err)
+
+			replayedSource := aggregate.NewTodoListAggregate()
+			require.NoError(t, replayedSource.Hydration(append(sourceHistory, source.GetUncommittedEvents()...)))
+			replayedTarget := aggregate.NewTodoListAggregate()
+			require.NoError(t, replayedTarget.Hydration(append(targetHistory, target.GetUncommittedEvents()...)))
+			for _, list := range []*aggregate.TodoListAggregate{source, replayedSource} {
+				require.Nil(t, list.FindItem(todo.ID))
+			}
+			for _, list := range []*aggregate.TodoListAggregate{target, replayedTarget} {
+				moved := list.FindItem(todo.ID)
+				require.NotNil(t, moved)
+				require.Len(t, list.GetItems(), tt.targetTodos+1)
+				require.Equal(t, value.TodoText("Move house"), moved.Text)
+				require.Equal(t, value.DueDate("2025-03-30"), moved.DueDate)
+				require.Equal(t, value.PriorityHigh, moved.Priority)
+				require.Equal(t, []value.Tag{"home"}, moved.Tags)
+				require.True(t, moved.Completed)
+				require.Len(t, moved.Checklist, 1)
+				require.True(t, moved.Checklist[0].Completed)
+			}
+		})
+	}
+}
+
+func dueDatePtr(date string) *value.DueDate {
+	d := value.DueDate(date)
+	return &d
+}
@@ -0,0 +1,366 @@
+package aggregate
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+)
+
+// undoableCommand is the run of events one command recorded, with the user
+// who issued it.
+type undoableCommand struct {
+	actor  value.UserID
+	events []event.Event
+}
+
+// ExecuteUndoCommand reverts the last command recorded on the list by
+// appending compensating events; the history itself is never rewritten.
+// Only the user who issued the command can undo it, and only within
+// cmd.Window. Undoing again reverts the command before it.
+func (a *TodoListAggregate) ExecuteUndoCommand(cmd command.UndoCommand) error {
+	if a.deleted {
+		return ErrTodoListDeleted
+	}
+	if len(a.undoable) == 0 {
+		return ErrNothingToUndo
+	}
+
+	last := a.undoable[len(a.undoable)-1]
+	first := last.events[0]
+	if last.actor != cmd.UserID {
+		return ErrUndoNotActor
+	}
+	if cmd.Now.Sub(first.GetTimestamp()) > cmd.Window {
+		return ErrUndoWindowExpired
+	}
+	// Archiving is the one command that can be undone on an archived list.
+	if _, archiving := first.(event.TodoListArchivedEvent); a.archived && !archiving {
+		return ErrTodoListArchived
+	}
+
+	// The state before the command tells what the compensating events must
+	// restore.
+	start := slices.IndexFunc(a.history, func(evt event.Event) bool {
+		return evt.GetEventID() == first.GetEventID()
+	})
+	before := NewTodoListAggregate()
+	if err := before.Hydration(a.history[:start]); err != nil {
+		return err
+	}
+
+	for i := len(last.events) - 1; i >= 0; i-- {
+		for _, evt := range a.compensate(last.events[i], before, cmd.UserID) {
+			if err := a.applyEvent(evt, true); err != nil {
+				return err
+			}
+		}
+	}
+
+	evt := event.CommandUndoneEvent{
+		AggregateID:       cmd.AggregateID,
+		UserID:            cmd.UserID,
+		UndoneFromVersion: first.GetVersion(),
+		UndoneToVersion:   last.events[len(last.events)-1].GetVersion(),
+		EventID:           uuid.New(),
+		Timestamp:         time.Now(),
+		Version:           a.version + 1,
+	}
+
+	return a.applyEvent(evt, true)
+}
+
+// trackUndoable keeps the stack of commands that can be undone up to date as
+// events are applied, both when replaying and when executing commands.
+func (a *TodoListAggregate) trackUndoable(evt event.Event) {
+	switch e := evt.(type) {
+	case event.TodoListCreatedEvent, event.TodoListDeletedEvent:
+		// Neither creating nor deleting a list can be undone.
+		a.undoable = nil
+		return
+	case event.TodoMovedOutEvent, event.TodoMovedInEvent:
+		// A move changed another list as well, so undoing it, or anything
+		// recorded before it, on this list alone would leave the two apart.
+		a.undoable = nil
+		return
+	case event.TodoCompletedEvent:
+		if e.Derived {
+			a.extendUndoable(evt)
+			return
+		}
+	case event.TodoReopenedEvent:
+		if e.Derived {
+			a.extendUndoable(evt)
+			return
+		}
+	case event.CommandUndoneEvent:
+		// Drop the compensating events and the command they reverted.
+		for len(a.undoable) > 0 {
+			top := a.undoable[len(a.undoable)-1]
+			a.undoable = a.undoable[:len(a.undoable)-1]
+			if top.events[0].GetVersion() == e.UndoneFromVersion {
+				break
+			}
+		}
+		return
+	}
+
+	userEvt, ok := evt.(event.UserEvent)
+	if !ok {
+		// System events, such as overdue reports, are not commands.
+		return
+	}
+	a.undoable = append(a.undoable, undoableCommand{
+		actor:  userEvt.GetUserID(),
+		events: []event.Event{evt},
+	})
+}
+
+// extendUndoable adds an event derived from the last command to it.
+func (a *TodoListAggregate) extendUndoable(evt event.Event) {
+	if len(a.undoable) == 0 {
+		return
+	}
+	top := &a.undoable[len(a.undoable)-1]
+	top.events = append(top.events, evt)
+}
+
+// compensate returns the events that revert evt, given the list as it was
+// before the command that recorded evt. The events are numbered from the
+// current version on, in the order they must be applied.
+func (a *TodoListAggregate) compensate(evt event.Event, before *TodoListAggregate, userID value.UserID) []event.Event {
+	version := a.version
+	next := func() int {
+		version++
+		return version
+	}
+
+	switch e := evt.(type) {
+	case event.TodoListRenamedEvent:
+		return []event.Event{event.TodoListRenamedEvent{
+			AggregateID: e.AggregateID,
+			UserID:      userID,
+			Title:       before.title,
+			Description: before.description,
+			EventID:     uuid.New(),
+			Timestamp:   time.Now(),
+			Version:     next(),
+		}}
+	case event.TodoAddedEvent:
+		return []event.Event{event.TodoRemovedEvent{
+			AggregateID: e.AggregateID,
+			UserID:      userID,
+			TodoID:      e.ItemID(),
+			EventID:     uuid.New(),
+			Timestamp:   time.Now(),
+			Version:     next(),
+		}}
+	case event.TodoDueDateSetEvent:
+		var dueDate value.DueDate
+		if item := before.findItem(e.TodoID); item != nil {
+			dueDate = item.DueDate
+		}
+		return []event.Event{event.TodoDueDateSetEvent{
+			AggregateID: e.AggregateID,
+			UserID:      userID,
+			TodoID:      e.TodoID,
+			DueDate:     dueDate,
+			EventID:     uuid.New(),
+			Timestamp:   time.Now(),
+			Version:     next(),
+		}}
+	case event.TodoPriorityChangedEvent:
+		priority := value.PriorityMedium
+		if item := before.findItem(e.TodoID); item != nil {
+			priority = item.Priority
+		}
+		return []event.Event{event.TodoPriorityChangedEvent{
+			AggregateID: e.AggregateID,
+			UserID:      userID,
+			TodoID:      e.TodoID,
+			Priority:    priority,
+			EventID:     uuid.New(),
+			Timestamp:   time.Now(),
+			Version:     next(),
+		}}
+	case event.TodoItemsReorderedEvent:
+		todoIDs := make([]uuid.UUID, 0, len(before.items))
+		for _, item := range before.items {
+			todoIDs = append(todoIDs, item.ID)
+		}
+		return []event.Event{event.TodoItemsReorderedEvent{
+			AggregateID: e.AggregateID,
+			UserID:      userID,
+			TodoIDs:     todoIDs,
+			EventID:     uuid.New(),
+			Timestamp:   time.Now(),
+			Version:     next(),
+		}}
+	case event.TodoTaggedEvent:
+		return []event.Event{event.TodoUntaggedEvent{
+			AggregateID: e.AggregateID,
+			UserID:      userID,
+			TodoID:      e.TodoID,
+			Tag:         e.Tag,
+			EventID:     uuid.New(),
+			Timestamp:   time.Now(),
+			Version:     next(),
+		}}
+	case event.TodoUntaggedEvent:
+		return []event.Event{event.TodoTaggedEvent{
+			AggregateID: e.AggregateID,
+			UserID:      userID,
+			TodoID:      e.TodoID,
+			Tag:         e.Tag,
+			EventID:     uuid.New(),
+			Timestamp:   time.Now(),
+			Version:     next(),
+		}}
+	case event.TodoCompletedEvent:
+		return []event.Event{event.TodoReopenedEvent{
+			AggregateID: e.AggregateID,
+			UserID:      userID,
+			TodoID:      e.TodoID,
+			EventID:     uuid.New(),
+			Timestamp:   time.Now(),
+			Version:     next(),
+		}}
+	case event.TodoReopenedEvent:
+		return []event.Event{event.TodoCompletedEvent{
+			AggregateID: e.AggregateID,
+			UserID:      userID,
+			TodoID:      e.TodoID,
+			EventID:     uuid.New(),
+			Timestamp:   time.Now(),
+			Version:     next(),
+		}}
+	case event.ChecklistItemAddedEvent:
+		return []event.Event{event.ChecklistItemRemovedEvent{
+			AggregateID:     e.AggregateID,
+			UserID:          userID,
+			TodoID:          e.TodoID,
+			ChecklistItemID: e.ChecklistItemID,
+			EventID:         uuid.New(),
+			Timestamp:       time.Now(),
+			Version:         next(),
+		}}
+	case event.ChecklistItemCompletedEvent:
+		return []event.Event{event.ChecklistItemReopenedEvent{
+			AggregateID:     e.AggregateID,
+			UserID:          userID,
+			TodoID:          e.TodoID,
+			ChecklistItemID: e.ChecklistItemID,
+			EventID:         uuid.New(),
+			Timestamp:       time.Now(),
+			Version:         next(),
+		}}
+	case event.ChecklistItemRemovedEvent:
+		item := before.findItem(e.TodoID)
+		if item == nil {
+			return nil
+		}
+		entry := item.FindChecklistItem(e.ChecklistItemID)
+		if entry == nil {
+			return nil
+		}
+		// The entry comes back at the end of the checklist.
+		evts := []event.Event{event.ChecklistItemAddedEvent{
+			AggregateID:     e.AggregateID,
+			UserID:          userID,
+			TodoID:          e.TodoID,
+			ChecklistItemID: entry.ID,
+			Text:            entry.Text,
+			EventID:         uuid.New(),
+			Timestamp:       time.Now(),
+			Version:         next(),
+		}}
+		if entry.Completed {
+			evts = append(evts, event.ChecklistItemCompletedEvent{
+				AggregateID:     e.AggregateID,
+				UserID:          userID,
+				TodoID:          e.TodoID,
+				ChecklistItemID: entry.ID,
+				EventID:         uuid.New(),
+				Timestamp:       time.Now(),
+				Version:         next(),
+			})
+		}
+		return evts
+	case event.RecurringTodoScheduledEvent:
+		return []event.Event{event.RecurringTodoCancelledEvent{
+			AggregateID:  e.AggregateID,
+			UserID:       userID,
+			RecurrenceID: e.RecurrenceID,
+			EventID:      uuid.New(),
+			Timestamp:    time.Now(),
+			Version:      next(),
+		}}
+	case event.RecurringTodoCancelledEvent:
+		recurring, ok := before.recurring[e.RecurrenceID]
+		if !ok {
+			return nil
+		}
+		return []event.Event{event.RecurringTodoScheduledEvent{
+			AggregateID:  e.AggregateID,
+			UserID:       userID,
+			RecurrenceID: recurring.ID,
+			TodoText:     recurring.Text,
+			Rule:         recurring.Rule,
+			StartDate:    recurring.StartDate,
+			Priority:     recurring.Priority,
+			EventID:      uuid.New(),
+			Timestamp:    time.Now(),
+			Version:      next(),
+		}}
+	case event.CollaboratorInvitedEvent:
+		return []event.Event{event.CollaboratorRemovedEvent{
+			AggregateID:    e.AggregateID,
+			UserID:         userID,
+			CollaboratorID: e.CollaboratorID,
+			EventID:        uuid.New(),
+			Timestamp:      time.Now(),
+			Version:        next(),
+		}}
+	case event.CollaboratorRoleChangedEvent:
+		return []event.Event{event.CollaboratorRoleChangedEvent{
+			AggregateID:    e.AggregateID,
+			UserID:         userID,
+			CollaboratorID: e.CollaboratorID,
+			Role:           before.collaborators[e.CollaboratorID],
+			EventID:        uuid.New(),
+			Timestamp:      time.Now(),
+			Version:        next(),
+		}}
+	case event.CollaboratorRemovedEvent:
+		return []event.Event{event.CollaboratorInvitedEvent{
+			AggregateID:    e.AggregateID,
+			UserID:         userID,
+			CollaboratorID: e.CollaboratorID,
+			Role:           before.collaborators[e.CollaboratorID],
+			EventID:        uuid.New(),
+			Timestamp:      time.Now(),
+			Version:        next(),
+		}}
+	case event.TodoListArchivedEvent:
+		return []event.Event{event.TodoListRestoredEvent{
+			AggregateID: e.AggregateID,
+			UserID:      userID,
+			EventID:     uuid.New(),
+			Timestamp:   time.Now(),
+			Version:     next(),
+		}}
+	case event.TodoListRestoredEvent:
+		return []event.Event{event.TodoListArchivedEvent{
+			AggregateID: e.AggregateID,
+			UserID:      userID,
+			EventID:     uuid.New(),
+			Timestamp:   time.Now(),
+			Version:     next(),
+		}}
+	}
+
+	return nil
+}
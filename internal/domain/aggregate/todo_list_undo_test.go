@@ -0,0 +1,165 @@
+package aggregate_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+)
+
+func TestTodoListAggregate_ExecuteUndoCommand(t *testing.T) {
+	owner := value.UserID("user123")
+	editor := value.UserID("editor1")
+
+	addTodo := func(t *testing.T, agg *aggregate.TodoListAggregate, userID value.UserID, text string) {
+		t.Helper()
+		require.NoError(t, agg.ExecuteAddTodoCommand(command.AddTodoCommand{
+			AggregateID: agg.GetAggregateID(),
+			UserID:      userID,
+			TodoText:    value.TodoText(text),
+			DueDate:     value.DueDate("2025-03-30"),
+		}))
+	}
+	undo := func(agg *aggregate.TodoListAggregate, userID value.UserID, now time.Time) error {
+		return agg.ExecuteUndoCommand(command.UndoCommand{
+			AggregateID: agg.GetAggregateID(),
+			UserID:      userID,
+			Now:         now,
+			Window:      5 * time.Minute,
+		})
+	}
+
+	tests := map[string]struct {
+		arrange    func(t *testing.T, agg *aggregate.TodoListAggregate)
+		act        func(agg *aggregate.TodoListAggregate) error
+		wantErr    error
+		wantItems  []string
+		wantEvents []string
+	}{
+		"removes the todo the last command added": {
+			arrange: func(t *testing.T, agg *aggregate.TodoListAggregate) {
+				addTodo(t, agg, owner, "Buy milk")
+				addTodo(t, agg, owner, "Walk dog")
+			},
+			act:        func(agg *aggregate.TodoListAggregate) error { return undo(agg, owner, time.Now()) },
+			wantItems:  []string{"Buy milk"},
+			wantEvents: []string{"TodoRemovedEvent", "CommandUndoneEvent"},
+		},
+		"undoing again reverts the command before": {
+			arrange: func(t *testing.T, agg *aggregate.TodoListAggregate) {
+				addTodo(t, agg, owner, "Buy milk")
+				addTodo(t, agg, owner, "Walk dog")
+			},
+			act: func(agg *aggregate.TodoListAggregate) error {
+				if err := undo(agg, owner, time.Now()); err != nil {
+					return err
+				}
+				return undo(agg, owner, time.Now())
+			},
+			wantItems:  []string{},
+			wantEvents: []string{"TodoRemovedEvent", "CommandUndoneEvent", "TodoRemovedEvent", "CommandUndoneEvent"},
+		},
+		"reverts events derived from the command": {
+			arrange: func(t *testing.T, agg *aggregate.TodoListAggregate) {
+				addTodo(t, agg, owner, "Move house")
+				todoID := agg.GetItems()[0].ID
+				require.NoError(t, agg.ExecuteAddChecklistItemCommand(command.AddChecklistItemCommand{AggregateID: agg.GetAggregateID(), UserID: owner, TodoID: todoID, Text: value.TodoText("Book van")}))
+				entryID := agg.GetItems()[0].Checklist[0].ID
+				require.NoError(t, agg.ExecuteCompleteChecklistItemCommand(command.CompleteChecklistItemCommand{AggregateID: agg.GetAggregateID(), UserID: owner, TodoID: todoID, ChecklistItemID: entryID}))
+				require.True(t, agg.GetItems()[0].Completed)
+			},
+			act:        func(agg *aggregate.TodoListAggregate) error { return undo(agg, owner, time.Now()) },
+			wantItems:  []string{"Move house"},
+			wantEvents: []string{"TodoReopenedEvent", "ChecklistItemReopenedEvent", "CommandUndoneEvent"},
+		},
+		"skips system events": {
+			arrange: func(t *testing.T, agg *aggregate.TodoListAggregate) {
+				addTodo(t, agg, owner, "Buy milk")
+				require.NoError(t, agg.ExecuteMarkOverdueTodosCommand(command.MarkOverdueTodosCommand{
+					AggregateID: agg.GetAggregateID(),
+					Now:         time.Date(2025, 3, 31, 9, 0, 0, 0, time.UTC),
+				}))
+			},
+			act:        func(agg *aggregate.TodoListAggregate) error { return undo(agg, owner, time.Now()) },
+			wantItems:  []string{},
+			wantEvents: []string{"TodoRemovedEvent", "CommandUndoneEvent"},
+		},
+		"restores an archived list": {
+			arrange: func(t *testing.T, agg *aggregate.TodoListAggregate) {
+				require.NoError(t, agg.ExecuteArchiveTodoListCommand(command.ArchiveTodoListCommand{AggregateID: agg.GetAggregateID(), UserID: owner}))
+			},
+			act:        func(agg *aggregate.TodoListAggregate) error { return undo(agg, owner, time.Now()) },
+			wantItems:  []string{},
+			wantEvents: []string{"TodoListRestoredEvent", "CommandUndoneEvent"},
+		},
+		"only the user who issued the command can undo it": {
+			arrange: func(t *testing.T, agg *aggregate.TodoListAggregate) {
+				require.NoError(t, agg.ExecuteInviteCollaboratorCommand(command.InviteCollaboratorCommand{AggregateID: agg.GetAggregateID(), UserID: owner, CollaboratorID: editor, Role: value.RoleEditor}))
+				addTodo(t, agg, editor, "Buy milk")
+			},
+			act:        func(agg *aggregate.TodoListAggregate) error { return undo(agg, owner, time.Now()) },
+			wantErr:    aggregate.ErrUndoNotActor,
+			wantItems:  []string{"Buy milk"},
+			wantEvents: []string{},
+		},
+		"rejects commands older than the window": {
+			arrange: func(t *testing.T, agg *aggregate.TodoListAggregate) {
+				addTodo(t, agg, owner, "Buy milk")
+			},
+			act:        func(agg *aggregate.TodoListAggregate) error { return undo(agg, owner, time.Now().Add(time.Hour)) },
+			wantErr:    aggregate.ErrUndoWindowExpired,
+			wantItems:  []string{"Buy milk"},
+			wantEvents: []string{},
+		},
+		"creating the list cannot be undone": {
+			arrange:    func(t *testing.T, agg *aggregate.TodoListAggregate) {},
+			act:        func(agg *aggregate.TodoListAggregate) error { return undo(agg, owner, time.Now()) },
+			wantErr:    aggregate.ErrNothingToUndo,
+			wantItems:  []string{},
+			wantEvents: []string{},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			agg := aggregate.NewTodoListAggregate()
+			require.NoError(t, agg.ExecuteCreateTodoListCommand(command.CreateTodoListCommand{UserID: owner}))
+			tt.arrange(t, agg)
+			history := agg.GetUncommittedEvents()
+			agg.MarkEventsAsCommitted()
+
+			// Act
+			err := tt.act(agg)
+
+			// Assert
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			types := make([]string, 0, len(agg.GetUncommittedEvents()))
+			for _, e := range agg.GetUncommittedEvents() {
+				types = append(types, e.GetEventType())
+			}
+			require.Equal(t, tt.wantEvents, types)
+			require.False(t, agg.IsArchived())
+
+			replayed := aggregate.NewTodoListAggregate()
+			require.NoError(t, replayed.Hydration(append(history, agg.GetUncommittedEvents()...)))
+			for _, a := range []*aggregate.TodoListAggregate{agg, replayed} {
+				texts := make([]string, 0, len(a.GetItems()))
+				for _, item := range a.GetItems() {
+					require.False(t, item.Completed)
+					texts = append(texts, item.Text.String())
+				}
+				require.Equal(t, tt.wantItems, texts)
+			}
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package command
+
+import (
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+)
+
+type AddChecklistItemCommand struct {
+	AggregateID uuid.UUID
+	UserID      value.UserID
+	TodoID      uuid.UUID
+	Text        value.TodoText
+}
@@ -9,4 +9,9 @@ type AddTodoCommand struct {
 	AggregateID uuid.UUID
 	UserID      value.UserID
 	TodoText    value.TodoText
+	DueDate     value.DueDate
+	Priority    value.Priority
+	// RecurrenceID marks the todo as the occurrence of a recurring todo due
+	// on DueDate. Adding the same occurrence twice is a no-op.
+	RecurrenceID uuid.UUID
 }
@@ -0,0 +1,11 @@
+package command
+
+import (
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+)
+
+type ArchiveTodoListCommand struct {
+	AggregateID uuid.UUID
+	UserID      value.UserID
+}
@@ -3,5 +3,7 @@ package command
 import "github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
 
 type CreateTodoListCommand struct {
-	UserID value.UserID
+	UserID      value.UserID
+	Title       value.TodoListTitle
+	Description value.TodoListDescription
 }
@@ -0,0 +1,21 @@
+package command
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MarkOverdueTodosCommand is issued by the overdue scheduler with the time of
+// the check, so the aggregate does not read the clock itself.
+type MarkOverdueTodosCommand struct {
+	AggregateID uuid.UUID
+	Now         time.Time
+}
+
+// IdempotencyPayload leaves out Now, which differs between retries of one
+// check.
+func (c MarkOverdueTodosCommand) IdempotencyPayload() any {
+	c.Now = time.Time{}
+	return c
+}
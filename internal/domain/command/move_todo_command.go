@@ -0,0 +1,25 @@
+package command
+
+import (
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/entity"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+)
+
+// MoveTodoOutCommand takes a todo off its list so it can be moved to the list
+// TargetAggregateID.
+type MoveTodoOutCommand struct {
+	AggregateID       uuid.UUID
+	UserID            value.UserID
+	TodoID            uuid.UUID
+	TargetAggregateID uuid.UUID
+}
+
+// MoveTodoInCommand adds Todo, as it was on the list SourceAggregateID, to
+// the list.
+type MoveTodoInCommand struct {
+	AggregateID       uuid.UUID
+	UserID            value.UserID
+	SourceAggregateID uuid.UUID
+	Todo              *entity.TodoItem
+}
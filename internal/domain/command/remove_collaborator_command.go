@@ -0,0 +1,12 @@
+package command
+
+import (
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+)
+
+type RemoveCollaboratorCommand struct {
+	AggregateID    uuid.UUID
+	UserID         value.UserID
+	CollaboratorID value.UserID
+}
@@ -0,0 +1,15 @@
+package command
+
+import (
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+)
+
+// RenameTodoListCommand changes the title and/or description of a list. A nil
+// field keeps its current value.
+type RenameTodoListCommand struct {
+	AggregateID uuid.UUID
+	UserID      value.UserID
+	Title       *value.TodoListTitle
+	Description *value.TodoListDescription
+}
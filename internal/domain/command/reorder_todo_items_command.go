@@ -0,0 +1,14 @@
+package command
+
+import (
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+)
+
+// ReorderTodoItemsCommand replaces the order of a list's todos. TodoIDs must
+// name every todo on the list exactly once.
+type ReorderTodoItemsCommand struct {
+	AggregateID uuid.UUID
+	UserID      value.UserID
+	TodoIDs     []uuid.UUID
+}
@@ -0,0 +1,15 @@
+package command
+
+import (
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+)
+
+type ScheduleRecurringTodoCommand struct {
+	AggregateID uuid.UUID
+	UserID      value.UserID
+	TodoText    value.TodoText
+	Rule        value.RecurrenceRule
+	StartDate   value.DueDate
+	Priority    value.Priority
+}
@@ -0,0 +1,15 @@
+package command
+
+import (
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+)
+
+// SetTodoCompletionCommand completes or reopens a todo explicitly, whatever
+// the state of its checklist.
+type SetTodoCompletionCommand struct {
+	AggregateID uuid.UUID
+	UserID      value.UserID
+	TodoID      uuid.UUID
+	Completed   bool
+}
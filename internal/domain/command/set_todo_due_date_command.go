@@ -0,0 +1,14 @@
+package command
+
+import (
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+)
+
+// SetTodoDueDateCommand sets or, with a zero DueDate, clears a todo's due date.
+type SetTodoDueDateCommand struct {
+	AggregateID uuid.UUID
+	UserID      value.UserID
+	TodoID      uuid.UUID
+	DueDate     value.DueDate
+}
@@ -0,0 +1,13 @@
+package command
+
+import (
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+)
+
+type TagTodoCommand struct {
+	AggregateID uuid.UUID
+	UserID      value.UserID
+	TodoID      uuid.UUID
+	Tag         value.Tag
+}
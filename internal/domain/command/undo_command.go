@@ -0,0 +1,24 @@
+package command
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+)
+
+// UndoCommand reverts the last command recorded on a list. Now and Window are
+// passed in, so the aggregate does not read the clock or the configuration.
+type UndoCommand struct {
+	AggregateID uuid.UUID
+	UserID      value.UserID
+	Now         time.Time
+	Window      time.Duration
+}
+
+// IdempotencyPayload leaves out Now, which differs between retries of one
+// request.
+func (c UndoCommand) IdempotencyPayload() any {
+	c.Now = time.Time{}
+	return c
+}
@@ -0,0 +1,20 @@
+package entity
+
+import (
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+)
+
+// ChecklistItem is one step of a multi-step todo.
+type ChecklistItem struct {
+	ID        uuid.UUID
+	Text      value.TodoText
+	Completed bool
+}
+
+func NewChecklistItem(id uuid.UUID, text value.TodoText) *ChecklistItem {
+	return &ChecklistItem{
+		ID:   id,
+		Text: text,
+	}
+}
@@ -0,0 +1,28 @@
+package entity
+
+import (
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+)
+
+// RecurringTodo is a template from which a todo is added on every day its
+// rule occurs, starting at StartDate.
+type RecurringTodo struct {
+	ID        uuid.UUID
+	Text      value.TodoText
+	Rule      value.RecurrenceRule
+	StartDate value.DueDate
+	Priority  value.Priority
+	// LastOccurrence is the latest day a todo was added for this template.
+	LastOccurrence value.DueDate
+}
+
+func NewRecurringTodo(id uuid.UUID, text value.TodoText, rule value.RecurrenceRule, startDate value.DueDate, priority value.Priority) *RecurringTodo {
+	return &RecurringTodo{
+		ID:        id,
+		Text:      text,
+		Rule:      rule,
+		StartDate: startDate,
+		Priority:  priority,
+	}
+}
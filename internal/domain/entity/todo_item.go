@@ -1,6 +1,7 @@
 package entity
 
 import (
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
@@ -8,15 +9,53 @@ import (
 )
 
 type TodoItem struct {
-	ID        string
+	ID        uuid.UUID
 	Text      value.TodoText
-	CreatedAt time.Time
+	DueDate   value.DueDate
+	Priority  value.Priority
+	Tags      []value.Tag
+	Overdue   bool
+	Completed bool
+	Checklist []*ChecklistItem
+	// RecurrenceID links an occurrence to the recurring todo it was added
+	// for; it is uuid.Nil for todos added by users.
+	RecurrenceID uuid.UUID
+	CreatedAt    time.Time
 }
 
-func NewTodoItem(text value.TodoText) *TodoItem {
+func NewTodoItem(id uuid.UUID, text value.TodoText, dueDate value.DueDate, priority value.Priority, createdAt time.Time) *TodoItem {
 	return &TodoItem{
-		ID:        uuid.New().String(),
+		ID:        id,
 		Text:      text,
-		CreatedAt: time.Now(),
+		DueDate:   dueDate,
+		Priority:  priority,
+		CreatedAt: createdAt,
 	}
 }
+
+func (t *TodoItem) HasTag(tag value.Tag) bool {
+	return slices.Contains(t.Tags, tag)
+}
+
+func (t *TodoItem) FindChecklistItem(id uuid.UUID) *ChecklistItem {
+	for _, c := range t.Checklist {
+		if c.ID == id {
+			return c
+		}
+	}
+	return nil
+}
+
+// ChecklistDone reports whether the todo has checklist entries and all of them
+// are completed.
+func (t *TodoItem) ChecklistDone() bool {
+	if len(t.Checklist) == 0 {
+		return false
+	}
+	for _, c := range t.Checklist {
+		if !c.Completed {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,43 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+)
+
+type ChecklistItemAddedEvent struct {
+	AggregateID     uuid.UUID
+	UserID          value.UserID
+	TodoID          uuid.UUID
+	ChecklistItemID uuid.UUID
+	Text            value.TodoText
+	EventID         uuid.UUID
+	Timestamp       time.Time
+	Version         int
+}
+
+func (e ChecklistItemAddedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e ChecklistItemAddedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e ChecklistItemAddedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e ChecklistItemAddedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e ChecklistItemAddedEvent) GetEventType() string {
+	return "ChecklistItemAddedEvent"
+}
+
+func (e ChecklistItemAddedEvent) GetUserID() value.UserID {
+	return e.UserID
+}
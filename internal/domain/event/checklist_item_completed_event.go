@@ -0,0 +1,42 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+)
+
+type ChecklistItemCompletedEvent struct {
+	AggregateID     uuid.UUID
+	UserID          value.UserID
+	TodoID          uuid.UUID
+	ChecklistItemID uuid.UUID
+	EventID         uuid.UUID
+	Timestamp       time.Time
+	Version         int
+}
+
+func (e ChecklistItemCompletedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e ChecklistItemCompletedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e ChecklistItemCompletedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e ChecklistItemCompletedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e ChecklistItemCompletedEvent) GetEventType() string {
+	return "ChecklistItemCompletedEvent"
+}
+
+func (e ChecklistItemCompletedEvent) GetUserID() value.UserID {
+	return e.UserID
+}
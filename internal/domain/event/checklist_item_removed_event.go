@@ -0,0 +1,42 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+)
+
+type ChecklistItemRemovedEvent struct {
+	AggregateID     uuid.UUID
+	UserID          value.UserID
+	TodoID          uuid.UUID
+	ChecklistItemID uuid.UUID
+	EventID         uuid.UUID
+	Timestamp       time.Time
+	Version         int
+}
+
+func (e ChecklistItemRemovedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e ChecklistItemRemovedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e ChecklistItemRemovedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e ChecklistItemRemovedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e ChecklistItemRemovedEvent) GetEventType() string {
+	return "ChecklistItemRemovedEvent"
+}
+
+func (e ChecklistItemRemovedEvent) GetUserID() value.UserID {
+	return e.UserID
+}
@@ -0,0 +1,44 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+)
+
+// ChecklistItemReopenedEvent marks a completed checklist entry as open again.
+// It is recorded when completing the entry is undone.
+type ChecklistItemReopenedEvent struct {
+	AggregateID     uuid.UUID
+	UserID          value.UserID
+	TodoID          uuid.UUID
+	ChecklistItemID uuid.UUID
+	EventID         uuid.UUID
+	Timestamp       time.Time
+	Version         int
+}
+
+func (e ChecklistItemReopenedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e ChecklistItemReopenedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e ChecklistItemReopenedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e ChecklistItemReopenedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e ChecklistItemReopenedEvent) GetEventType() string {
+	return "ChecklistItemReopenedEvent"
+}
+
+func (e ChecklistItemReopenedEvent) GetUserID() value.UserID {
+	return e.UserID
+}
@@ -0,0 +1,42 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+)
+
+type CollaboratorInvitedEvent struct {
+	AggregateID    uuid.UUID
+	UserID         value.UserID
+	CollaboratorID value.UserID
+	Role           value.Role
+	EventID        uuid.UUID
+	Timestamp      time.Time
+	Version        int
+}
+
+func (e CollaboratorInvitedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e CollaboratorInvitedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e CollaboratorInvitedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e CollaboratorInvitedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e CollaboratorInvitedEvent) GetEventType() string {
+	return "CollaboratorInvitedEvent"
+}
+
+func (e CollaboratorInvitedEvent) GetUserID() value.UserID {
+	return e.UserID
+}
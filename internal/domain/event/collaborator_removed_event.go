@@ -0,0 +1,41 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+)
+
+type CollaboratorRemovedEvent struct {
+	AggregateID    uuid.UUID
+	UserID         value.UserID
+	CollaboratorID value.UserID
+	EventID        uuid.UUID
+	Timestamp      time.Time
+	Version        int
+}
+
+func (e CollaboratorRemovedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e CollaboratorRemovedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e CollaboratorRemovedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e CollaboratorRemovedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e CollaboratorRemovedEvent) GetEventType() string {
+	return "CollaboratorRemovedEvent"
+}
+
+func (e CollaboratorRemovedEvent) GetUserID() value.UserID {
+	return e.UserID
+}
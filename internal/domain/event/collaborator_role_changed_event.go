@@ -0,0 +1,42 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+)
+
+type CollaboratorRoleChangedEvent struct {
+	AggregateID    uuid.UUID
+	UserID         value.UserID
+	CollaboratorID value.UserID
+	Role           value.Role
+	EventID        uuid.UUID
+	Timestamp      time.Time
+	Version        int
+}
+
+func (e CollaboratorRoleChangedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e CollaboratorRoleChangedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e CollaboratorRoleChangedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e CollaboratorRoleChangedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e CollaboratorRoleChangedEvent) GetEventType() string {
+	return "CollaboratorRoleChangedEvent"
+}
+
+func (e CollaboratorRoleChangedEvent) GetUserID() value.UserID {
+	return e.UserID
+}
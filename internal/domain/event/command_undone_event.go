@@ -0,0 +1,45 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+)
+
+// CommandUndoneEvent closes an undo. The command that recorded the events
+// from UndoneFromVersion to UndoneToVersion has been reverted by the
+// compensating events recorded just before this one.
+type CommandUndoneEvent struct {
+	AggregateID       uuid.UUID
+	UserID            value.UserID
+	UndoneFromVersion int
+	UndoneToVersion   int
+	EventID           uuid.UUID
+	Timestamp         time.Time
+	Version           int
+}
+
+func (e CommandUndoneEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e CommandUndoneEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e CommandUndoneEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e CommandUndoneEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e CommandUndoneEvent) GetEventType() string {
+	return "CommandUndoneEvent"
+}
+
+func (e CommandUndoneEvent) GetUserID() value.UserID {
+	return e.UserID
+}
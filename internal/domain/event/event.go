@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
 )
 
 type Event interface {
@@ -13,3 +14,10 @@ type Event interface {
 	GetVersion() int
 	GetEventType() string
 }
+
+// UserEvent is an event recorded on behalf of a user. System events, such as
+// TodoBecameOverdueEvent, have no user.
+type UserEvent interface {
+	Event
+	GetUserID() value.UserID
+}
@@ -0,0 +1,41 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+)
+
+type RecurringTodoCancelledEvent struct {
+	AggregateID  uuid.UUID
+	UserID       value.UserID
+	RecurrenceID uuid.UUID
+	EventID      uuid.UUID
+	Timestamp    time.Time
+	Version      int
+}
+
+func (e RecurringTodoCancelledEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e RecurringTodoCancelledEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e RecurringTodoCancelledEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e RecurringTodoCancelledEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e RecurringTodoCancelledEvent) GetEventType() string {
+	return "RecurringTodoCancelledEvent"
+}
+
+func (e RecurringTodoCancelledEvent) GetUserID() value.UserID {
+	return e.UserID
+}
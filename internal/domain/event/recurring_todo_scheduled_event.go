@@ -0,0 +1,46 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+)
+
+// RecurringTodoScheduledEvent adds a recurring todo template to the list.
+type RecurringTodoScheduledEvent struct {
+	AggregateID  uuid.UUID
+	UserID       value.UserID
+	RecurrenceID uuid.UUID
+	TodoText     value.TodoText
+	Rule         value.RecurrenceRule
+	StartDate    value.DueDate
+	Priority     value.Priority
+	EventID      uuid.UUID
+	Timestamp    time.Time
+	Version      int
+}
+
+func (e RecurringTodoScheduledEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e RecurringTodoScheduledEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e RecurringTodoScheduledEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e RecurringTodoScheduledEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e RecurringTodoScheduledEvent) GetEventType() string {
+	return "RecurringTodoScheduledEvent"
+}
+
+func (e RecurringTodoScheduledEvent) GetUserID() value.UserID {
+	return e.UserID
+}
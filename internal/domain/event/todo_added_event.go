@@ -10,10 +10,16 @@ import (
 type TodoAddedEvent struct {
 	AggregateID uuid.UUID
 	UserID      value.UserID
+	TodoID      uuid.UUID
 	TodoText    value.TodoText
-	EventID     uuid.UUID
-	Timestamp   time.Time
-	Version     int
+	DueDate     value.DueDate
+	Priority    value.Priority
+	// RecurrenceID is set when the todo is an occurrence of a recurring todo;
+	// DueDate is then the day of the occurrence.
+	RecurrenceID uuid.UUID
+	EventID      uuid.UUID
+	Timestamp    time.Time
+	Version      int
 }
 
 func (e TodoAddedEvent) GetAggregateID() uuid.UUID {
@@ -35,3 +41,16 @@ func (e TodoAddedEvent) GetVersion() int {
 func (e TodoAddedEvent) GetEventType() string {
 	return "TodoAddedEvent"
 }
+
+// ItemID returns the ID of the added todo. Events recorded before todos had
+// their own ID fall back to the event ID, which is just as stable on replay.
+func (e TodoAddedEvent) ItemID() uuid.UUID {
+	if e.TodoID == uuid.Nil {
+		return e.EventID
+	}
+	return e.TodoID
+}
+
+func (e TodoAddedEvent) GetUserID() value.UserID {
+	return e.UserID
+}
@@ -0,0 +1,39 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+)
+
+// TodoBecameOverdueEvent is recorded by the overdue scheduler, not by a user,
+// the first time a todo is seen past its due date.
+type TodoBecameOverdueEvent struct {
+	AggregateID uuid.UUID
+	TodoID      uuid.UUID
+	DueDate     value.DueDate
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func (e TodoBecameOverdueEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e TodoBecameOverdueEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e TodoBecameOverdueEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e TodoBecameOverdueEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e TodoBecameOverdueEvent) GetEventType() string {
+	return "TodoBecameOverdueEvent"
+}
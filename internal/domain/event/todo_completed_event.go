@@ -0,0 +1,46 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+)
+
+// TodoCompletedEvent marks a todo as done, either because a user completed
+// it or because its last open checklist entry was completed.
+type TodoCompletedEvent struct {
+	AggregateID uuid.UUID
+	UserID      value.UserID
+	TodoID      uuid.UUID
+	// Derived is set when the completion follows from the checklist, so it
+	// belongs to the command that changed the checklist.
+	Derived   bool
+	EventID   uuid.UUID
+	Timestamp time.Time
+	Version   int
+}
+
+func (e TodoCompletedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e TodoCompletedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e TodoCompletedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e TodoCompletedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e TodoCompletedEvent) GetEventType() string {
+	return "TodoCompletedEvent"
+}
+
+func (e TodoCompletedEvent) GetUserID() value.UserID {
+	return e.UserID
+}
@@ -0,0 +1,43 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+)
+
+type TodoDueDateSetEvent struct {
+	AggregateID uuid.UUID
+	UserID      value.UserID
+	TodoID      uuid.UUID
+	// DueDate is empty when the due date was cleared.
+	DueDate   value.DueDate
+	EventID   uuid.UUID
+	Timestamp time.Time
+	Version   int
+}
+
+func (e TodoDueDateSetEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e TodoDueDateSetEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e TodoDueDateSetEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e TodoDueDateSetEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e TodoDueDateSetEvent) GetEventType() string {
+	return "TodoDueDateSetEvent"
+}
+
+func (e TodoDueDateSetEvent) GetUserID() value.UserID {
+	return e.UserID
+}
@@ -0,0 +1,43 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+)
+
+// TodoItemsReorderedEvent holds the complete new order of the list's todos.
+// Every todo on the list appears in TodoIDs exactly once.
+type TodoItemsReorderedEvent struct {
+	AggregateID uuid.UUID
+	UserID      value.UserID
+	TodoIDs     []uuid.UUID
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func (e TodoItemsReorderedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e TodoItemsReorderedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e TodoItemsReorderedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e TodoItemsReorderedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e TodoItemsReorderedEvent) GetEventType() string {
+	return "TodoItemsReorderedEvent"
+}
+
+func (e TodoItemsReorderedEvent) GetUserID() value.UserID {
+	return e.UserID
+}
@@ -0,0 +1,40 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+)
+
+type TodoListArchivedEvent struct {
+	AggregateID uuid.UUID
+	UserID      value.UserID
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func (e TodoListArchivedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e TodoListArchivedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e TodoListArchivedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e TodoListArchivedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e TodoListArchivedEvent) GetEventType() string {
+	return "TodoListArchivedEvent"
+}
+
+func (e TodoListArchivedEvent) GetUserID() value.UserID {
+	return e.UserID
+}
@@ -10,6 +10,8 @@ import (
 type TodoListCreatedEvent struct {
 	AggregateID uuid.UUID
 	UserID      value.UserID
+	Title       value.TodoListTitle
+	Description value.TodoListDescription
 	EventID     uuid.UUID
 	Timestamp   time.Time
 	Version     int
@@ -34,3 +36,7 @@ func (e TodoListCreatedEvent) GetVersion() int {
 func (e TodoListCreatedEvent) GetEventType() string {
 	return "TodoListCreatedEvent"
 }
+
+func (e TodoListCreatedEvent) GetUserID() value.UserID {
+	return e.UserID
+}
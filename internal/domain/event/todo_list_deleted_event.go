@@ -0,0 +1,40 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+)
+
+type TodoListDeletedEvent struct {
+	AggregateID uuid.UUID
+	UserID      value.UserID
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func (e TodoListDeletedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e TodoListDeletedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e TodoListDeletedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e TodoListDeletedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e TodoListDeletedEvent) GetEventType() string {
+	return "TodoListDeletedEvent"
+}
+
+func (e TodoListDeletedEvent) GetUserID() value.UserID {
+	return e.UserID
+}
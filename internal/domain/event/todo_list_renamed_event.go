@@ -0,0 +1,42 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+)
+
+type TodoListRenamedEvent struct {
+	AggregateID uuid.UUID
+	UserID      value.UserID
+	Title       value.TodoListTitle
+	Description value.TodoListDescription
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func (e TodoListRenamedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e TodoListRenamedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e TodoListRenamedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e TodoListRenamedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e TodoListRenamedEvent) GetEventType() string {
+	return "TodoListRenamedEvent"
+}
+
+func (e TodoListRenamedEvent) GetUserID() value.UserID {
+	return e.UserID
+}
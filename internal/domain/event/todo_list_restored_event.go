@@ -0,0 +1,40 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+)
+
+type TodoListRestoredEvent struct {
+	AggregateID uuid.UUID
+	UserID      value.UserID
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func (e TodoListRestoredEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e TodoListRestoredEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e TodoListRestoredEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e TodoListRestoredEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e TodoListRestoredEvent) GetEventType() string {
+	return "TodoListRestoredEvent"
+}
+
+func (e TodoListRestoredEvent) GetUserID() value.UserID {
+	return e.UserID
+}
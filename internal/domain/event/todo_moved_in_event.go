@@ -0,0 +1,58 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+)
+
+// TodoMovedInEvent adds a todo moved from the list SourceAggregateID, which
+// records a matching TodoMovedOutEvent. The todo keeps its ID and carries
+// its state with it.
+type TodoMovedInEvent struct {
+	AggregateID       uuid.UUID
+	UserID            value.UserID
+	TodoID            uuid.UUID
+	SourceAggregateID uuid.UUID
+	TodoText          value.TodoText
+	DueDate           value.DueDate
+	Priority          value.Priority
+	Tags              []value.Tag
+	Completed         bool
+	Checklist         []MovedChecklistItem
+	EventID           uuid.UUID
+	Timestamp         time.Time
+	Version           int
+}
+
+// MovedChecklistItem is a checklist entry carried by a TodoMovedInEvent.
+type MovedChecklistItem struct {
+	ID        uuid.UUID
+	Text      value.TodoText
+	Completed bool
+}
+
+func (e TodoMovedInEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e TodoMovedInEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e TodoMovedInEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e TodoMovedInEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e TodoMovedInEvent) GetEventType() string {
+	return "TodoMovedInEvent"
+}
+
+func (e TodoMovedInEvent) GetUserID() value.UserID {
+	return e.UserID
+}
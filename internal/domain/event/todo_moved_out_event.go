@@ -0,0 +1,44 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+)
+
+// TodoMovedOutEvent takes a todo off the list because it was moved to the
+// list TargetAggregateID, which records a matching TodoMovedInEvent.
+type TodoMovedOutEvent struct {
+	AggregateID       uuid.UUID
+	UserID            value.UserID
+	TodoID            uuid.UUID
+	TargetAggregateID uuid.UUID
+	EventID           uuid.UUID
+	Timestamp         time.Time
+	Version           int
+}
+
+func (e TodoMovedOutEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e TodoMovedOutEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e TodoMovedOutEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e TodoMovedOutEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e TodoMovedOutEvent) GetEventType() string {
+	return "TodoMovedOutEvent"
+}
+
+func (e TodoMovedOutEvent) GetUserID() value.UserID {
+	return e.UserID
+}
@@ -0,0 +1,42 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+)
+
+type TodoPriorityChangedEvent struct {
+	AggregateID uuid.UUID
+	UserID      value.UserID
+	TodoID      uuid.UUID
+	Priority    value.Priority
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func (e TodoPriorityChangedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e TodoPriorityChangedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e TodoPriorityChangedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e TodoPriorityChangedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e TodoPriorityChangedEvent) GetEventType() string {
+	return "TodoPriorityChangedEvent"
+}
+
+func (e TodoPriorityChangedEvent) GetUserID() value.UserID {
+	return e.UserID
+}
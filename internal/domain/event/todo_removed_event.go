@@ -0,0 +1,43 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+)
+
+// TodoRemovedEvent takes a todo off the list. It is recorded when adding the
+// todo is undone.
+type TodoRemovedEvent struct {
+	AggregateID uuid.UUID
+	UserID      value.UserID
+	TodoID      uuid.UUID
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func (e TodoRemovedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e TodoRemovedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e TodoRemovedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e TodoRemovedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e TodoRemovedEvent) GetEventType() string {
+	return "TodoRemovedEvent"
+}
+
+func (e TodoRemovedEvent) GetUserID() value.UserID {
+	return e.UserID
+}
@@ -0,0 +1,46 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+)
+
+// TodoReopenedEvent marks a completed todo as open again, either because a
+// user reopened it or because an open checklist entry was added to it.
+type TodoReopenedEvent struct {
+	AggregateID uuid.UUID
+	UserID      value.UserID
+	TodoID      uuid.UUID
+	// Derived is set when reopening follows from the checklist, so it
+	// belongs to the command that changed the checklist.
+	Derived   bool
+	EventID   uuid.UUID
+	Timestamp time.Time
+	Version   int
+}
+
+func (e TodoReopenedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e TodoReopenedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e TodoReopenedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e TodoReopenedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e TodoReopenedEvent) GetEventType() string {
+	return "TodoReopenedEvent"
+}
+
+func (e TodoReopenedEvent) GetUserID() value.UserID {
+	return e.UserID
+}
@@ -0,0 +1,42 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+)
+
+type TodoTaggedEvent struct {
+	AggregateID uuid.UUID
+	UserID      value.UserID
+	TodoID      uuid.UUID
+	Tag         value.Tag
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func (e TodoTaggedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e TodoTaggedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e TodoTaggedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e TodoTaggedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e TodoTaggedEvent) GetEventType() string {
+	return "TodoTaggedEvent"
+}
+
+func (e TodoTaggedEvent) GetUserID() value.UserID {
+	return e.UserID
+}
@@ -0,0 +1,42 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+)
+
+type TodoUntaggedEvent struct {
+	AggregateID uuid.UUID
+	UserID      value.UserID
+	TodoID      uuid.UUID
+	Tag         value.Tag
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func (e TodoUntaggedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e TodoUntaggedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e TodoUntaggedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e TodoUntaggedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e TodoUntaggedEvent) GetEventType() string {
+	return "TodoUntaggedEvent"
+}
+
+func (e TodoUntaggedEvent) GetUserID() value.UserID {
+	return e.UserID
+}
@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+)
+
+// Aggregate is an event-sourced aggregate: its state is rebuilt from its
+// events, and changes are recorded as uncommitted events until saved.
+type Aggregate interface {
+	GetAggregateID() uuid.UUID
+	GetVersion() int
+	Hydration(events []event.Event) error
+	GetUncommittedEvents() []event.Event
+	MarkEventsAsCommitted()
+}
+
+// AggregateRepository loads and saves aggregates of type T.
+type AggregateRepository[T Aggregate] interface {
+	// Load rebuilds the aggregate with id from its events.
+	Load(ctx context.Context, id uuid.UUID) (T, error)
+	// Save stores the aggregate's uncommitted events and marks them as
+	// committed. It fails with an OptimisticLock error when another command
+	// saved the aggregate since it was loaded.
+	Save(ctx context.Context, aggregate T) error
+}
+
+// SnapshotStore keeps snapshots of aggregates of type T, so that loading one
+// only replays the events recorded after its snapshot.
+type SnapshotStore[T Aggregate] interface {
+	// LoadSnapshot returns the latest snapshot of the aggregate with id, or
+	// false if there is none.
+	LoadSnapshot(ctx context.Context, id uuid.UUID) (T, bool, error)
+	// SaveSnapshot is given every saved aggregate and decides whether to keep
+	// a snapshot of it.
+	SaveSnapshot(ctx context.Context, aggregate T) error
+}
@@ -11,4 +11,10 @@ type EventStore interface {
 	SaveEvents(ctx context.Context, aggregateID uuid.UUID, events []event.Event) error
 	LoadEvents(ctx context.Context, aggregateID uuid.UUID) ([]event.Event, error)
 	GetAllEvents(ctx context.Context) ([]event.Event, error)
+	// GetEventsAfter returns the events stored after position, in the order
+	// they were stored.
+	GetEventsAfter(ctx context.Context, position int64) ([]event.Event, error)
+	// LastPosition returns the position of the last stored event, or 0 when
+	// there is none.
+	LastPosition(ctx context.Context) (int64, error)
 }
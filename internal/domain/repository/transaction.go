@@ -4,5 +4,8 @@ import "context"
 
 type Transaction interface {
 	RWTx(ctx context.Context, fn func(ctx context.Context) error) error
-	AfterCommit(fn func() error)
+	// AfterCommit registers fn to run once the transaction in ctx commits.
+	AfterCommit(ctx context.Context, fn func() error) error
+	// InTx reports whether ctx carries a transaction, which RWTx joins.
+	InTx(ctx context.Context) bool
 }
@@ -0,0 +1,54 @@
+package value
+
+import (
+	"strings"
+	"time"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+)
+
+const dueDateLayout = time.DateOnly
+
+var ErrDueDateInvalid = errors.InvalidParameter.New("due_date must be a date in YYYY-MM-DD format")
+
+// DueDate is the calendar day a todo is due, in UTC. The zero value means the
+// todo has no due date.
+type DueDate string
+
+func NewDueDate(date string) (DueDate, error) {
+	trimmed := strings.TrimSpace(date)
+
+	t, err := time.Parse(dueDateLayout, trimmed)
+	if err != nil {
+		return "", ErrDueDateInvalid
+	}
+
+	return DueDate(t.Format(dueDateLayout)), nil
+}
+
+func DueDateOf(t time.Time) DueDate {
+	return DueDate(t.UTC().Format(dueDateLayout))
+}
+
+func (d DueDate) IsZero() bool {
+	return d == ""
+}
+
+// IsOverdue reports whether the due date lies before the day of now.
+func (d DueDate) IsOverdue(now time.Time) bool {
+	return !d.IsZero() && d < DueDateOf(now)
+}
+
+// IsDueOn reports whether the due date is the day of now.
+func (d DueDate) IsDueOn(now time.Time) bool {
+	return !d.IsZero() && d == DueDateOf(now)
+}
+
+// Time returns midnight UTC of the due date.
+func (d DueDate) Time() (time.Time, error) {
+	return time.Parse(dueDateLayout, string(d))
+}
+
+func (d DueDate) String() string {
+	return string(d)
+}
@@ -0,0 +1,82 @@
+package value_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+)
+
+func TestNewDueDate(t *testing.T) {
+	tests := map[string]struct {
+		input     string
+		want      value.DueDate
+		wantError error
+	}{
+		"valid date": {
+			input: "2025-03-31",
+			want:  value.DueDate("2025-03-31"),
+		},
+		"date with surrounding spaces": {
+			input: " 2025-03-31 ",
+			want:  value.DueDate("2025-03-31"),
+		},
+		"empty string": {
+			input:     "",
+			wantError: value.ErrDueDateInvalid,
+		},
+		"timestamp instead of date": {
+			input:     "2025-03-31T10:00:00Z",
+			wantError: value.ErrDueDateInvalid,
+		},
+		"impossible day": {
+			input:     "2025-02-30",
+			wantError: value.ErrDueDateInvalid,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := value.NewDueDate(tt.input)
+			if tt.wantError != nil {
+				require.ErrorIs(t, err, tt.wantError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestDueDate_IsOverdue(t *testing.T) {
+	now := time.Date(2025, 3, 31, 23, 59, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		dueDate     value.DueDate
+		wantOverdue bool
+		wantDueOn   bool
+	}{
+		"yesterday is overdue": {
+			dueDate:     value.DueDate("2025-03-30"),
+			wantOverdue: true,
+		},
+		"today is due but not overdue": {
+			dueDate:   value.DueDate("2025-03-31"),
+			wantDueOn: true,
+		},
+		"tomorrow is neither": {
+			dueDate: value.DueDate("2025-04-01"),
+		},
+		"no due date is neither": {
+			dueDate: value.DueDate(""),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tt.wantOverdue, tt.dueDate.IsOverdue(now))
+			require.Equal(t, tt.wantDueOn, tt.dueDate.IsDueOn(now))
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package value
+
+import (
+	"strings"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+)
+
+var ErrPriorityInvalid = errors.InvalidParameter.New("priority must be low, medium or high")
+
+// Priority is how urgent a todo is. Todos added without a priority, including
+// those recorded before priorities existed, are medium.
+type Priority string
+
+const (
+	PriorityLow    Priority = "low"
+	PriorityMedium Priority = "medium"
+	PriorityHigh   Priority = "high"
+)
+
+// NewPriority parses priority, defaulting an empty value to medium.
+func NewPriority(priority string) (Priority, error) {
+	trimmed := strings.ToLower(strings.TrimSpace(priority))
+	if trimmed == "" {
+		return PriorityMedium, nil
+	}
+
+	p := Priority(trimmed)
+	if p.rank() == 0 {
+		return "", ErrPriorityInvalid
+	}
+	return p, nil
+}
+
+// OrDefault returns p, or medium when p is unset.
+func (p Priority) OrDefault() Priority {
+	if p == "" {
+		return PriorityMedium
+	}
+	return p
+}
+
+// Compare returns a negative number when p is more urgent than other, a
+// positive number when it is less urgent and zero when they are equal.
+func (p Priority) Compare(other Priority) int {
+	return other.OrDefault().rank() - p.OrDefault().rank()
+}
+
+func (p Priority) rank() int {
+	switch p {
+	case PriorityLow:
+		return 1
+	case PriorityMedium:
+		return 2
+	case PriorityHigh:
+		return 3
+	default:
+		return 0
+	}
+}
+
+func (p Priority) String() string {
+	return string(p)
+}
@@ -0,0 +1,40 @@
+package value_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+)
+
+func TestNewPriority(t *testing.T) {
+	tests := map[string]struct {
+		input     string
+		want      value.Priority
+		wantError error
+	}{
+		"low":              {input: "low", want: value.PriorityLow},
+		"high with case":   {input: " High ", want: value.PriorityHigh},
+		"empty is medium":  {input: "", want: value.PriorityMedium},
+		"unknown priority": {input: "urgent", wantError: value.ErrPriorityInvalid},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := value.NewPriority(tt.input)
+			if tt.wantError != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.wantError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestPriority_Compare(t *testing.T) {
+	require.Negative(t, value.PriorityHigh.Compare(value.PriorityLow))
+	require.Positive(t, value.PriorityLow.Compare(value.PriorityMedium))
+	require.Zero(t, value.Priority("").Compare(value.PriorityMedium))
+}
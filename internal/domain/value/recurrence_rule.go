@@ -0,0 +1,189 @@
+package value
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+)
+
+var ErrRecurrenceRuleInvalid = errors.InvalidParameter.New("rule must be daily, weekly, monthly or an RRULE such as FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH")
+
+const maxRecurrenceInterval = 366
+
+// maxOccurrenceSearchYears is how far NextOccurrence looks ahead.
+const maxOccurrenceSearchYears = 100
+
+var weekdayCodes = []string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// RecurrenceRule says on which days a recurring todo occurs. It accepts the
+// shorthands daily, weekly and monthly, or a subset of iCalendar RRULE:
+// FREQ (DAILY, WEEKLY or MONTHLY), INTERVAL and, for weekly rules, BYDAY.
+// Rules are stored in a canonical form such as "FREQ=WEEKLY;INTERVAL=1;BYDAY=MO,FR".
+type RecurrenceRule string
+
+type recurrence struct {
+	freq     string
+	interval int
+	byDay    []time.Weekday
+}
+
+func NewRecurrenceRule(rule string) (RecurrenceRule, error) {
+	r, err := parseRecurrence(rule)
+	if err != nil {
+		return "", err
+	}
+	return RecurrenceRule(r.String()), nil
+}
+
+// OccursOn reports whether a todo following the rule from start has an
+// occurrence on day. Weekly rules without BYDAY repeat on start's weekday and
+// monthly rules on start's day of the month.
+func (r RecurrenceRule) OccursOn(start, day DueDate) bool {
+	rec, err := parseRecurrence(string(r))
+	if err != nil {
+		return false
+	}
+
+	s, err := start.Time()
+	if err != nil {
+		return false
+	}
+	d, err := day.Time()
+	if err != nil {
+		return false
+	}
+	return rec.occursOn(s, d)
+}
+
+// NextOccurrence returns the first day on or after from on which a todo
+// following the rule from start occurs, or false if there is none within a
+// hundred years.
+func (r RecurrenceRule) NextOccurrence(start, from DueDate) (DueDate, bool) {
+	rec, err := parseRecurrence(string(r))
+	if err != nil {
+		return "", false
+	}
+
+	s, err := start.Time()
+	if err != nil {
+		return "", false
+	}
+	d, err := from.Time()
+	if err != nil {
+		return "", false
+	}
+	if d.Before(s) {
+		d = s
+	}
+
+	for limit := d.AddDate(maxOccurrenceSearchYears, 0, 0); d.Before(limit); d = d.AddDate(0, 0, 1) {
+		if rec.occursOn(s, d) {
+			return DueDateOf(d), true
+		}
+	}
+	return "", false
+}
+
+func (r RecurrenceRule) String() string {
+	return string(r)
+}
+
+func (r recurrence) occursOn(s, d time.Time) bool {
+	if d.Before(s) {
+		return false
+	}
+
+	switch r.freq {
+	case "DAILY":
+		days := int(d.Sub(s).Hours() / 24)
+		return days%r.interval == 0
+	case "WEEKLY":
+		byDay := r.byDay
+		if len(byDay) == 0 {
+			byDay = []time.Weekday{s.Weekday()}
+		}
+		if !slices.Contains(byDay, d.Weekday()) {
+			return false
+		}
+		weeks := int(startOfWeek(d).Sub(startOfWeek(s)).Hours() / 24 / 7)
+		return weeks%r.interval == 0
+	case "MONTHLY":
+		months := (d.Year()-s.Year())*12 + int(d.Month()-s.Month())
+		return d.Day() == s.Day() && months%r.interval == 0
+	default:
+		return false
+	}
+}
+
+func parseRecurrence(rule string) (recurrence, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(rule))
+	switch normalized {
+	case "DAILY", "WEEKLY", "MONTHLY":
+		return recurrence{freq: normalized, interval: 1}, nil
+	}
+
+	rec := recurrence{interval: 1}
+	for _, part := range strings.Split(strings.TrimPrefix(normalized, "RRULE:"), ";") {
+		key, val, ok := strings.Cut(part, "=")
+		if !ok {
+			return recurrence{}, ErrRecurrenceRuleInvalid
+		}
+
+		switch key {
+		case "FREQ":
+			if val != "DAILY" && val != "WEEKLY" && val != "MONTHLY" {
+				return recurrence{}, ErrRecurrenceRuleInvalid
+			}
+			rec.freq = val
+		case "INTERVAL":
+			n, err := strconv.Atoi(val)
+			if err != nil || n < 1 || n > maxRecurrenceInterval {
+				return recurrence{}, ErrRecurrenceRuleInvalid
+			}
+			rec.interval = n
+		case "BYDAY":
+			for _, code := range strings.Split(val, ",") {
+				i := slices.Index(weekdayCodes, code)
+				if i < 0 {
+					return recurrence{}, ErrRecurrenceRuleInvalid
+				}
+				if !slices.Contains(rec.byDay, time.Weekday(i)) {
+					rec.byDay = append(rec.byDay, time.Weekday(i))
+				}
+			}
+		default:
+			return recurrence{}, ErrRecurrenceRuleInvalid
+		}
+	}
+
+	if rec.freq == "" || (len(rec.byDay) > 0 && rec.freq != "WEEKLY") {
+		return recurrence{}, ErrRecurrenceRuleInvalid
+	}
+
+	// Monday first, as in ISO weeks.
+	slices.SortFunc(rec.byDay, func(a, b time.Weekday) int {
+		return (int(a)+6)%7 - (int(b)+6)%7
+	})
+	return rec, nil
+}
+
+func (r recurrence) String() string {
+	s := fmt.Sprintf("FREQ=%s;INTERVAL=%d", r.freq, r.interval)
+	if len(r.byDay) > 0 {
+		codes := make([]string, 0, len(r.byDay))
+		for _, d := range r.byDay {
+			codes = append(codes, weekdayCodes[d])
+		}
+		s += ";BYDAY=" + strings.Join(codes, ",")
+	}
+	return s
+}
+
+// startOfWeek returns the Monday of t's week.
+func startOfWeek(t time.Time) time.Time {
+	return t.AddDate(0, 0, -((int(t.Weekday()) + 6) % 7))
+}
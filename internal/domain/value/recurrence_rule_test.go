@@ -0,0 +1,110 @@
+package value_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+)
+
+func TestNewRecurrenceRule(t *testing.T) {
+	tests := map[string]struct {
+		input     string
+		want      value.RecurrenceRule
+		wantError error
+	}{
+		"daily shorthand":         {input: "daily", want: "FREQ=DAILY;INTERVAL=1"},
+		"weekly shorthand":        {input: " Weekly ", want: "FREQ=WEEKLY;INTERVAL=1"},
+		"rrule with days ordered": {input: "RRULE:FREQ=WEEKLY;BYDAY=FR,MO;INTERVAL=2", want: "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR"},
+		"monthly interval":        {input: "FREQ=MONTHLY;INTERVAL=3", want: "FREQ=MONTHLY;INTERVAL=3"},
+		"missing freq":            {input: "INTERVAL=2", wantError: value.ErrRecurrenceRuleInvalid},
+		"unknown freq":            {input: "FREQ=HOURLY", wantError: value.ErrRecurrenceRuleInvalid},
+		"zero interval":           {input: "FREQ=DAILY;INTERVAL=0", wantError: value.ErrRecurrenceRuleInvalid},
+		"byday on daily rule":     {input: "FREQ=DAILY;BYDAY=MO", wantError: value.ErrRecurrenceRuleInvalid},
+		"unknown weekday":         {input: "FREQ=WEEKLY;BYDAY=XX", wantError: value.ErrRecurrenceRuleInvalid},
+		"unsupported part":        {input: "FREQ=DAILY;COUNT=3", wantError: value.ErrRecurrenceRuleInvalid},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := value.NewRecurrenceRule(tt.input)
+			if tt.wantError != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.wantError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRecurrenceRule_OccursOn(t *testing.T) {
+	// 2025-03-03 is a Monday.
+	const start = value.DueDate("2025-03-03")
+
+	tests := map[string]struct {
+		rule string
+		day  value.DueDate
+		want bool
+	}{
+		"daily on start":              {rule: "daily", day: "2025-03-03", want: true},
+		"daily before start":          {rule: "daily", day: "2025-03-02", want: false},
+		"every other day skips":       {rule: "FREQ=DAILY;INTERVAL=2", day: "2025-03-04", want: false},
+		"every other day hits":        {rule: "FREQ=DAILY;INTERVAL=2", day: "2025-03-05", want: true},
+		"weekly on start weekday":     {rule: "weekly", day: "2025-03-10", want: true},
+		"weekly on other weekday":     {rule: "weekly", day: "2025-03-11", want: false},
+		"byday friday":                {rule: "FREQ=WEEKLY;BYDAY=MO,FR", day: "2025-03-07", want: true},
+		"biweekly skips second week":  {rule: "FREQ=WEEKLY;INTERVAL=2;BYDAY=FR", day: "2025-03-14", want: false},
+		"biweekly hits third week":    {rule: "FREQ=WEEKLY;INTERVAL=2;BYDAY=FR", day: "2025-03-21", want: true},
+		"monthly same day":            {rule: "monthly", day: "2025-04-03", want: true},
+		"monthly other day":           {rule: "monthly", day: "2025-04-04", want: false},
+		"quarterly skips next month":  {rule: "FREQ=MONTHLY;INTERVAL=3", day: "2025-04-03", want: false},
+		"quarterly hits three months": {rule: "FREQ=MONTHLY;INTERVAL=3", day: "2025-06-03", want: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			rule, err := value.NewRecurrenceRule(tt.rule)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, rule.OccursOn(start, tt.day))
+		})
+	}
+}
+
+func TestRecurrenceRule_NextOccurrence(t *testing.T) {
+	// 2025-03-03 is a Monday.
+	const start = value.DueDate("2025-03-03")
+
+	tests := map[string]struct {
+		rule  string
+		start value.DueDate
+		from  value.DueDate
+		want  value.DueDate
+	}{
+		"start when from is before it": {rule: "daily", from: "2025-02-01", want: "2025-03-03"},
+		"daily on from":                {rule: "daily", from: "2025-03-10", want: "2025-03-10"},
+		"every other day skips from":   {rule: "FREQ=DAILY;INTERVAL=2", from: "2025-03-04", want: "2025-03-05"},
+		"weekly next week":             {rule: "weekly", from: "2025-03-04", want: "2025-03-10"},
+		"byday later in the week":      {rule: "FREQ=WEEKLY;BYDAY=MO,FR", from: "2025-03-04", want: "2025-03-07"},
+		"biweekly skips second week":   {rule: "FREQ=WEEKLY;INTERVAL=2;BYDAY=FR", from: "2025-03-08", want: "2025-03-21"},
+		"monthly next month":           {rule: "monthly", from: "2025-03-04", want: "2025-04-03"},
+		"monthly skips short months":   {rule: "monthly", start: "2025-01-31", from: "2025-04-01", want: "2025-05-31"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			rule, err := value.NewRecurrenceRule(tt.rule)
+			require.NoError(t, err)
+			ruleStart := start
+			if tt.start != "" {
+				ruleStart = tt.start
+			}
+
+			got, ok := rule.NextOccurrence(ruleStart, tt.from)
+
+			require.True(t, ok)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
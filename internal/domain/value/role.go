@@ -0,0 +1,47 @@
+package value
+
+import (
+	"strings"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+)
+
+var ErrRoleInvalid = errors.InvalidParameter.New("role must be viewer, editor or owner")
+
+type Role string
+
+const (
+	RoleViewer Role = "viewer"
+	RoleEditor Role = "editor"
+	RoleOwner  Role = "owner"
+)
+
+func NewRole(role string) (Role, error) {
+	r := Role(strings.ToLower(strings.TrimSpace(role)))
+	if r.rank() == 0 {
+		return "", ErrRoleInvalid
+	}
+	return r, nil
+}
+
+// Includes reports whether r grants at least the permissions of required.
+func (r Role) Includes(required Role) bool {
+	return r.rank() > 0 && r.rank() >= required.rank()
+}
+
+func (r Role) rank() int {
+	switch r {
+	case RoleViewer:
+		return 1
+	case RoleEditor:
+		return 2
+	case RoleOwner:
+		return 3
+	default:
+		return 0
+	}
+}
+
+func (r Role) String() string {
+	return string(r)
+}
@@ -0,0 +1,42 @@
+package value_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+)
+
+func TestNewRole(t *testing.T) {
+	tests := map[string]struct {
+		input     string
+		want      value.Role
+		wantError error
+	}{
+		"viewer":           {input: "viewer", want: value.RoleViewer},
+		"editor with case": {input: " Editor ", want: value.RoleEditor},
+		"owner":            {input: "owner", want: value.RoleOwner},
+		"empty role":       {input: "", wantError: value.ErrRoleInvalid},
+		"unknown role":     {input: "admin", wantError: value.ErrRoleInvalid},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := value.NewRole(tt.input)
+			if tt.wantError != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.wantError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRole_Includes(t *testing.T) {
+	require.True(t, value.RoleOwner.Includes(value.RoleEditor))
+	require.True(t, value.RoleEditor.Includes(value.RoleEditor))
+	require.False(t, value.RoleViewer.Includes(value.RoleEditor))
+	require.False(t, value.Role("").Includes(value.RoleViewer))
+}
@@ -0,0 +1,42 @@
+package value
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+)
+
+var (
+	ErrTagEmpty   = errors.InvalidParameter.New("tag cannot be empty")
+	ErrTagTooLong = errors.InvalidParameter.New("tag cannot exceed 30 characters")
+	ErrTagInvalid = errors.InvalidParameter.New("tag may only contain letters, digits, '-' and '_'")
+)
+
+var tagPattern = regexp.MustCompile(`^[a-z0-9_-]+$`)
+
+// Tag is a label on a todo, such as "urgent" or "home". Tags are compared
+// case-insensitively and stored in lower case.
+type Tag string
+
+func NewTag(tag string) (Tag, error) {
+	normalized := strings.ToLower(strings.TrimSpace(tag))
+
+	if normalized == "" {
+		return "", ErrTagEmpty
+	}
+
+	if len(normalized) > 30 {
+		return "", ErrTagTooLong
+	}
+
+	if !tagPattern.MatchString(normalized) {
+		return "", ErrTagInvalid
+	}
+
+	return Tag(normalized), nil
+}
+
+func (t Tag) String() string {
+	return string(t)
+}
@@ -0,0 +1,38 @@
+package value_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+)
+
+func TestNewTag(t *testing.T) {
+	tests := map[string]struct {
+		input     string
+		want      value.Tag
+		wantError error
+	}{
+		"simple tag":          {input: "home", want: value.Tag("home")},
+		"normalized to lower": {input: " Urgent ", want: value.Tag("urgent")},
+		"dash and underscore": {input: "follow-up_2", want: value.Tag("follow-up_2")},
+		"empty tag":           {input: "  ", wantError: value.ErrTagEmpty},
+		"too long":            {input: strings.Repeat("a", 31), wantError: value.ErrTagTooLong},
+		"contains space":      {input: "at home", wantError: value.ErrTagInvalid},
+		"contains symbol":     {input: "#urgent", wantError: value.ErrTagInvalid},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := value.NewTag(tt.input)
+			if tt.wantError != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.wantError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package value
+
+import (
+	"strings"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+)
+
+var ErrTodoListDescriptionTooLong = errors.InvalidParameter.New("description cannot exceed 1000 characters")
+
+// TodoListDescription is free text shown alongside a list's title. Unlike the
+// title it may be empty, which clears it.
+type TodoListDescription string
+
+func NewTodoListDescription(description string) (TodoListDescription, error) {
+	trimmed := strings.TrimSpace(description)
+
+	if len(trimmed) > 1000 {
+		return "", ErrTodoListDescriptionTooLong
+	}
+
+	return TodoListDescription(trimmed), nil
+}
+
+func (d TodoListDescription) String() string {
+	return string(d)
+}
@@ -0,0 +1,32 @@
+package value
+
+import (
+	"strings"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+)
+
+var (
+	ErrTodoListTitleEmpty   = errors.InvalidParameter.New("title cannot be empty")
+	ErrTodoListTitleTooLong = errors.InvalidParameter.New("title cannot exceed 100 characters")
+)
+
+type TodoListTitle string
+
+func NewTodoListTitle(title string) (TodoListTitle, error) {
+	trimmed := strings.TrimSpace(title)
+
+	if trimmed == "" {
+		return "", ErrTodoListTitleEmpty
+	}
+
+	if len(trimmed) > 100 {
+		return "", ErrTodoListTitleTooLong
+	}
+
+	return TodoListTitle(trimmed), nil
+}
+
+func (t TodoListTitle) String() string {
+	return string(t)
+}
@@ -0,0 +1,83 @@
+package value_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+)
+
+func TestNewTodoListTitle(t *testing.T) {
+	tests := map[string]struct {
+		input     string
+		want      value.TodoListTitle
+		wantError error
+	}{
+		"valid title": {
+			input: "Groceries",
+			want:  value.TodoListTitle("Groceries"),
+		},
+		"title with leading and trailing spaces": {
+			input: "  Weekend  ",
+			want:  value.TodoListTitle("Weekend"),
+		},
+		"only spaces": {
+			input:     "   ",
+			wantError: value.ErrTodoListTitleEmpty,
+		},
+		"exactly 100 characters": {
+			input: strings.Repeat("a", 100),
+			want:  value.TodoListTitle(strings.Repeat("a", 100)),
+		},
+		"over 100 characters": {
+			input:     strings.Repeat("a", 101),
+			wantError: value.ErrTodoListTitleTooLong,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := value.NewTodoListTitle(tt.input)
+			if tt.wantError != nil {
+				require.ErrorIs(t, err, tt.wantError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestNewTodoListDescription(t *testing.T) {
+	tests := map[string]struct {
+		input     string
+		want      value.TodoListDescription
+		wantError error
+	}{
+		"valid description": {
+			input: "Things to buy on Saturday",
+			want:  value.TodoListDescription("Things to buy on Saturday"),
+		},
+		"empty description clears it": {
+			input: "  ",
+			want:  value.TodoListDescription(""),
+		},
+		"over 1000 characters": {
+			input:     strings.Repeat("a", 1001),
+			wantError: value.ErrTodoListDescriptionTooLong,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := value.NewTodoListDescription(tt.input)
+			if tt.wantError != nil {
+				require.ErrorIs(t, err, tt.wantError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
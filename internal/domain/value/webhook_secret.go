@@ -0,0 +1,28 @@
+package value
+
+import (
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+)
+
+var (
+	ErrWebhookSecretTooShort = errors.InvalidParameter.New("secret must be at least 16 characters")
+	ErrWebhookSecretTooLong  = errors.InvalidParameter.New("secret cannot exceed 256 characters")
+)
+
+type WebhookSecret string
+
+func NewWebhookSecret(secret string) (WebhookSecret, error) {
+	if len(secret) < 16 {
+		return "", ErrWebhookSecretTooShort
+	}
+
+	if len(secret) > 256 {
+		return "", ErrWebhookSecretTooLong
+	}
+
+	return WebhookSecret(secret), nil
+}
+
+func (w WebhookSecret) String() string {
+	return string(w)
+}
@@ -0,0 +1,85 @@
+package value
+
+import (
+	"net/netip"
+	"net/url"
+	"strings"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+)
+
+var (
+	ErrWebhookURLEmpty     = errors.InvalidParameter.New("target_url cannot be empty")
+	ErrWebhookURLTooLong   = errors.InvalidParameter.New("target_url cannot exceed 2048 characters")
+	ErrWebhookURLInvalid   = errors.InvalidParameter.New("target_url must be an absolute http or https URL")
+	ErrWebhookURLNotPublic = errors.InvalidParameter.New("target_url must point to a public host")
+)
+
+// nonPublicPrefixes are the special-purpose ranges, besides the loopback,
+// private, link-local and multicast ones netip reports, that webhooks must
+// not reach.
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("192.0.2.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("198.51.100.0/24"),
+	netip.MustParsePrefix("203.0.113.0/24"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+	netip.MustParsePrefix("2001:db8::/32"),
+}
+
+type WebhookURL string
+
+// NewWebhookURL validates a webhook target. Hosts given as a loopback,
+// private or otherwise non-public address, or as localhost, are rejected;
+// names are only resolved when a delivery connects, which checks the address
+// again.
+func NewWebhookURL(rawURL string) (WebhookURL, error) {
+	trimmed := strings.TrimSpace(rawURL)
+
+	if trimmed == "" {
+		return "", ErrWebhookURLEmpty
+	}
+
+	if len(trimmed) > 2048 {
+		return "", ErrWebhookURLTooLong
+	}
+
+	u, err := url.Parse(trimmed)
+	if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		return "", ErrWebhookURLInvalid
+	}
+
+	host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return "", ErrWebhookURLNotPublic
+	}
+	if addr, err := netip.ParseAddr(host); err == nil && !IsPublicAddr(addr) {
+		return "", ErrWebhookURLNotPublic
+	}
+
+	return WebhookURL(trimmed), nil
+}
+
+func (w WebhookURL) String() string {
+	return string(w)
+}
+
+// IsPublicAddr reports whether addr is a public unicast address, one a
+// webhook may be delivered to.
+func IsPublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap().WithZone("")
+	if !addr.IsValid() || addr.IsUnspecified() || addr.IsLoopback() || addr.IsPrivate() ||
+		addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() || addr.IsInterfaceLocalMulticast() || addr.IsMulticast() {
+		return false
+	}
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
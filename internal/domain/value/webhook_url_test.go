@@ -0,0 +1,83 @@
+package value_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+)
+
+func TestNewWebhookURL(t *testing.T) {
+	tests := map[string]struct {
+		input     string
+		want      value.WebhookURL
+		wantError error
+	}{
+		"valid https URL": {
+			input: "https://example.com/hooks/todo",
+			want:  value.WebhookURL("https://example.com/hooks/todo"),
+		},
+		"URL with surrounding spaces": {
+			input: "  http://example.com:9000/hook  ",
+			want:  value.WebhookURL("http://example.com:9000/hook"),
+		},
+		"public IP address": {
+			input: "https://93.184.215.14/hook",
+			want:  value.WebhookURL("https://93.184.215.14/hook"),
+		},
+		"localhost": {
+			input:     "http://localhost:9000/hook",
+			wantError: value.ErrWebhookURLNotPublic,
+		},
+		"loopback address": {
+			input:     "http://127.0.0.1:6060/debug/vars",
+			wantError: value.ErrWebhookURLNotPublic,
+		},
+		"private address": {
+			input:     "http://10.0.0.5/hook",
+			wantError: value.ErrWebhookURLNotPublic,
+		},
+		"link-local metadata address": {
+			input:     "http://169.254.169.254/latest/meta-data",
+			wantError: value.ErrWebhookURLNotPublic,
+		},
+		"IPv6 loopback address": {
+			input:     "http://[::1]:8080/hook",
+			wantError: value.ErrWebhookURLNotPublic,
+		},
+		"IPv4-mapped private address": {
+			input:     "http://[::ffff:192.168.1.1]/hook",
+			wantError: value.ErrWebhookURLNotPublic,
+		},
+		"empty URL": {
+			input:     "",
+			wantError: value.ErrWebhookURLEmpty,
+		},
+		"relative URL": {
+			input:     "/hooks/todo",
+			wantError: value.ErrWebhookURLInvalid,
+		},
+		"unsupported scheme": {
+			input:     "ftp://example.com/hook",
+			wantError: value.ErrWebhookURLInvalid,
+		},
+		"over 2048 characters": {
+			input:     "https://example.com/" + strings.Repeat("a", 2048),
+			wantError: value.ErrWebhookURLTooLong,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := value.NewWebhookURL(tt.input)
+			if tt.wantError != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.wantError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -8,10 +8,14 @@ const (
 	Unknown          ErrCode = "U000"
 	InvalidParameter ErrCode = "V001"
 	UnpermittedOp    ErrCode = "A001"
+	Forbidden        ErrCode = "A002"
+	Unauthenticated  ErrCode = "A003"
 	NotFound         ErrCode = "N001"
 	RepositoryError  ErrCode = "R001"
 	QueryError       ErrCode = "R002"
 	OptimisticLock   ErrCode = "R003"
+	Archived         ErrCode = "S001"
+	Deleted          ErrCode = "S002"
 )
 
 func (code ErrCode) New(message string) error {
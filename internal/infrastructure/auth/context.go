@@ -0,0 +1,28 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+)
+
+type userIDKeyType string
+
+const userIDKey userIDKeyType = "user_id"
+
+var ErrUnauthenticated = errors.Unauthenticated.New("authentication required")
+
+func WithUserID(ctx context.Context, userID value.UserID) context.Context {
+	return context.WithValue(ctx, userIDKey, userID)
+}
+
+// UserIDFromContext returns the authenticated caller, or ErrUnauthenticated
+// when the request carried no identity.
+func UserIDFromContext(ctx context.Context) (value.UserID, error) {
+	userID, ok := ctx.Value(userIDKey).(value.UserID)
+	if !ok || userID == "" {
+		return "", ErrUnauthenticated
+	}
+	return userID, nil
+}
@@ -0,0 +1,82 @@
+package auth
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/config"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+)
+
+type JWTMiddleware struct {
+	keys   *KeySet
+	parser *jwt.Parser
+}
+
+func NewJWTMiddleware(keys *KeySet, cfg config.AuthConfig) *JWTMiddleware {
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg(), jwt.SigningMethodRS256.Alg()}),
+		jwt.WithExpirationRequired(),
+	}
+	if cfg.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(cfg.Issuer))
+	}
+	if cfg.Audience != "" {
+		opts = append(opts, jwt.WithAudience(cfg.Audience))
+	}
+
+	return &JWTMiddleware{
+		keys:   keys,
+		parser: jwt.NewParser(opts...),
+	}
+}
+
+// Handler rejects requests without a valid bearer token and stores the
+// token subject in the request context as the caller's UserID.
+func (m *JWTMiddleware) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, err := m.Authenticate(r.Header.Get("Authorization"))
+		if err != nil {
+			writeUnauthorized(w, err.Error())
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(WithUserID(r.Context(), userID)))
+	})
+}
+
+// Authenticate returns the subject of the bearer token in an Authorization
+// header value, or an Unauthenticated error saying what is wrong with it.
+func (m *JWTMiddleware) Authenticate(authorization string) (value.UserID, error) {
+	raw, ok := strings.CutPrefix(authorization, "Bearer ")
+	if !ok || raw == "" {
+		return "", errors.Unauthenticated.New("missing bearer token")
+	}
+
+	var claims jwt.RegisteredClaims
+	if _, err := m.parser.ParseWithClaims(raw, &claims, m.keys.Keyfunc); err != nil {
+		return "", errors.Unauthenticated.Wrap(err, "invalid token")
+	}
+
+	userID, err := value.NewUserID(claims.Subject)
+	if err != nil {
+		return "", errors.Unauthenticated.Wrap(err, "invalid token subject")
+	}
+
+	return userID, nil
+}
+
+func writeUnauthorized(w http.ResponseWriter, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("WWW-Authenticate", `Bearer realm="todo"`)
+	w.WriteHeader(http.StatusUnauthorized)
+
+	errorResponse := map[string]any{
+		"status":  "error",
+		"message": message,
+	}
+	_ = json.NewEncoder(w).Encode(errorResponse)
+}
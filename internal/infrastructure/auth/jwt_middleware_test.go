@@ -0,0 +1,198 @@
+package auth_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/config"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/auth"
+)
+
+const testHMACSecret = "test-hmac-secret"
+
+func TestJWTMiddleware_Handler(t *testing.T) {
+	pemKey := mustGenerateRSAKey(t)
+	jwksKey := mustGenerateRSAKey(t)
+
+	dir := t.TempDir()
+	pemFile := writePublicKeyPEM(t, dir, &pemKey.PublicKey)
+	jwksFile := writeJWKS(t, dir, "key-1", &jwksKey.PublicKey)
+
+	cfg := config.AuthConfig{
+		HS256Secret:        testHMACSecret,
+		RS256PublicKeyFile: pemFile,
+		JWKSFile:           jwksFile,
+		Issuer:             "todo-auth",
+	}
+	keys, err := auth.NewKeySet(cfg)
+	require.NoError(t, err)
+	middleware := auth.NewJWTMiddleware(keys, cfg)
+
+	valid := jwt.RegisteredClaims{
+		Subject:   "user123",
+		Issuer:    "todo-auth",
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}
+
+	tests := map[string]struct {
+		authorization string
+		wantStatus    int
+		wantUserID    string
+	}{
+		"valid HS256 token": {
+			authorization: "Bearer " + signHS256(t, valid, testHMACSecret),
+			wantStatus:    http.StatusOK,
+			wantUserID:    "user123",
+		},
+		"valid RS256 token signed with configured PEM key": {
+			authorization: "Bearer " + signRS256(t, valid, pemKey, ""),
+			wantStatus:    http.StatusOK,
+			wantUserID:    "user123",
+		},
+		"valid RS256 token signed with JWKS key": {
+			authorization: "Bearer " + signRS256(t, valid, jwksKey, "key-1"),
+			wantStatus:    http.StatusOK,
+			wantUserID:    "user123",
+		},
+		"missing header": {
+			wantStatus: http.StatusUnauthorized,
+		},
+		"wrong HS256 secret": {
+			authorization: "Bearer " + signHS256(t, valid, "another-secret"),
+			wantStatus:    http.StatusUnauthorized,
+		},
+		"unknown key id": {
+			authorization: "Bearer " + signRS256(t, valid, jwksKey, "key-2"),
+			wantStatus:    http.StatusUnauthorized,
+		},
+		"expired token": {
+			authorization: "Bearer " + signHS256(t, jwt.RegisteredClaims{
+				Subject:   "user123",
+				Issuer:    "todo-auth",
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(-time.Minute)),
+			}, testHMACSecret),
+			wantStatus: http.StatusUnauthorized,
+		},
+		"wrong issuer": {
+			authorization: "Bearer " + signHS256(t, jwt.RegisteredClaims{
+				Subject:   "user123",
+				Issuer:    "someone-else",
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			}, testHMACSecret),
+			wantStatus: http.StatusUnauthorized,
+		},
+		"empty subject": {
+			authorization: "Bearer " + signHS256(t, jwt.RegisteredClaims{
+				Issuer:    "todo-auth",
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			}, testHMACSecret),
+			wantStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			var gotUserID string
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				userID, err := auth.UserIDFromContext(r.Context())
+				require.NoError(t, err)
+				gotUserID = userID.String()
+				w.WriteHeader(http.StatusOK)
+			})
+			req := httptest.NewRequest(http.MethodGet, "/todo-lists", nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			recorder := httptest.NewRecorder()
+
+			// Act
+			middleware.Handler(next).ServeHTTP(recorder, req)
+
+			// Assert
+			require.Equal(t, tt.wantStatus, recorder.Code)
+			require.Equal(t, tt.wantUserID, gotUserID)
+			if tt.wantStatus == http.StatusUnauthorized {
+				require.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+				var body map[string]any
+				require.NoError(t, json.NewDecoder(recorder.Body).Decode(&body))
+				require.Equal(t, "error", body["status"])
+			}
+		})
+	}
+}
+
+func TestNewKeySet_NoKeys(t *testing.T) {
+	_, err := auth.NewKeySet(config.AuthConfig{})
+	require.Error(t, err)
+}
+
+func mustGenerateRSAKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return key
+}
+
+func writePublicKeyPEM(t *testing.T, dir string, key *rsa.PublicKey) string {
+	t.Helper()
+
+	der, err := x509.MarshalPKIXPublicKey(key)
+	require.NoError(t, err)
+	path := filepath.Join(dir, "public.pem")
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o600))
+	return path
+}
+
+func writeJWKS(t *testing.T, dir, kid string, key *rsa.PublicKey) string {
+	t.Helper()
+
+	jwks := map[string]any{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": kid,
+			"alg": "RS256",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}},
+	}
+	data, err := json.Marshal(jwks)
+	require.NoError(t, err)
+	path := filepath.Join(dir, "jwks.json")
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+	return path
+}
+
+func signHS256(t *testing.T, claims jwt.RegisteredClaims, secret string) string {
+	t.Helper()
+
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+	require.NoError(t, err)
+	return signed
+}
+
+func signRS256(t *testing.T, claims jwt.RegisteredClaims, key *rsa.PrivateKey, kid string) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+	return signed
+}
@@ -0,0 +1,128 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/config"
+)
+
+var errNoKeysConfigured = errors.New("no JWT verification keys configured")
+
+// KeySet holds the keys used to verify bearer tokens: an optional HS256
+// secret, and RS256 public keys indexed by key ID. The key stored under the
+// empty ID is used for tokens that carry no "kid" header.
+type KeySet struct {
+	hmacSecret []byte
+	rsaKeys    map[string]*rsa.PublicKey
+}
+
+func NewKeySet(cfg config.AuthConfig) (*KeySet, error) {
+	ks := &KeySet{
+		rsaKeys: make(map[string]*rsa.PublicKey),
+	}
+
+	if cfg.HS256Secret != "" {
+		ks.hmacSecret = []byte(cfg.HS256Secret)
+	}
+
+	if cfg.RS256PublicKeyFile != "" {
+		pemBytes, err := os.ReadFile(cfg.RS256PublicKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read RS256 public key: %w", err)
+		}
+		key, err := jwt.ParseRSAPublicKeyFromPEM(pemBytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse RS256 public key: %w", err)
+		}
+		ks.rsaKeys[""] = key
+	}
+
+	if cfg.JWKSFile != "" {
+		if err := ks.loadJWKS(cfg.JWKSFile); err != nil {
+			return nil, err
+		}
+	}
+
+	if ks.hmacSecret == nil && len(ks.rsaKeys) == 0 {
+		return nil, errNoKeysConfigured
+	}
+
+	return ks, nil
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+func (ks *KeySet) loadJWKS(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read JWKS file: %w", err)
+	}
+
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return fmt.Errorf("failed to parse JWKS file: %w", err)
+	}
+
+	for _, k := range set.Keys {
+		if k.Kty != "RSA" {
+			continue
+		}
+		key, err := k.rsaPublicKey()
+		if err != nil {
+			return fmt.Errorf("invalid JWKS key %q: %w", k.Kid, err)
+		}
+		ks.rsaKeys[k.Kid] = key
+	}
+
+	return nil
+}
+
+func (k jsonWebKey) rsaPublicKey() (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(k.N)
+	if err != nil {
+		return nil, err
+	}
+	e, err := base64.RawURLEncoding.DecodeString(k.E)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(n),
+		E: int(new(big.Int).SetBytes(e).Int64()),
+	}, nil
+}
+
+// Keyfunc picks the verification key matching the token's algorithm and key ID.
+func (ks *KeySet) Keyfunc(token *jwt.Token) (any, error) {
+	switch token.Method.Alg() {
+	case jwt.SigningMethodHS256.Alg():
+		if ks.hmacSecret == nil {
+			return nil, errors.New("HS256 tokens are not accepted")
+		}
+		return ks.hmacSecret, nil
+	case jwt.SigningMethodRS256.Alg():
+		kid, _ := token.Header["kid"].(string)
+		key, ok := ks.rsaKeys[kid]
+		if !ok {
+			return nil, fmt.Errorf("unknown signing key %q", kid)
+		}
+		return key, nil
+	default:
+		return nil, fmt.Errorf("unexpected signing method %s", token.Method.Alg())
+	}
+}
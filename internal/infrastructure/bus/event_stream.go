@@ -0,0 +1,93 @@
+package bus
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/gateway"
+)
+
+// eventBufferSize is how many events a subscriber may fall behind before it
+// is dropped.
+const eventBufferSize = 64
+
+type subscription struct {
+	aggregateID uuid.UUID
+	events      chan event.Event
+}
+
+// EventStream hands the events published on the event bus to the API
+// streams following a list, such as gRPC StreamEvents calls and GraphQL
+// subscriptions. The bus has no way to unsubscribe, so EventStream
+// subscribes once and keeps its own subscribers.
+type EventStream struct {
+	mu   sync.Mutex
+	subs map[*subscription]struct{}
+}
+
+func NewEventStream() *EventStream {
+	return &EventStream{subs: make(map[*subscription]struct{})}
+}
+
+func (s *EventStream) Start(ctx context.Context, subscriber gateway.EventSubscriber) error {
+	subscriber.Subscribe(s.handle)
+	return nil
+}
+
+// Subscribe returns the events of aggregateID published from now on. The
+// channel is closed by cancel, or when the subscriber fell too far behind.
+func (s *EventStream) Subscribe(aggregateID uuid.UUID) (events <-chan event.Event, cancel func()) {
+	sub := &subscription{aggregateID: aggregateID, events: make(chan event.Event, eventBufferSize)}
+
+	s.mu.Lock()
+	s.subs[sub] = struct{}{}
+	s.mu.Unlock()
+
+	return sub.events, func() { s.remove(sub) }
+}
+
+func (s *EventStream) handle(ctx context.Context, ev event.Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for sub := range s.subs {
+		if sub.aggregateID != ev.GetAggregateID() {
+			continue
+		}
+		// Publishing must not wait for a slow client.
+		select {
+		case sub.events <- ev:
+		default:
+			delete(s.subs, sub)
+			close(sub.events)
+		}
+	}
+	return nil
+}
+
+func (s *EventStream) remove(sub *subscription) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.subs[sub]; ok {
+		delete(s.subs, sub)
+		close(sub.events)
+	}
+}
+
+// EndsAccess reports whether ev ends userID's access to the list it was
+// recorded on, because the list was deleted or userID was removed from it.
+// Access is only checked when a stream opens, so streams deliver such an
+// event and then end.
+func EndsAccess(ev event.Event, userID value.UserID) bool {
+	switch ev := ev.(type) {
+	case event.TodoListDeletedEvent:
+		return true
+	case event.CollaboratorRemovedEvent:
+		return ev.CollaboratorID == userID
+	}
+	return false
+}
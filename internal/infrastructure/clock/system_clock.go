@@ -0,0 +1,17 @@
+package clock
+
+import (
+	"time"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/gateway"
+)
+
+type SystemClock struct{}
+
+func NewSystemClock() gateway.Clock {
+	return SystemClock{}
+}
+
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
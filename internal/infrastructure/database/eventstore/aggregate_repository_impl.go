@@ -0,0 +1,95 @@
+package eventstore
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/repository"
+	appErrors "github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+)
+
+type aggregateRepositoryImpl[T repository.Aggregate] struct {
+	eventStore   repository.EventStore
+	newAggregate func() T
+	snapshots    repository.SnapshotStore[T]
+}
+
+// NewAggregateRepository returns a repository keeping the aggregates that
+// newAggregate creates in eventStore. snapshots is optional; without it every
+// load replays the aggregate's whole history.
+func NewAggregateRepository[T repository.Aggregate](eventStore repository.EventStore, newAggregate func() T, snapshots repository.SnapshotStore[T]) repository.AggregateRepository[T] {
+	return &aggregateRepositoryImpl[T]{
+		eventStore:   eventStore,
+		newAggregate: newAggregate,
+		snapshots:    snapshots,
+	}
+}
+
+func (r *aggregateRepositoryImpl[T]) Load(ctx context.Context, id uuid.UUID) (T, error) {
+	var zero T
+
+	aggregate := r.newAggregate()
+	snapshotted := false
+	if r.snapshots != nil {
+		snapshot, ok, err := r.snapshots.LoadSnapshot(ctx, id)
+		if err != nil {
+			return zero, err
+		}
+		if ok {
+			aggregate = snapshot
+			snapshotted = true
+		}
+	}
+
+	events, err := r.eventStore.LoadEvents(ctx, id)
+	if err != nil {
+		return zero, err
+	}
+	if len(events) == 0 && !snapshotted {
+		return zero, appErrors.NotFound.New(fmt.Sprintf("aggregate %s not found", id))
+	}
+
+	// A snapshot already holds the events up to its version.
+	from := aggregate.GetVersion()
+	events = slices.DeleteFunc(events, func(evt event.Event) bool {
+		return evt.GetVersion() <= from
+	})
+
+	if err := aggregate.Hydration(events); err != nil {
+		return zero, err
+	}
+
+	return aggregate, nil
+}
+
+func (r *aggregateRepositoryImpl[T]) Save(ctx context.Context, aggregate T) error {
+	events := aggregate.GetUncommittedEvents()
+	if len(events) == 0 {
+		return nil
+	}
+
+	// The events must follow on from the version the aggregate was loaded
+	// at; the event store then rejects them if another command got there
+	// first.
+	loaded := aggregate.GetVersion() - len(events)
+	for i, evt := range events {
+		if evt.GetVersion() != loaded+i+1 {
+			return appErrors.Unknown.New(fmt.Sprintf("event %s of aggregate %s has version %d, want %d", evt.GetEventType(), aggregate.GetAggregateID(), evt.GetVersion(), loaded+i+1))
+		}
+	}
+
+	if err := r.eventStore.SaveEvents(ctx, aggregate.GetAggregateID(), events); err != nil {
+		return err
+	}
+
+	aggregate.MarkEventsAsCommitted()
+
+	if r.snapshots != nil {
+		return r.snapshots.SaveSnapshot(ctx, aggregate)
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package eventstore_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/command"
+	domainevent "github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/database/eventstore"
+)
+
+type memoryEventStore struct {
+	events map[uuid.UUID][]domainevent.Event
+}
+
+func (m *memoryEventStore) SaveEvents(ctx context.Context, aggregateID uuid.UUID, events []domainevent.Event) error {
+	m.events[aggregateID] = append(m.events[aggregateID], events...)
+	return nil
+}
+
+func (m *memoryEventStore) LoadEvents(ctx context.Context, aggregateID uuid.UUID) ([]domainevent.Event, error) {
+	return append([]domainevent.Event(nil), m.events[aggregateID]...), nil
+}
+
+func (m *memoryEventStore) GetAllEvents(ctx context.Context) ([]domainevent.Event, error) {
+	return nil, nil
+}
+
+func (m *memoryEventStore) GetEventsAfter(ctx context.Context, position int64) ([]domainevent.Event, error) {
+	return nil, nil
+}
+
+func (m *memoryEventStore) LastPosition(ctx context.Context) (int64, error) {
+	return 0, nil
+}
+
+type memorySnapshotStore struct {
+	snapshot *aggregate.TodoListAggregate
+	saved    []int
+}
+
+func (m *memorySnapshotStore) LoadSnapshot(ctx context.Context, id uuid.UUID) (*aggregate.TodoListAggregate, bool, error) {
+	return m.snapshot, m.snapshot != nil, nil
+}
+
+func (m *memorySnapshotStore) SaveSnapshot(ctx context.Context, todoList *aggregate.TodoListAggregate) error {
+	m.saved = append(m.saved, todoList.GetVersion())
+	return nil
+}
+
+func TestAggregateRepository_LoadAndSave(t *testing.T) {
+	owner := value.UserID("user123")
+
+	tests := map[string]struct {
+		snapshotVersion int
+		unknown         bool
+		wantSnapshots   []int
+	}{
+		"replays the whole history without snapshots": {},
+		"replays only the events after a snapshot": {
+			snapshotVersion: 2,
+			wantSnapshots:   []int{4},
+		},
+		"reports an aggregate with neither events nor a snapshot as not found": {
+			unknown: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+			store := &memoryEventStore{events: map[uuid.UUID][]domainevent.Event{}}
+			todoList := aggregate.NewTodoListAggregate()
+			require.NoError(t, todoList.ExecuteCreateTodoListCommand(command.CreateTodoListCommand{UserID: owner}))
+			for _, text := range []string{"Buy milk", "Walk dog"} {
+				require.NoError(t, todoList.ExecuteAddTodoCommand(command.AddTodoCommand{
+					AggregateID: todoList.GetAggregateID(),
+					UserID:      owner,
+					TodoText:    value.TodoText(text),
+				}))
+			}
+			history := todoList.GetUncommittedEvents()
+			store.events[todoList.GetAggregateID()] = history
+
+			var snapshots *memorySnapshotStore
+			repo := eventstore.NewAggregateRepository(store, aggregate.NewTodoListAggregate, nil)
+			if tt.snapshotVersion > 0 {
+				snapshot := aggregate.NewTodoListAggregate()
+				require.NoError(t, snapshot.Hydration(history[:tt.snapshotVersion]))
+				snapshots = &memorySnapshotStore{snapshot: snapshot}
+				repo = eventstore.NewAggregateRepository(store, aggregate.NewTodoListAggregate, snapshots)
+			}
+
+			// Act
+			if tt.unknown {
+				_, err := repo.Load(ctx, uuid.New())
+
+				// Assert
+				require.True(t, errors.IsCode(err, errors.NotFound))
+				return
+			}
+			loaded, err := repo.Load(ctx, todoList.GetAggregateID())
+			require.NoError(t, err)
+			require.NoError(t, loaded.ExecuteSetTodoPriorityCommand(command.SetTodoPriorityCommand{
+				AggregateID: loaded.GetAggregateID(),
+				UserID:      owner,
+				TodoID:      loaded.GetItems()[0].ID,
+				Priority:    value.PriorityHigh,
+			}))
+			err = repo.Save(ctx, loaded)
+
+			// Assert
+			require.NoError(t, err)
+			require.Len(t, loaded.GetItems(), 2)
+			require.Equal(t, 4, loaded.GetVersion())
+			require.Empty(t, loaded.GetUncommittedEvents())
+			require.Len(t, store.events[todoList.GetAggregateID()], 4)
+			if snapshots != nil {
+				require.Equal(t, tt.wantSnapshots, snapshots.saved)
+			}
+		})
+	}
+}
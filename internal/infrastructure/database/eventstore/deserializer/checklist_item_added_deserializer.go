@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+)
+
+type ChecklistItemAddedEventDeserializer struct{}
+
+func NewChecklistItemAddedEventDeserializer() eventDeserializer {
+	return &ChecklistItemAddedEventDeserializer{}
+}
+
+func (d *ChecklistItemAddedEventDeserializer) EventType() string {
+	return "ChecklistItemAddedEvent"
+}
+
+func (d *ChecklistItemAddedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.ChecklistItemAddedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return evt, nil
+}
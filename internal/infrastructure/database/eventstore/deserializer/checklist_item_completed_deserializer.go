@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+)
+
+type ChecklistItemCompletedEventDeserializer struct{}
+
+func NewChecklistItemCompletedEventDeserializer() eventDeserializer {
+	return &ChecklistItemCompletedEventDeserializer{}
+}
+
+func (d *ChecklistItemCompletedEventDeserializer) EventType() string {
+	return "ChecklistItemCompletedEvent"
+}
+
+func (d *ChecklistItemCompletedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.ChecklistItemCompletedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return evt, nil
+}
@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+)
+
+type ChecklistItemRemovedEventDeserializer struct{}
+
+func NewChecklistItemRemovedEventDeserializer() eventDeserializer {
+	return &ChecklistItemRemovedEventDeserializer{}
+}
+
+func (d *ChecklistItemRemovedEventDeserializer) EventType() string {
+	return "ChecklistItemRemovedEvent"
+}
+
+func (d *ChecklistItemRemovedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.ChecklistItemRemovedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return evt, nil
+}
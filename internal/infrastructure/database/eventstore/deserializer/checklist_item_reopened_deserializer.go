@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+)
+
+type ChecklistItemReopenedEventDeserializer struct{}
+
+func NewChecklistItemReopenedEventDeserializer() eventDeserializer {
+	return &ChecklistItemReopenedEventDeserializer{}
+}
+
+func (d *ChecklistItemReopenedEventDeserializer) EventType() string {
+	return "ChecklistItemReopenedEvent"
+}
+
+func (d *ChecklistItemReopenedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.ChecklistItemReopenedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return evt, nil
+}
@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+)
+
+type CollaboratorInvitedEventDeserializer struct{}
+
+func NewCollaboratorInvitedEventDeserializer() eventDeserializer {
+	return &CollaboratorInvitedEventDeserializer{}
+}
+
+func (d *CollaboratorInvitedEventDeserializer) EventType() string {
+	return "CollaboratorInvitedEvent"
+}
+
+func (d *CollaboratorInvitedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.CollaboratorInvitedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return evt, nil
+}
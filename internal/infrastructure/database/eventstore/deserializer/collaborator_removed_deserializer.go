@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+)
+
+type CollaboratorRemovedEventDeserializer struct{}
+
+func NewCollaboratorRemovedEventDeserializer() eventDeserializer {
+	return &CollaboratorRemovedEventDeserializer{}
+}
+
+func (d *CollaboratorRemovedEventDeserializer) EventType() string {
+	return "CollaboratorRemovedEvent"
+}
+
+func (d *CollaboratorRemovedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.CollaboratorRemovedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return evt, nil
+}
@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+)
+
+type CollaboratorRoleChangedEventDeserializer struct{}
+
+func NewCollaboratorRoleChangedEventDeserializer() eventDeserializer {
+	return &CollaboratorRoleChangedEventDeserializer{}
+}
+
+func (d *CollaboratorRoleChangedEventDeserializer) EventType() string {
+	return "CollaboratorRoleChangedEvent"
+}
+
+func (d *CollaboratorRoleChangedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.CollaboratorRoleChangedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return evt, nil
+}
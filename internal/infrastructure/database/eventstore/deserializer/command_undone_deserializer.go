@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+)
+
+type CommandUndoneEventDeserializer struct{}
+
+func NewCommandUndoneEventDeserializer() eventDeserializer {
+	return &CommandUndoneEventDeserializer{}
+}
+
+func (d *CommandUndoneEventDeserializer) EventType() string {
+	return "CommandUndoneEvent"
+}
+
+func (d *CommandUndoneEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.CommandUndoneEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return evt, nil
+}
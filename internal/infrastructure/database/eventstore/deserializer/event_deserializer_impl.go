@@ -22,7 +22,32 @@ func NewEventDeserializer() repository.EventDeserializer {
 	}
 
 	registry.register(NewTodoListCreatedEventDeserializer())
+	registry.register(NewTodoListRenamedEventDeserializer())
 	registry.register(NewTodoAddedEventDeserializer())
+	registry.register(NewTodoDueDateSetEventDeserializer())
+	registry.register(NewTodoBecameOverdueEventDeserializer())
+	registry.register(NewTodoPriorityChangedEventDeserializer())
+	registry.register(NewTodoItemsReorderedEventDeserializer())
+	registry.register(NewTodoTaggedEventDeserializer())
+	registry.register(NewTodoUntaggedEventDeserializer())
+	registry.register(NewTodoCompletedEventDeserializer())
+	registry.register(NewTodoReopenedEventDeserializer())
+	registry.register(NewTodoRemovedEventDeserializer())
+	registry.register(NewTodoMovedOutEventDeserializer())
+	registry.register(NewTodoMovedInEventDeserializer())
+	registry.register(NewChecklistItemAddedEventDeserializer())
+	registry.register(NewChecklistItemCompletedEventDeserializer())
+	registry.register(NewChecklistItemReopenedEventDeserializer())
+	registry.register(NewChecklistItemRemovedEventDeserializer())
+	registry.register(NewRecurringTodoScheduledEventDeserializer())
+	registry.register(NewRecurringTodoCancelledEventDeserializer())
+	registry.register(NewCollaboratorInvitedEventDeserializer())
+	registry.register(NewCollaboratorRoleChangedEventDeserializer())
+	registry.register(NewCollaboratorRemovedEventDeserializer())
+	registry.register(NewTodoListArchivedEventDeserializer())
+	registry.register(NewTodoListRestoredEventDeserializer())
+	registry.register(NewTodoListDeletedEventDeserializer())
+	registry.register(NewCommandUndoneEventDeserializer())
 
 	return registry
 }
@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+)
+
+type RecurringTodoCancelledEventDeserializer struct{}
+
+func NewRecurringTodoCancelledEventDeserializer() eventDeserializer {
+	return &RecurringTodoCancelledEventDeserializer{}
+}
+
+func (d *RecurringTodoCancelledEventDeserializer) EventType() string {
+	return "RecurringTodoCancelledEvent"
+}
+
+func (d *RecurringTodoCancelledEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.RecurringTodoCancelledEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return evt, nil
+}
@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+)
+
+type RecurringTodoScheduledEventDeserializer struct{}
+
+func NewRecurringTodoScheduledEventDeserializer() eventDeserializer {
+	return &RecurringTodoScheduledEventDeserializer{}
+}
+
+func (d *RecurringTodoScheduledEventDeserializer) EventType() string {
+	return "RecurringTodoScheduledEvent"
+}
+
+func (d *RecurringTodoScheduledEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.RecurringTodoScheduledEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return evt, nil
+}
@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+)
+
+type TodoBecameOverdueEventDeserializer struct{}
+
+func NewTodoBecameOverdueEventDeserializer() eventDeserializer {
+	return &TodoBecameOverdueEventDeserializer{}
+}
+
+func (d *TodoBecameOverdueEventDeserializer) EventType() string {
+	return "TodoBecameOverdueEvent"
+}
+
+func (d *TodoBecameOverdueEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.TodoBecameOverdueEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return evt, nil
+}
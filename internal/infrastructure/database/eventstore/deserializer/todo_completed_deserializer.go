@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+)
+
+type TodoCompletedEventDeserializer struct{}
+
+func NewTodoCompletedEventDeserializer() eventDeserializer {
+	return &TodoCompletedEventDeserializer{}
+}
+
+func (d *TodoCompletedEventDeserializer) EventType() string {
+	return "TodoCompletedEvent"
+}
+
+func (d *TodoCompletedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.TodoCompletedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return evt, nil
+}
@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+)
+
+type TodoDueDateSetEventDeserializer struct{}
+
+func NewTodoDueDateSetEventDeserializer() eventDeserializer {
+	return &TodoDueDateSetEventDeserializer{}
+}
+
+func (d *TodoDueDateSetEventDeserializer) EventType() string {
+	return "TodoDueDateSetEvent"
+}
+
+func (d *TodoDueDateSetEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.TodoDueDateSetEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return evt, nil
+}
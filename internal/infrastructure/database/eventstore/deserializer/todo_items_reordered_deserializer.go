@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+)
+
+type TodoItemsReorderedEventDeserializer struct{}
+
+func NewTodoItemsReorderedEventDeserializer() eventDeserializer {
+	return &TodoItemsReorderedEventDeserializer{}
+}
+
+func (d *TodoItemsReorderedEventDeserializer) EventType() string {
+	return "TodoItemsReorderedEvent"
+}
+
+func (d *TodoItemsReorderedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.TodoItemsReorderedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return evt, nil
+}
@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+)
+
+type TodoListArchivedEventDeserializer struct{}
+
+func NewTodoListArchivedEventDeserializer() eventDeserializer {
+	return &TodoListArchivedEventDeserializer{}
+}
+
+func (d *TodoListArchivedEventDeserializer) EventType() string {
+	return "TodoListArchivedEvent"
+}
+
+func (d *TodoListArchivedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.TodoListArchivedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return evt, nil
+}
@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+)
+
+type TodoListDeletedEventDeserializer struct{}
+
+func NewTodoListDeletedEventDeserializer() eventDeserializer {
+	return &TodoListDeletedEventDeserializer{}
+}
+
+func (d *TodoListDeletedEventDeserializer) EventType() string {
+	return "TodoListDeletedEvent"
+}
+
+func (d *TodoListDeletedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.TodoListDeletedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return evt, nil
+}
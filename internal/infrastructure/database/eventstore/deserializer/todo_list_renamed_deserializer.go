@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+)
+
+type TodoListRenamedEventDeserializer struct{}
+
+func NewTodoListRenamedEventDeserializer() eventDeserializer {
+	return &TodoListRenamedEventDeserializer{}
+}
+
+func (d *TodoListRenamedEventDeserializer) EventType() string {
+	return "TodoListRenamedEvent"
+}
+
+func (d *TodoListRenamedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.TodoListRenamedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return evt, nil
+}
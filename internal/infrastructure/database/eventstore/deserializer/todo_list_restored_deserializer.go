@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+)
+
+type TodoListRestoredEventDeserializer struct{}
+
+func NewTodoListRestoredEventDeserializer() eventDeserializer {
+	return &TodoListRestoredEventDeserializer{}
+}
+
+func (d *TodoListRestoredEventDeserializer) EventType() string {
+	return "TodoListRestoredEvent"
+}
+
+func (d *TodoListRestoredEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.TodoListRestoredEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return evt, nil
+}
@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+)
+
+type TodoMovedInEventDeserializer struct{}
+
+func NewTodoMovedInEventDeserializer() eventDeserializer {
+	return &TodoMovedInEventDeserializer{}
+}
+
+func (d *TodoMovedInEventDeserializer) EventType() string {
+	return "TodoMovedInEvent"
+}
+
+func (d *TodoMovedInEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.TodoMovedInEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return evt, nil
+}
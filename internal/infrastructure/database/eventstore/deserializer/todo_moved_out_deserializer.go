@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+)
+
+type TodoMovedOutEventDeserializer struct{}
+
+func NewTodoMovedOutEventDeserializer() eventDeserializer {
+	return &TodoMovedOutEventDeserializer{}
+}
+
+func (d *TodoMovedOutEventDeserializer) EventType() string {
+	return "TodoMovedOutEvent"
+}
+
+func (d *TodoMovedOutEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.TodoMovedOutEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return evt, nil
+}
@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+)
+
+type TodoPriorityChangedEventDeserializer struct{}
+
+func NewTodoPriorityChangedEventDeserializer() eventDeserializer {
+	return &TodoPriorityChangedEventDeserializer{}
+}
+
+func (d *TodoPriorityChangedEventDeserializer) EventType() string {
+	return "TodoPriorityChangedEvent"
+}
+
+func (d *TodoPriorityChangedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.TodoPriorityChangedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return evt, nil
+}
@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+)
+
+type TodoRemovedEventDeserializer struct{}
+
+func NewTodoRemovedEventDeserializer() eventDeserializer {
+	return &TodoRemovedEventDeserializer{}
+}
+
+func (d *TodoRemovedEventDeserializer) EventType() string {
+	return "TodoRemovedEvent"
+}
+
+func (d *TodoRemovedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.TodoRemovedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return evt, nil
+}
@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+)
+
+type TodoReopenedEventDeserializer struct{}
+
+func NewTodoReopenedEventDeserializer() eventDeserializer {
+	return &TodoReopenedEventDeserializer{}
+}
+
+func (d *TodoReopenedEventDeserializer) EventType() string {
+	return "TodoReopenedEvent"
+}
+
+func (d *TodoReopenedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.TodoReopenedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return evt, nil
+}
@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+)
+
+type TodoTaggedEventDeserializer struct{}
+
+func NewTodoTaggedEventDeserializer() eventDeserializer {
+	return &TodoTaggedEventDeserializer{}
+}
+
+func (d *TodoTaggedEventDeserializer) EventType() string {
+	return "TodoTaggedEvent"
+}
+
+func (d *TodoTaggedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.TodoTaggedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return evt, nil
+}
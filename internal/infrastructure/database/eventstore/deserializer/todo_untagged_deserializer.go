@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+)
+
+type TodoUntaggedEventDeserializer struct{}
+
+func NewTodoUntaggedEventDeserializer() eventDeserializer {
+	return &TodoUntaggedEventDeserializer{}
+}
+
+func (d *TodoUntaggedEventDeserializer) EventType() string {
+	return "TodoUntaggedEvent"
+}
+
+func (d *TodoUntaggedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.TodoUntaggedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return evt, nil
+}
@@ -174,3 +174,59 @@ func (e *eventStoreImpl) GetAllEvents(ctx context.Context) ([]event.Event, error
 
 	return events, nil
 }
+
+func (e *eventStoreImpl) GetEventsAfter(ctx context.Context, position int64) ([]event.Event, error) {
+	tx, err := transaction.GetTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT event_type, event_data
+		FROM events
+		WHERE position > ?
+		ORDER BY position ASC
+	`
+
+	rows, err := tx.QueryContext(ctx, query, position)
+	if err != nil {
+		return nil, appErrors.QueryError.Wrap(err, "failed to load events")
+	}
+	defer rows.Close()
+
+	var events []event.Event
+	for rows.Next() {
+		var eventType string
+		var eventData []byte
+		if err := rows.Scan(&eventType, &eventData); err != nil {
+			return nil, appErrors.QueryError.Wrap(err, "failed to scan event row")
+		}
+
+		evt, err := e.deserializer.Deserialize(eventType, eventData)
+		if err != nil {
+			return nil, appErrors.QueryError.Wrap(err, fmt.Sprintf("failed to deserialize event %s", eventType))
+		}
+
+		events = append(events, evt)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, appErrors.QueryError.Wrap(err, "rows iteration error")
+	}
+
+	return events, nil
+}
+
+func (e *eventStoreImpl) LastPosition(ctx context.Context) (int64, error) {
+	tx, err := transaction.GetTx(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var position int64
+	if err := tx.QueryRowContext(ctx, `SELECT COALESCE(MAX(position), 0) FROM events`).Scan(&position); err != nil {
+		return 0, appErrors.QueryError.Wrap(err, "failed to load the last event position")
+	}
+
+	return position, nil
+}
@@ -256,3 +256,55 @@ func TestEventStore_LoadEvents(t *testing.T) {
 		})
 	}
 }
+
+func TestEventStore_GetEventsAfter(t *testing.T) {
+	aggregateID := uuid.New()
+	first := testEvent{AggregateID: aggregateID, EventID: uuid.New(), Type: "TodoListCreated", Version: 1, CreatedAt: time.Now()}
+	second := testEvent{AggregateID: aggregateID, EventID: uuid.New(), Type: "TodoAdded", Version: 2, Title: "Second Todo", CreatedAt: time.Now()}
+
+	tests := map[string]struct {
+		skip       int
+		wantEvents []domainevent.Event
+	}{
+		"returns every event stored after the position": {
+			skip:       0,
+			wantEvents: []domainevent.Event{first, second},
+		},
+		"skips the events up to the position": {
+			skip:       1,
+			wantEvents: []domainevent.Event{second},
+		},
+		"returns nothing after the last position": {
+			skip: 2,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			dbClient := newTestDBClient(t)
+			ctx, tx := beginTxCtx(t, dbClient)
+			defer func() { require.NoError(t, tx.Rollback()) }()
+			store := eventstore.NewEventStore(fakeDeserializer{})
+			before, err := store.LastPosition(ctx)
+			require.NoError(t, err)
+			require.NoError(t, store.SaveEvents(ctx, aggregateID, []domainevent.Event{first}))
+			afterFirst, err := store.LastPosition(ctx)
+			require.NoError(t, err)
+			require.NoError(t, store.SaveEvents(ctx, aggregateID, []domainevent.Event{second}))
+			last, err := store.LastPosition(ctx)
+			require.NoError(t, err)
+			position := []int64{before, afterFirst, last}[tt.skip]
+
+			// Act
+			events, err := store.GetEventsAfter(ctx, position)
+
+			// Assert
+			require.NoError(t, err)
+			require.Len(t, events, len(tt.wantEvents))
+			for i, want := range tt.wantEvents {
+				require.Equal(t, want.GetEventID(), events[i].GetEventID())
+			}
+		})
+	}
+}
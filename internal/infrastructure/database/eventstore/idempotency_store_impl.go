@@ -0,0 +1,144 @@
+package eventstore
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/repository"
+	appErrors "github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/database/transaction"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/idempotencystore"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/idempotencystore/dto"
+)
+
+// storedEvent is how the events of a command result are kept, so that they
+// can be deserialized like the events in the event store.
+type storedEvent struct {
+	EventType string          `json:"event_type"`
+	EventData json.RawMessage `json:"event_data"`
+}
+
+type idempotencyStoreImpl struct {
+	deserializer repository.EventDeserializer
+}
+
+// NewIdempotencyStore returns a store keeping idempotency keys next to the
+// events, in the transaction of the command that recorded them.
+func NewIdempotencyStore(deserializer repository.EventDeserializer) idempotencystore.IdempotencyStore {
+	return &idempotencyStoreImpl{
+		deserializer: deserializer,
+	}
+}
+
+func (s *idempotencyStoreImpl) Find(ctx context.Context, userID, key string, now time.Time) (*dto.IdempotentResultDTO, error) {
+	tx, err := transaction.GetTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT command, payload_hash, aggregate_id, version, events, created_at, expires_at
+		FROM idempotency_keys
+		WHERE user_id = ? AND idempotency_key = ? AND expires_at > ?
+	`
+
+	result := &dto.IdempotentResultDTO{UserID: userID, Key: key}
+	var eventsData []byte
+	err = tx.QueryRowContext(ctx, query, userID, key, now).Scan(
+		&result.Command,
+		&result.PayloadHash,
+		&result.AggregateID,
+		&result.Version,
+		&eventsData,
+		&result.CreatedAt,
+		&result.ExpiresAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, appErrors.NotFound.New("idempotency key not found")
+		}
+		return nil, appErrors.QueryError.Wrap(err, "failed to load idempotency key")
+	}
+
+	var stored []storedEvent
+	if err := json.Unmarshal(eventsData, &stored); err != nil {
+		return nil, appErrors.QueryError.Wrap(err, "failed to decode stored events")
+	}
+
+	result.Events = make([]event.Event, 0, len(stored))
+	for _, se := range stored {
+		evt, err := s.deserializer.Deserialize(se.EventType, se.EventData)
+		if err != nil {
+			return nil, appErrors.QueryError.Wrap(err, fmt.Sprintf("failed to deserialize event %s", se.EventType))
+		}
+		result.Events = append(result.Events, evt)
+	}
+
+	return result, nil
+}
+
+func (s *idempotencyStoreImpl) Save(ctx context.Context, result *dto.IdempotentResultDTO) error {
+	tx, err := transaction.GetTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	stored := make([]storedEvent, 0, len(result.Events))
+	for _, evt := range result.Events {
+		eventData, err := json.Marshal(evt)
+		if err != nil {
+			return err
+		}
+		stored = append(stored, storedEvent{EventType: evt.GetEventType(), EventData: eventData})
+	}
+	eventsData, err := json.Marshal(stored)
+	if err != nil {
+		return err
+	}
+
+	deleteExpired := `
+		DELETE FROM idempotency_keys
+		WHERE user_id = ? AND idempotency_key = ? AND expires_at <= ?
+	`
+	if _, err := tx.ExecContext(ctx, deleteExpired, result.UserID, result.Key, result.CreatedAt); err != nil {
+		return appErrors.RepositoryError.Wrap(err, "failed to delete expired idempotency key")
+	}
+
+	insert := `
+		INSERT INTO idempotency_keys (
+			user_id,
+			idempotency_key,
+			command,
+			payload_hash,
+			aggregate_id,
+			version,
+			events,
+			created_at,
+			expires_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+	_, err = tx.ExecContext(ctx, insert,
+		result.UserID,
+		result.Key,
+		result.Command,
+		result.PayloadHash,
+		result.AggregateID,
+		result.Version,
+		eventsData,
+		result.CreatedAt,
+		result.ExpiresAt,
+	)
+	if err != nil {
+		if isDuplicateKeyError(err) {
+			return appErrors.OptimisticLock.Wrap(err, fmt.Sprintf("idempotency key %s is in use", result.Key))
+		}
+		return appErrors.RepositoryError.Wrap(err, "failed to save idempotency key")
+	}
+
+	return nil
+}
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE user_todo_lists (
+    aggregate_id CHAR(36) PRIMARY KEY,
+    user_id VARCHAR(128) NOT NULL,
+    item_count INT NOT NULL DEFAULT 0,
+    version INT NOT NULL,
+    created_at TIMESTAMP(6) NOT NULL,
+    updated_at TIMESTAMP(6) NOT NULL,
+    INDEX idx_user_created (user_id, created_at),
+    INDEX idx_user_updated (user_id, updated_at)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE user_todo_lists;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE todo_list_collaborators (
+    aggregate_id CHAR(36) NOT NULL,
+    user_id VARCHAR(128) NOT NULL,
+    role VARCHAR(16) NOT NULL,
+    PRIMARY KEY (aggregate_id, user_id),
+    INDEX idx_user (user_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE todo_list_collaborators;
+-- +goose StatementEnd
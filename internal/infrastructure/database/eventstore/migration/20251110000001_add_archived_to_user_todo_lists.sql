@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE user_todo_lists ADD COLUMN archived BOOLEAN NOT NULL DEFAULT FALSE AFTER item_count;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE user_todo_lists DROP COLUMN archived;
+-- +goose StatementEnd
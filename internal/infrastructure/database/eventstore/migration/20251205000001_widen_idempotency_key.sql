@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE idempotency_keys MODIFY idempotency_key VARCHAR(320) NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE idempotency_keys MODIFY idempotency_key VARCHAR(255) NOT NULL;
+-- +goose StatementEnd
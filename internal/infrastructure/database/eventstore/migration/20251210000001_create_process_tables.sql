@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE process_steps (
+    process VARCHAR(255) NOT NULL,
+    correlation_id VARCHAR(255) NOT NULL,
+    version INT NOT NULL,
+    trigger_id VARCHAR(255) NOT NULL,
+    step_data JSON NOT NULL,
+    recorded_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (process, correlation_id, version)
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TABLE process_wake_ups (
+    process VARCHAR(255) NOT NULL,
+    correlation_id VARCHAR(255) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    due_at DATETIME(6) NOT NULL,
+    PRIMARY KEY (process, correlation_id, name),
+    INDEX idx_due_at (due_at)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE process_wake_ups;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE process_steps;
+-- +goose StatementEnd
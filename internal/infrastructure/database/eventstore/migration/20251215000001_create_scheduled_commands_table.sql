@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE scheduled_commands (
+    id VARCHAR(36) PRIMARY KEY,
+    user_id VARCHAR(36) NOT NULL,
+    command_type VARCHAR(64) NOT NULL,
+    aggregate_id VARCHAR(36) NOT NULL DEFAULT '',
+    title VARCHAR(255) NOT NULL DEFAULT '',
+    description TEXT NOT NULL,
+    todo TEXT NOT NULL,
+    due_date VARCHAR(32) NOT NULL DEFAULT '',
+    priority VARCHAR(32) NOT NULL DEFAULT '',
+    run_at DATETIME(6) NOT NULL,
+    status VARCHAR(16) NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL,
+    result_aggregate_id VARCHAR(36) NOT NULL DEFAULT '',
+    result_version INT NOT NULL DEFAULT 0,
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6) NOT NULL,
+    INDEX idx_user_id_run_at (user_id, run_at),
+    INDEX idx_status_run_at (status, run_at)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE scheduled_commands;
+-- +goose StatementEnd
@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE webhook_subscriptions (
+    id CHAR(36) PRIMARY KEY,
+    user_id VARCHAR(128) NOT NULL,
+    aggregate_id VARCHAR(36) NOT NULL DEFAULT '',
+    target_url VARCHAR(2048) NOT NULL,
+    event_types JSON NOT NULL,
+    secret VARCHAR(256) NOT NULL,
+    created_at TIMESTAMP(6) NOT NULL,
+    INDEX idx_user_id (user_id)
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TABLE webhook_deliveries (
+    id CHAR(36) NOT NULL,
+    attempt INT NOT NULL,
+    subscription_id CHAR(36) NOT NULL,
+    event_id CHAR(36) NOT NULL,
+    event_type VARCHAR(255) NOT NULL,
+    status_code INT NOT NULL,
+    error TEXT NOT NULL,
+    succeeded BOOLEAN NOT NULL,
+    attempted_at TIMESTAMP(6) NOT NULL,
+    PRIMARY KEY (id, attempt),
+    INDEX idx_subscription_attempted (subscription_id, attempted_at)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE webhook_deliveries;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE webhook_subscriptions;
+-- +goose StatementEnd
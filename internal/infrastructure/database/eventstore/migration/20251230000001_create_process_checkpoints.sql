@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE events ADD COLUMN position BIGINT NOT NULL AUTO_INCREMENT UNIQUE AFTER event_id;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TABLE process_checkpoints (
+    process VARCHAR(255) PRIMARY KEY,
+    position BIGINT NOT NULL,
+    updated_at TIMESTAMP NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE process_checkpoints;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE events DROP COLUMN position;
+-- +goose StatementEnd
@@ -0,0 +1,215 @@
+package eventstore
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	appErrors "github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/database/transaction"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/processstore"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/processstore/dto"
+)
+
+// storedProcessStep is how the reaction of a step is kept.
+type storedProcessStep struct {
+	Set      map[string]string `json:"set,omitempty"`
+	Schedule []storedWakeUp    `json:"schedule,omitempty"`
+	Cancel   []string          `json:"cancel,omitempty"`
+	Done     bool              `json:"done,omitempty"`
+}
+
+type storedWakeUp struct {
+	Name string    `json:"name"`
+	At   time.Time `json:"at"`
+}
+
+type processStoreImpl struct{}
+
+// NewProcessStore returns a store keeping the steps of process instances as
+// an append-only stream per instance, next to the pending wake-ups.
+func NewProcessStore() processstore.ProcessStore {
+	return &processStoreImpl{}
+}
+
+func (s *processStoreImpl) Load(ctx context.Context, process, correlationID string) ([]*dto.ProcessStepDTO, error) {
+	tx, err := transaction.GetTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT version, trigger_id, step_data, recorded_at
+		FROM process_steps
+		WHERE process = ? AND correlation_id = ?
+		ORDER BY version ASC
+	`
+
+	rows, err := tx.QueryContext(ctx, query, process, correlationID)
+	if err != nil {
+		return nil, appErrors.QueryError.Wrap(err, "failed to load process steps")
+	}
+	defer rows.Close()
+
+	var steps []*dto.ProcessStepDTO
+	for rows.Next() {
+		step := &dto.ProcessStepDTO{Process: process, CorrelationID: correlationID}
+		var data []byte
+		if err := rows.Scan(&step.Version, &step.Trigger, &data, &step.RecordedAt); err != nil {
+			return nil, appErrors.QueryError.Wrap(err, "failed to scan process step")
+		}
+
+		var stored storedProcessStep
+		if err := json.Unmarshal(data, &stored); err != nil {
+			return nil, appErrors.QueryError.Wrap(err, "failed to decode process step")
+		}
+		step.Set = stored.Set
+		step.Cancel = stored.Cancel
+		step.Done = stored.Done
+		for _, wakeUp := range stored.Schedule {
+			step.Schedule = append(step.Schedule, dto.WakeUpDTO{
+				Process:       process,
+				CorrelationID: correlationID,
+				Name:          wakeUp.Name,
+				At:            wakeUp.At,
+			})
+		}
+
+		steps = append(steps, step)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, appErrors.QueryError.Wrap(err, "failed to load process steps")
+	}
+
+	return steps, nil
+}
+
+func (s *processStoreImpl) Append(ctx context.Context, step *dto.ProcessStepDTO) error {
+	tx, err := transaction.GetTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	stored := storedProcessStep{Set: step.Set, Cancel: step.Cancel, Done: step.Done}
+	for _, wakeUp := range step.Schedule {
+		stored.Schedule = append(stored.Schedule, storedWakeUp{Name: wakeUp.Name, At: wakeUp.At})
+	}
+	data, err := json.Marshal(stored)
+	if err != nil {
+		return err
+	}
+
+	insert := `
+		INSERT INTO process_steps (process, correlation_id, version, trigger_id, step_data, recorded_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`
+	_, err = tx.ExecContext(ctx, insert, step.Process, step.CorrelationID, step.Version, step.Trigger, data, step.RecordedAt)
+	if err != nil {
+		if isDuplicateKeyError(err) {
+			return appErrors.OptimisticLock.Wrap(err, fmt.Sprintf("process %s of %s already has step %d", step.Process, step.CorrelationID, step.Version))
+		}
+		return appErrors.RepositoryError.Wrap(err, "failed to save process step")
+	}
+
+	if step.Done {
+		deleteAll := `DELETE FROM process_wake_ups WHERE process = ? AND correlation_id = ?`
+		if _, err := tx.ExecContext(ctx, deleteAll, step.Process, step.CorrelationID); err != nil {
+			return appErrors.RepositoryError.Wrap(err, "failed to delete wake-ups")
+		}
+		return nil
+	}
+
+	deleteOne := `DELETE FROM process_wake_ups WHERE process = ? AND correlation_id = ? AND name = ?`
+	for _, name := range step.Cancel {
+		if _, err := tx.ExecContext(ctx, deleteOne, step.Process, step.CorrelationID, name); err != nil {
+			return appErrors.RepositoryError.Wrap(err, "failed to delete wake-up")
+		}
+	}
+
+	upsert := `
+		INSERT INTO process_wake_ups (process, correlation_id, name, due_at)
+		VALUES (?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE due_at = VALUES(due_at)
+	`
+	for _, wakeUp := range step.Schedule {
+		if _, err := tx.ExecContext(ctx, upsert, step.Process, step.CorrelationID, wakeUp.Name, wakeUp.At); err != nil {
+			return appErrors.RepositoryError.Wrap(err, "failed to save wake-up")
+		}
+	}
+
+	return nil
+}
+
+func (s *processStoreImpl) DueWakeUps(ctx context.Context, now time.Time) ([]*dto.WakeUpDTO, error) {
+	tx, err := transaction.GetTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT process, correlation_id, name, due_at
+		FROM process_wake_ups
+		WHERE due_at <= ?
+		ORDER BY due_at ASC
+	`
+
+	rows, err := tx.QueryContext(ctx, query, now)
+	if err != nil {
+		return nil, appErrors.QueryError.Wrap(err, "failed to load due wake-ups")
+	}
+	defer rows.Close()
+
+	var wakeUps []*dto.WakeUpDTO
+	for rows.Next() {
+		wakeUp := &dto.WakeUpDTO{}
+		if err := rows.Scan(&wakeUp.Process, &wakeUp.CorrelationID, &wakeUp.Name, &wakeUp.At); err != nil {
+			return nil, appErrors.QueryError.Wrap(err, "failed to scan wake-up")
+		}
+		wakeUps = append(wakeUps, wakeUp)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, appErrors.QueryError.Wrap(err, "failed to load due wake-ups")
+	}
+
+	return wakeUps, nil
+}
+
+func (s *processStoreImpl) Checkpoint(ctx context.Context, process string) (int64, error) {
+	tx, err := transaction.GetTx(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var position int64
+	query := `SELECT position FROM process_checkpoints WHERE process = ?`
+	err = tx.QueryRowContext(ctx, query, process).Scan(&position)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, appErrors.QueryError.Wrap(err, "failed to load process checkpoint")
+	}
+
+	return position, nil
+}
+
+func (s *processStoreImpl) SaveCheckpoint(ctx context.Context, process string, position int64) error {
+	tx, err := transaction.GetTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	upsert := `
+		INSERT INTO process_checkpoints (process, position, updated_at)
+		VALUES (?, ?, ?)
+		ON DUPLICATE KEY UPDATE position = VALUES(position), updated_at = VALUES(updated_at)
+	`
+	if _, err := tx.ExecContext(ctx, upsert, process, position, time.Now()); err != nil {
+		return appErrors.RepositoryError.Wrap(err, "failed to save process checkpoint")
+	}
+
+	return nil
+}
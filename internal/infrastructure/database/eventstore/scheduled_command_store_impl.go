@@ -0,0 +1,171 @@
+package eventstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	appErrors "github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/database/transaction"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/schedulestore"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/schedulestore/dto"
+)
+
+const scheduledCommandColumns = `
+	id, user_id, command_type, aggregate_id, title, description, todo, due_date, priority,
+	run_at, status, attempts, last_error, result_aggregate_id, result_version, created_at, updated_at
+`
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+type scheduledCommandStoreImpl struct{}
+
+func NewScheduledCommandStore() schedulestore.ScheduledCommandStore {
+	return &scheduledCommandStoreImpl{}
+}
+
+func (s *scheduledCommandStoreImpl) Save(ctx context.Context, cmd *dto.ScheduledCommandDTO) error {
+	tx, err := transaction.GetTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	insert := `INSERT INTO scheduled_commands (` + scheduledCommandColumns + `)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+	_, err = tx.ExecContext(ctx, insert,
+		cmd.ID, cmd.UserID, cmd.Type, cmd.AggregateID, cmd.Title, cmd.Description, cmd.Todo, cmd.DueDate, cmd.Priority,
+		cmd.RunAt, cmd.Status, cmd.Attempts, cmd.LastError, cmd.ResultAggregateID, cmd.ResultVersion, cmd.CreatedAt, cmd.UpdatedAt,
+	)
+	if err != nil {
+		return appErrors.RepositoryError.Wrap(err, "failed to save scheduled command")
+	}
+
+	return nil
+}
+
+func (s *scheduledCommandStoreImpl) Update(ctx context.Context, cmd *dto.ScheduledCommandDTO, from string) error {
+	tx, err := transaction.GetTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	update := `
+		UPDATE scheduled_commands
+		SET status = ?, attempts = ?, last_error = ?, result_aggregate_id = ?, result_version = ?, updated_at = ?
+		WHERE id = ? AND status = ?
+	`
+	result, err := tx.ExecContext(ctx, update,
+		cmd.Status, cmd.Attempts, cmd.LastError, cmd.ResultAggregateID, cmd.ResultVersion, cmd.UpdatedAt,
+		cmd.ID, from,
+	)
+	if err != nil {
+		return appErrors.RepositoryError.Wrap(err, "failed to update scheduled command")
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return appErrors.RepositoryError.Wrap(err, "failed to update scheduled command")
+	}
+	if affected == 0 {
+		return appErrors.OptimisticLock.New(fmt.Sprintf("scheduled command %s is no longer %s", cmd.ID, from))
+	}
+
+	return nil
+}
+
+func (s *scheduledCommandStoreImpl) Get(ctx context.Context, id string) (*dto.ScheduledCommandDTO, error) {
+	tx, err := transaction.GetTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `SELECT ` + scheduledCommandColumns + ` FROM scheduled_commands WHERE id = ?`
+	cmd, err := scanScheduledCommand(tx.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, appErrors.NotFound.New(fmt.Sprintf("scheduled command %s not found", id))
+		}
+		return nil, appErrors.QueryError.Wrap(err, "failed to load scheduled command")
+	}
+
+	return cmd, nil
+}
+
+func (s *scheduledCommandStoreImpl) ListByUserID(ctx context.Context, userID string) ([]*dto.ScheduledCommandDTO, error) {
+	tx, err := transaction.GetTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `SELECT ` + scheduledCommandColumns + `
+		FROM scheduled_commands
+		WHERE user_id = ?
+		ORDER BY run_at ASC, created_at ASC
+	`
+	rows, err := tx.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, appErrors.QueryError.Wrap(err, "failed to load scheduled commands")
+	}
+	defer rows.Close()
+
+	return scanScheduledCommands(rows)
+}
+
+func (s *scheduledCommandStoreImpl) ListDue(ctx context.Context, now, staleBefore time.Time, limit int) ([]*dto.ScheduledCommandDTO, error) {
+	tx, err := transaction.GetTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `SELECT ` + scheduledCommandColumns + `
+		FROM scheduled_commands
+		WHERE (status = ? AND run_at <= ?) OR (status = ? AND updated_at < ?)
+		ORDER BY run_at ASC
+		LIMIT ?
+	`
+	rows, err := tx.QueryContext(ctx, query,
+		dto.ScheduledCommandPending, now,
+		dto.ScheduledCommandRunning, staleBefore,
+		limit,
+	)
+	if err != nil {
+		return nil, appErrors.QueryError.Wrap(err, "failed to load due scheduled commands")
+	}
+	defer rows.Close()
+
+	return scanScheduledCommands(rows)
+}
+
+func scanScheduledCommands(rows *sql.Rows) ([]*dto.ScheduledCommandDTO, error) {
+	var cmds []*dto.ScheduledCommandDTO
+	for rows.Next() {
+		cmd, err := scanScheduledCommand(rows)
+		if err != nil {
+			return nil, appErrors.QueryError.Wrap(err, "failed to scan scheduled command")
+		}
+		cmds = append(cmds, cmd)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, appErrors.QueryError.Wrap(err, "failed to load scheduled commands")
+	}
+
+	return cmds, nil
+}
+
+func scanScheduledCommand(row rowScanner) (*dto.ScheduledCommandDTO, error) {
+	cmd := &dto.ScheduledCommandDTO{}
+	err := row.Scan(
+		&cmd.ID, &cmd.UserID, &cmd.Type, &cmd.AggregateID, &cmd.Title, &cmd.Description, &cmd.Todo, &cmd.DueDate, &cmd.Priority,
+		&cmd.RunAt, &cmd.Status, &cmd.Attempts, &cmd.LastError, &cmd.ResultAggregateID, &cmd.ResultVersion, &cmd.CreatedAt, &cmd.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return cmd, nil
+}
@@ -0,0 +1,243 @@
+package readmodel
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	appErrors "github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/readmodelstore"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/readmodelstore/dto"
+)
+
+type userTodoListRow struct {
+	AggregateID string    `db:"aggregate_id"`
+	UserID      string    `db:"user_id"`
+	ItemCount   int       `db:"item_count"`
+	Archived    bool      `db:"archived"`
+	Version     int       `db:"version"`
+	CreatedAt   time.Time `db:"created_at"`
+	UpdatedAt   time.Time `db:"updated_at"`
+}
+
+type collaboratorRow struct {
+	AggregateID string `db:"aggregate_id"`
+	UserID      string `db:"user_id"`
+	Role        string `db:"role"`
+}
+
+func (r userTodoListRow) toDTO() *dto.UserTodoListDTO {
+	return &dto.UserTodoListDTO{
+		AggregateID:   r.AggregateID,
+		UserID:        r.UserID,
+		Collaborators: []dto.CollaboratorViewDTO{},
+		ItemCount:     r.ItemCount,
+		Archived:      r.Archived,
+		Version:       r.Version,
+		CreatedAt:     r.CreatedAt,
+		UpdatedAt:     r.UpdatedAt,
+	}
+}
+
+type userTodoListStoreImpl struct {
+	db *sqlx.DB
+}
+
+func NewUserTodoListStore(db *sqlx.DB) readmodelstore.UserTodoListStore {
+	return &userTodoListStoreImpl{db: db}
+}
+
+func (s *userTodoListStoreImpl) Get(ctx context.Context, aggregateID string) (*dto.UserTodoListDTO, error) {
+	query := `
+		SELECT aggregate_id, user_id, item_count, archived, version, created_at, updated_at
+		FROM user_todo_lists
+		WHERE aggregate_id = ?
+	`
+
+	var row userTodoListRow
+	if err := s.db.GetContext(ctx, &row, query, aggregateID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, appErrors.NotFound.New("todo list not found")
+		}
+		return nil, appErrors.QueryError.Wrap(err, "failed to load user todo list")
+	}
+
+	views := []*dto.UserTodoListDTO{row.toDTO()}
+	if err := s.loadCollaborators(ctx, views); err != nil {
+		return nil, err
+	}
+
+	return views[0], nil
+}
+
+func (s *userTodoListStoreImpl) Upsert(ctx context.Context, view *dto.UserTodoListDTO) error {
+	query := `
+		INSERT INTO user_todo_lists (
+			aggregate_id,
+			user_id,
+			item_count,
+			archived,
+			version,
+			created_at,
+			updated_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+			user_id = VALUES(user_id),
+			item_count = VALUES(item_count),
+			archived = VALUES(archived),
+			version = VALUES(version),
+			updated_at = VALUES(updated_at)
+	`
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return appErrors.RepositoryError.Wrap(err, "failed to begin transaction")
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.ExecContext(ctx, query,
+		view.AggregateID,
+		view.UserID,
+		view.ItemCount,
+		view.Archived,
+		view.Version,
+		view.CreatedAt,
+		view.UpdatedAt,
+	)
+	if err != nil {
+		return appErrors.RepositoryError.Wrap(err, "failed to save user todo list")
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM todo_list_collaborators WHERE aggregate_id = ?`, view.AggregateID); err != nil {
+		return appErrors.RepositoryError.Wrap(err, "failed to clear collaborators")
+	}
+	for _, c := range view.Collaborators {
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO todo_list_collaborators (aggregate_id, user_id, role) VALUES (?, ?, ?)`,
+			view.AggregateID, c.UserID, c.Role,
+		)
+		if err != nil {
+			return appErrors.RepositoryError.Wrap(err, "failed to save collaborator")
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return appErrors.RepositoryError.Wrap(err, "failed to commit user todo list")
+	}
+
+	return nil
+}
+
+func (s *userTodoListStoreImpl) Delete(ctx context.Context, aggregateID string) error {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return appErrors.RepositoryError.Wrap(err, "failed to begin transaction")
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM todo_list_collaborators WHERE aggregate_id = ?`, aggregateID); err != nil {
+		return appErrors.RepositoryError.Wrap(err, "failed to delete collaborators")
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM user_todo_lists WHERE aggregate_id = ?`, aggregateID); err != nil {
+		return appErrors.RepositoryError.Wrap(err, "failed to delete user todo list")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return appErrors.RepositoryError.Wrap(err, "failed to commit user todo list deletion")
+	}
+
+	return nil
+}
+
+func (s *userTodoListStoreImpl) ListByUserID(ctx context.Context, q dto.UserTodoListQuery) (*dto.UserTodoListPage, error) {
+	return s.list(ctx, q, `l.user_id = ?`)
+}
+
+func (s *userTodoListStoreImpl) ListSharedWithUserID(ctx context.Context, q dto.UserTodoListQuery) (*dto.UserTodoListPage, error) {
+	return s.list(ctx, q, `l.aggregate_id IN (SELECT aggregate_id FROM todo_list_collaborators WHERE user_id = ?)`)
+}
+
+func (s *userTodoListStoreImpl) list(ctx context.Context, q dto.UserTodoListQuery, where string) (*dto.UserTodoListPage, error) {
+	if !q.IncludeArchived {
+		where += ` AND l.archived = FALSE`
+	}
+
+	var total int
+	countQuery := `SELECT COUNT(*) FROM user_todo_lists l WHERE ` + where
+	if err := s.db.GetContext(ctx, &total, countQuery, q.UserID); err != nil {
+		return nil, appErrors.QueryError.Wrap(err, "failed to count user todo lists")
+	}
+
+	// The sort column and direction come from a fixed whitelist, never from user input.
+	column := dto.SortByCreatedAt
+	if q.SortBy == dto.SortByUpdatedAt {
+		column = dto.SortByUpdatedAt
+	}
+	direction := "ASC"
+	if q.Descending {
+		direction = "DESC"
+	}
+
+	query := fmt.Sprintf(`
+		SELECT l.aggregate_id, l.user_id, l.item_count, l.archived, l.version, l.created_at, l.updated_at
+		FROM user_todo_lists l
+		WHERE %s
+		ORDER BY l.%s %s, l.aggregate_id ASC
+		LIMIT ? OFFSET ?
+	`, where, column, direction)
+
+	var rows []userTodoListRow
+	if err := s.db.SelectContext(ctx, &rows, query, q.UserID, q.Limit, q.Offset); err != nil {
+		return nil, appErrors.QueryError.Wrap(err, "failed to list user todo lists")
+	}
+
+	lists := make([]*dto.UserTodoListDTO, 0, len(rows))
+	for _, row := range rows {
+		lists = append(lists, row.toDTO())
+	}
+	if err := s.loadCollaborators(ctx, lists); err != nil {
+		return nil, err
+	}
+
+	return &dto.UserTodoListPage{
+		TodoLists: lists,
+		Total:     total,
+	}, nil
+}
+
+func (s *userTodoListStoreImpl) loadCollaborators(ctx context.Context, views []*dto.UserTodoListDTO) error {
+	if len(views) == 0 {
+		return nil
+	}
+
+	byID := make(map[string]*dto.UserTodoListDTO, len(views))
+	ids := make([]string, 0, len(views))
+	for _, v := range views {
+		byID[v.AggregateID] = v
+		ids = append(ids, v.AggregateID)
+	}
+
+	query, args, err := sqlx.In(`
+		SELECT aggregate_id, user_id, role
+		FROM todo_list_collaborators
+		WHERE aggregate_id IN (?)
+		ORDER BY user_id ASC
+	`, ids)
+	if err != nil {
+		return appErrors.QueryError.Wrap(err, "failed to build collaborators query")
+	}
+
+	var rows []collaboratorRow
+	if err := s.db.SelectContext(ctx, &rows, s.db.Rebind(query), args...); err != nil {
+		return appErrors.QueryError.Wrap(err, "failed to load collaborators")
+	}
+
+	for _, row := range rows {
+		v := byID[row.AggregateID]
+		v.Collaborators = append(v.Collaborators, dto.CollaboratorViewDTO{UserID: row.UserID, Role: row.Role})
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package readmodel_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/config"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/database/client"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/database/readmodel"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/readmodelstore/dto"
+)
+
+func newTestDBClient(t *testing.T) *client.Client {
+	t.Helper()
+
+	testCfg, err := config.NewTestDatabaseConfig()
+	require.NoError(t, err)
+
+	c, err := client.NewClient(config.DatabaseConfig{
+		User:     testCfg.User,
+		Password: testCfg.Password,
+		Host:     testCfg.Host,
+		Port:     testCfg.Port,
+		Name:     testCfg.Name,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		err = c.Close()
+		require.NoError(t, err)
+	})
+
+	return c
+}
+
+func TestUserTodoListStore_UpsertAndList(t *testing.T) {
+	dbClient := newTestDBClient(t)
+	store := readmodel.NewUserTodoListStore(dbClient.GetDB())
+	ctx := context.Background()
+
+	userID := "user-" + uuid.NewString()
+	base := time.Now().UTC().Truncate(time.Second)
+	first := &dto.UserTodoListDTO{AggregateID: uuid.NewString(), UserID: userID, Version: 1, CreatedAt: base, UpdatedAt: base}
+	second := &dto.UserTodoListDTO{AggregateID: uuid.NewString(), UserID: userID, Version: 1, CreatedAt: base.Add(time.Minute), UpdatedAt: base.Add(time.Minute)}
+
+	t.Cleanup(func() {
+		_, _ = dbClient.GetDB().Exec("DELETE FROM user_todo_lists WHERE user_id = ?", userID)
+	})
+
+	require.NoError(t, store.Upsert(ctx, first))
+	require.NoError(t, store.Upsert(ctx, second))
+
+	first.ItemCount = 2
+	first.Version = 3
+	first.UpdatedAt = base.Add(time.Hour)
+	require.NoError(t, store.Upsert(ctx, first))
+
+	got, err := store.Get(ctx, first.AggregateID)
+	require.NoError(t, err)
+	require.Equal(t, 2, got.ItemCount)
+	require.Equal(t, 3, got.Version)
+
+	page, err := store.ListByUserID(ctx, dto.UserTodoListQuery{
+		UserID:     userID,
+		SortBy:     dto.SortByUpdatedAt,
+		Descending: true,
+		Limit:      10,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, page.Total)
+	require.Equal(t, first.AggregateID, page.TodoLists[0].AggregateID)
+	require.Equal(t, second.AggregateID, page.TodoLists[1].AggregateID)
+
+	_, err = store.Get(ctx, uuid.NewString())
+	require.True(t, errors.IsCode(err, errors.NotFound))
+}
+
+func TestUserTodoListStore_ArchiveAndDelete(t *testing.T) {
+	dbClient := newTestDBClient(t)
+	store := readmodel.NewUserTodoListStore(dbClient.GetDB())
+	ctx := context.Background()
+
+	userID := "user-" + uuid.NewString()
+	now := time.Now().UTC().Truncate(time.Second)
+	archived := &dto.UserTodoListDTO{AggregateID: uuid.NewString(), UserID: userID, Archived: true, Version: 2, CreatedAt: now, UpdatedAt: now}
+	active := &dto.UserTodoListDTO{AggregateID: uuid.NewString(), UserID: userID, Version: 1, CreatedAt: now, UpdatedAt: now}
+
+	t.Cleanup(func() {
+		_, _ = dbClient.GetDB().Exec("DELETE FROM user_todo_lists WHERE user_id = ?", userID)
+	})
+
+	require.NoError(t, store.Upsert(ctx, archived))
+	require.NoError(t, store.Upsert(ctx, active))
+
+	page, err := store.ListByUserID(ctx, dto.UserTodoListQuery{UserID: userID, SortBy: dto.SortByCreatedAt, Limit: 10})
+	require.NoError(t, err)
+	require.Equal(t, 1, page.Total)
+	require.Equal(t, active.AggregateID, page.TodoLists[0].AggregateID)
+
+	page, err = store.ListByUserID(ctx, dto.UserTodoListQuery{UserID: userID, SortBy: dto.SortByCreatedAt, IncludeArchived: true, Limit: 10})
+	require.NoError(t, err)
+	require.Equal(t, 2, page.Total)
+
+	require.NoError(t, store.Delete(ctx, active.AggregateID))
+	_, err = store.Get(ctx, active.AggregateID)
+	require.True(t, errors.IsCode(err, errors.NotFound))
+}
@@ -13,15 +13,21 @@ type txKeyType string
 
 const TxKey txKeyType = "tx"
 
+type afterCommitKeyType struct{}
+
+// afterCommitHooks are kept in ctx next to the transaction they were
+// registered in, so that concurrent transactions do not share them.
+type afterCommitHooks struct {
+	hooks []func() error
+}
+
 type transaction struct {
-	db               *sqlx.DB
-	afterCommitHooks []func() error
+	db *sqlx.DB
 }
 
 func NewTransaction(db *sqlx.DB) repository.Transaction {
 	return &transaction{
-		db:               db,
-		afterCommitHooks: make([]func() error, 0),
+		db: db,
 	}
 }
 
@@ -29,19 +35,34 @@ func (t *transaction) RWTx(ctx context.Context, fn func(ctx context.Context) err
 	return t.runTx(ctx, sql.LevelRepeatableRead, fn)
 }
 
-func (t *transaction) AfterCommit(fn func() error) {
-	t.afterCommitHooks = append(t.afterCommitHooks, fn)
+func (t *transaction) AfterCommit(ctx context.Context, fn func() error) error {
+	hooks, ok := ctx.Value(afterCommitKeyType{}).(*afterCommitHooks)
+	if !ok {
+		return errors.New("transaction not found in context")
+	}
+	hooks.hooks = append(hooks.hooks, fn)
+	return nil
+}
+
+func (t *transaction) InTx(ctx context.Context) bool {
+	_, err := GetTx(ctx)
+	return err == nil
 }
 
 func (t *transaction) runTx(ctx context.Context, level sql.IsolationLevel, fn func(ctx context.Context) error) error {
-	t.afterCommitHooks = make([]func() error, 0)
+	// A transaction already in ctx is joined, so that several commands can
+	// commit together; hooks registered meanwhile run after it commits.
+	if t.InTx(ctx) {
+		return fn(ctx)
+	}
 
 	tx, err := t.db.BeginTxx(ctx, &sql.TxOptions{Isolation: level})
 	if err != nil {
 		return err
 	}
 
-	ctxWithTx := context.WithValue(ctx, TxKey, tx)
+	hooks := &afterCommitHooks{}
+	ctxWithTx := context.WithValue(WithTx(ctx, tx), afterCommitKeyType{}, hooks)
 
 	var committed bool
 	defer func() {
@@ -60,7 +81,7 @@ func (t *transaction) runTx(ctx context.Context, level sql.IsolationLevel, fn fu
 
 	committed = true
 
-	for _, hook := range t.afterCommitHooks {
+	for _, hook := range hooks.hooks {
 		if err := hook(); err != nil {
 			return err
 		}
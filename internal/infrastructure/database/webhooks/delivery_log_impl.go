@@ -0,0 +1,93 @@
+package webhooks
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+	appErrors "github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/webhookstore"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/webhookstore/dto"
+)
+
+type deliveryLogImpl struct {
+	db *sqlx.DB
+}
+
+// NewDeliveryLog returns a log keeping every delivery attempt in db. Attempts
+// are appended by the dispatcher in the background, outside of any
+// transaction.
+func NewDeliveryLog(db *sqlx.DB) webhookstore.WebhookDeliveryLog {
+	return &deliveryLogImpl{db: db}
+}
+
+func (l *deliveryLogImpl) Append(ctx context.Context, delivery *dto.WebhookDeliveryDTO) error {
+	query := `
+		INSERT INTO webhook_deliveries (
+			id,
+			attempt,
+			subscription_id,
+			event_id,
+			event_type,
+			status_code,
+			error,
+			succeeded,
+			attempted_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	_, err := l.db.ExecContext(ctx, query,
+		delivery.ID,
+		delivery.Attempt,
+		delivery.SubscriptionID,
+		delivery.EventID,
+		delivery.EventType,
+		delivery.StatusCode,
+		delivery.Error,
+		delivery.Succeeded,
+		delivery.AttemptedAt,
+	)
+	if err != nil {
+		return appErrors.RepositoryError.Wrap(err, "failed to save webhook delivery")
+	}
+
+	return nil
+}
+
+func (l *deliveryLogImpl) ListBySubscriptionID(ctx context.Context, subscriptionID string) ([]*dto.WebhookDeliveryDTO, error) {
+	query := `
+		SELECT id, attempt, subscription_id, event_id, event_type, status_code, error, succeeded, attempted_at
+		FROM webhook_deliveries
+		WHERE subscription_id = ?
+		ORDER BY attempted_at ASC, id ASC, attempt ASC
+	`
+
+	rows, err := l.db.QueryxContext(ctx, query, subscriptionID)
+	if err != nil {
+		return nil, appErrors.QueryError.Wrap(err, "failed to load webhook deliveries")
+	}
+	defer rows.Close()
+
+	deliveries := make([]*dto.WebhookDeliveryDTO, 0)
+	for rows.Next() {
+		delivery := &dto.WebhookDeliveryDTO{}
+		if err := rows.Scan(
+			&delivery.ID,
+			&delivery.Attempt,
+			&delivery.SubscriptionID,
+			&delivery.EventID,
+			&delivery.EventType,
+			&delivery.StatusCode,
+			&delivery.Error,
+			&delivery.Succeeded,
+			&delivery.AttemptedAt,
+		); err != nil {
+			return nil, appErrors.QueryError.Wrap(err, "failed to scan webhook delivery")
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, appErrors.QueryError.Wrap(err, "failed to load webhook deliveries")
+	}
+
+	return deliveries, nil
+}
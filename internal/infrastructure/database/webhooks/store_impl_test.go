@@ -0,0 +1,132 @@
+package webhooks_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/config"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/database/client"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/database/webhooks"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/webhookstore/dto"
+)
+
+func newTestDBClient(t *testing.T) *client.Client {
+	t.Helper()
+
+	testCfg, err := config.NewTestDatabaseConfig()
+	require.NoError(t, err)
+
+	c, err := client.NewClient(config.DatabaseConfig{
+		User:     testCfg.User,
+		Password: testCfg.Password,
+		Host:     testCfg.Host,
+		Port:     testCfg.Port,
+		Name:     testCfg.Name,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		err = c.Close()
+		require.NoError(t, err)
+	})
+
+	return c
+}
+
+func TestSubscriptionStore_SaveAndFindByUserID(t *testing.T) {
+	dbClient := newTestDBClient(t)
+	store := webhooks.NewSubscriptionStore(dbClient.GetDB())
+	ctx := context.Background()
+
+	userID := "user-" + uuid.NewString()
+	base := time.Now().UTC().Truncate(time.Second)
+	everyList := &dto.WebhookSubscriptionDTO{
+		ID:         uuid.NewString(),
+		UserID:     userID,
+		TargetURL:  "https://example.com/hooks/all",
+		EventTypes: nil,
+		Secret:     "a-shared-secret-of-16+-chars",
+		CreatedAt:  base,
+	}
+	oneList := &dto.WebhookSubscriptionDTO{
+		ID:          uuid.NewString(),
+		UserID:      userID,
+		AggregateID: uuid.NewString(),
+		TargetURL:   "https://example.com/hooks/one",
+		EventTypes:  []string{"TodoAddedEvent", "TodoCompletedEvent"},
+		Secret:      "another-secret-of-16+-chars",
+		CreatedAt:   base.Add(time.Minute),
+	}
+
+	t.Cleanup(func() {
+		_, _ = dbClient.GetDB().Exec("DELETE FROM webhook_subscriptions WHERE user_id = ?", userID)
+	})
+
+	require.NoError(t, store.Save(ctx, oneList))
+	require.NoError(t, store.Save(ctx, everyList))
+
+	subscriptions, err := store.FindByUserID(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, subscriptions, 2)
+	require.Equal(t, everyList.ID, subscriptions[0].ID)
+	require.Empty(t, subscriptions[0].AggregateID)
+	require.Empty(t, subscriptions[0].EventTypes)
+	require.Equal(t, oneList.ID, subscriptions[1].ID)
+	require.Equal(t, oneList.AggregateID, subscriptions[1].AggregateID)
+	require.Equal(t, oneList.TargetURL, subscriptions[1].TargetURL)
+	require.Equal(t, oneList.EventTypes, subscriptions[1].EventTypes)
+	require.Equal(t, oneList.Secret, subscriptions[1].Secret)
+	require.True(t, oneList.CreatedAt.Equal(subscriptions[1].CreatedAt))
+
+	others, err := store.FindByUserID(ctx, "user-"+uuid.NewString())
+	require.NoError(t, err)
+	require.Empty(t, others)
+}
+
+func TestDeliveryLog_AppendAndList(t *testing.T) {
+	dbClient := newTestDBClient(t)
+	log := webhooks.NewDeliveryLog(dbClient.GetDB())
+	ctx := context.Background()
+
+	subscriptionID := uuid.NewString()
+	deliveryID := uuid.NewString()
+	base := time.Now().UTC().Truncate(time.Second)
+	failed := &dto.WebhookDeliveryDTO{
+		ID:             deliveryID,
+		SubscriptionID: subscriptionID,
+		EventID:        uuid.NewString(),
+		EventType:      "TodoAddedEvent",
+		Attempt:        1,
+		StatusCode:     500,
+		Error:          "unexpected status code 500",
+		AttemptedAt:    base,
+	}
+	succeeded := *failed
+	succeeded.Attempt = 2
+	succeeded.StatusCode = 204
+	succeeded.Error = ""
+	succeeded.Succeeded = true
+	succeeded.AttemptedAt = base.Add(time.Second)
+
+	t.Cleanup(func() {
+		_, _ = dbClient.GetDB().Exec("DELETE FROM webhook_deliveries WHERE subscription_id = ?", subscriptionID)
+	})
+
+	require.NoError(t, log.Append(ctx, failed))
+	require.NoError(t, log.Append(ctx, &succeeded))
+
+	deliveries, err := log.ListBySubscriptionID(ctx, subscriptionID)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 2)
+	require.Equal(t, 1, deliveries[0].Attempt)
+	require.False(t, deliveries[0].Succeeded)
+	require.Equal(t, failed.Error, deliveries[0].Error)
+	require.Equal(t, 2, deliveries[1].Attempt)
+	require.True(t, deliveries[1].Succeeded)
+	require.Equal(t, 204, deliveries[1].StatusCode)
+}
@@ -0,0 +1,118 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	appErrors "github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/webhookstore"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/webhookstore/dto"
+)
+
+type subscriptionRow struct {
+	ID          string    `db:"id"`
+	UserID      string    `db:"user_id"`
+	AggregateID string    `db:"aggregate_id"`
+	TargetURL   string    `db:"target_url"`
+	EventTypes  []byte    `db:"event_types"`
+	Secret      string    `db:"secret"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+func (r subscriptionRow) toDTO() (*dto.WebhookSubscriptionDTO, error) {
+	eventTypes := []string{}
+	if err := json.Unmarshal(r.EventTypes, &eventTypes); err != nil {
+		return nil, appErrors.QueryError.Wrap(err, "failed to decode webhook event types")
+	}
+
+	return &dto.WebhookSubscriptionDTO{
+		ID:          r.ID,
+		UserID:      r.UserID,
+		AggregateID: r.AggregateID,
+		TargetURL:   r.TargetURL,
+		EventTypes:  eventTypes,
+		Secret:      r.Secret,
+		CreatedAt:   r.CreatedAt,
+	}, nil
+}
+
+type subscriptionStoreImpl struct {
+	db *sqlx.DB
+}
+
+// NewSubscriptionStore returns a store keeping webhook subscriptions in db.
+// Subscriptions are saved and read outside of command transactions, so the
+// store uses db directly.
+func NewSubscriptionStore(db *sqlx.DB) webhookstore.WebhookSubscriptionStore {
+	return &subscriptionStoreImpl{db: db}
+}
+
+func (s *subscriptionStoreImpl) Save(ctx context.Context, subscription *dto.WebhookSubscriptionDTO) error {
+	eventTypes := subscription.EventTypes
+	if eventTypes == nil {
+		eventTypes = []string{}
+	}
+	encoded, err := json.Marshal(eventTypes)
+	if err != nil {
+		return appErrors.RepositoryError.Wrap(err, "failed to encode webhook event types")
+	}
+
+	query := `
+		INSERT INTO webhook_subscriptions (
+			id,
+			user_id,
+			aggregate_id,
+			target_url,
+			event_types,
+			secret,
+			created_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+			aggregate_id = VALUES(aggregate_id),
+			target_url = VALUES(target_url),
+			event_types = VALUES(event_types),
+			secret = VALUES(secret)
+	`
+
+	_, err = s.db.ExecContext(ctx, query,
+		subscription.ID,
+		subscription.UserID,
+		subscription.AggregateID,
+		subscription.TargetURL,
+		encoded,
+		subscription.Secret,
+		subscription.CreatedAt,
+	)
+	if err != nil {
+		return appErrors.RepositoryError.Wrap(err, "failed to save webhook subscription")
+	}
+
+	return nil
+}
+
+func (s *subscriptionStoreImpl) FindByUserID(ctx context.Context, userID string) ([]*dto.WebhookSubscriptionDTO, error) {
+	query := `
+		SELECT id, user_id, aggregate_id, target_url, event_types, secret, created_at
+		FROM webhook_subscriptions
+		WHERE user_id = ?
+		ORDER BY created_at ASC, id ASC
+	`
+
+	var rows []subscriptionRow
+	if err := s.db.SelectContext(ctx, &rows, query, userID); err != nil {
+		return nil, appErrors.QueryError.Wrap(err, "failed to load webhook subscriptions")
+	}
+
+	subscriptions := make([]*dto.WebhookSubscriptionDTO, 0, len(rows))
+	for _, row := range rows {
+		subscription, err := row.toDTO()
+		if err != nil {
+			return nil, err
+		}
+		subscriptions = append(subscriptions, subscription)
+	}
+
+	return subscriptions, nil
+}
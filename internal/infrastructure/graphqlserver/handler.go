@@ -0,0 +1,120 @@
+package graphqlserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+)
+
+// Request is the body of a GraphQL request sent over HTTP.
+type Request struct {
+	Query         string         `json:"query"`
+	Variables     map[string]any `json:"variables"`
+	OperationName string         `json:"operationName"`
+}
+
+// Handler serves the schema over HTTP. Queries and mutations answer with a
+// single JSON result; subscriptions stream their results as server-sent
+// events, so they require an Accept: text/event-stream request.
+type Handler struct {
+	schema graphql.Schema
+}
+
+func NewHandler(resolver *Resolver) (*Handler, error) {
+	schema, err := NewSchema(resolver)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build GraphQL schema: %w", err)
+	}
+	return &Handler{schema: schema}, nil
+}
+
+func (h *Handler) Serve(w http.ResponseWriter, r *http.Request) {
+	var req Request
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	params := graphql.Params{
+		Schema:         h.schema,
+		RequestString:  req.Query,
+		VariableValues: req.Variables,
+		OperationName:  req.OperationName,
+		Context:        r.Context(),
+	}
+
+	if isSubscription(req.Query, req.OperationName) {
+		if !strings.Contains(r.Header.Get("Accept"), "text/event-stream") {
+			http.Error(w, "subscriptions require Accept: text/event-stream", http.StatusBadRequest)
+			return
+		}
+		h.stream(w, params)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(graphql.Do(params))
+}
+
+// stream writes each result of a subscription as a next event and a complete
+// event once the subscription ends.
+func (h *Handler) stream(w http.ResponseWriter, params graphql.Params) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	// The results are drained even after a write fails, since graphql-go
+	// blocks on sending them until the request context is done.
+	failed := false
+	for result := range graphql.Subscribe(params) {
+		if failed {
+			continue
+		}
+		data, err := json.Marshal(result)
+		if err != nil {
+			failed = true
+			continue
+		}
+		if _, err := fmt.Fprintf(w, "event: next\ndata: %s\n\n", data); err != nil {
+			failed = true
+			continue
+		}
+		flusher.Flush()
+	}
+	if !failed {
+		_, _ = fmt.Fprint(w, "event: complete\ndata:\n\n")
+		flusher.Flush()
+	}
+}
+
+// isSubscription reports whether the operation a request runs is a
+// subscription. Documents that do not parse are left to graphql-go to report.
+func isSubscription(query, operationName string) bool {
+	doc, err := parser.Parse(parser.ParseParams{Source: query})
+	if err != nil {
+		return false
+	}
+	for _, def := range doc.Definitions {
+		op, ok := def.(*ast.OperationDefinition)
+		if !ok {
+			continue
+		}
+		if operationName == "" || (op.Name != nil && op.Name.Value == operationName) {
+			return op.Operation == ast.OperationTypeSubscription
+		}
+	}
+	return false
+}
@@ -0,0 +1,460 @@
+package graphqlserver_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/auth"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/bus"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/graphqlserver"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/gateway"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/presenter"
+	queryInput "github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/query/input"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/query/output"
+)
+
+// userHeader stands in for the JWT middleware: requests carrying it are
+// authenticated as its value.
+const userHeader = "X-Test-User"
+
+type fakeCreateCommand struct{}
+
+func (fakeCreateCommand) Execute(ctx context.Context, in *input.CreateTodoListInput, out presenter.CommandResultPresenter) error {
+	if in.Title == "" {
+		return out.PresentError(ctx, errors.InvalidParameter.New("title must not be empty"))
+	}
+	ev := event.TodoListCreatedEvent{AggregateID: uuid.New(), UserID: value.UserID(in.UserID), EventID: uuid.New(), Timestamp: time.Now(), Version: 1}
+	return out.PresentSuccess(ctx, ev.AggregateID.String(), 1, []event.Event{ev})
+}
+
+// fakeAddCommand fails with err.
+type fakeAddCommand struct {
+	err error
+}
+
+func (f fakeAddCommand) Execute(ctx context.Context, in *input.AddTodoInput, out presenter.CommandResultPresenter) error {
+	return out.PresentError(ctx, f.err)
+}
+
+// fakeQuery lets callers read the lists in readable.
+type fakeQuery struct {
+	readable map[string]bool
+}
+
+func (f fakeQuery) Execute(ctx context.Context, in *queryInput.GetTodoListInput, out presenter.TodoListPresenter) error {
+	if !f.readable[in.AggregateID] {
+		return out.PresentError(ctx, errors.Forbidden.New("not a member of this todo list"))
+	}
+	return out.Present(ctx, &output.GetTodoListOutput{
+		AggregateID: in.AggregateID,
+		UserID:      in.UserID,
+		Title:       "Groceries",
+		Items:       []output.TodoItem{{ID: "todo-1", Text: "Buy milk", Priority: "high"}},
+		Version:     2,
+	})
+}
+
+type fakeUserTodoListsQuery struct {
+	lists []output.TodoListSummary
+}
+
+func (f fakeUserTodoListsQuery) Execute(ctx context.Context, in *queryInput.ListUserTodoListsInput, out presenter.UserTodoListsPresenter) error {
+	return out.Present(ctx, &output.ListUserTodoListsOutput{UserID: in.UserID, TodoLists: f.lists, Total: len(f.lists), Limit: 20})
+}
+
+type fakeHistoryQuery struct{}
+
+func (fakeHistoryQuery) Execute(ctx context.Context, in *queryInput.GetTodoListHistoryInput, out presenter.TodoListHistoryPresenter) error {
+	aggregateID := uuid.MustParse(in.AggregateID)
+	return out.Present(ctx, &output.GetTodoListHistoryOutput{
+		AggregateID: in.AggregateID,
+		Events: []event.Event{
+			event.TodoListCreatedEvent{AggregateID: aggregateID, UserID: value.UserID(in.UserID), EventID: uuid.New(), Version: 1},
+			event.TodoAddedEvent{AggregateID: aggregateID, EventID: uuid.New(), TodoText: "Buy milk", Version: 2},
+		},
+	})
+}
+
+type graphQLResponse struct {
+	Data   map[string]json.RawMessage `json:"data"`
+	Errors []struct {
+		Message    string         `json:"message"`
+		Extensions map[string]any `json:"extensions"`
+	} `json:"errors"`
+}
+
+func newTestServer(t *testing.T, addErr error, readable map[string]bool, lists []output.TodoListSummary) (*httptest.Server, gateway.EventBus) {
+	t.Helper()
+
+	eventBus := bus.NewInMemoryEventBus()
+	events := bus.NewEventStream()
+	require.NoError(t, events.Start(context.Background(), eventBus))
+
+	handler, err := graphqlserver.NewHandler(graphqlserver.NewResolver(
+		fakeCreateCommand{},
+		fakeAddCommand{err: addErr},
+		fakeQuery{readable: readable},
+		fakeUserTodoListsQuery{lists: lists},
+		fakeHistoryQuery{},
+		events,
+	))
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user := r.Header.Get(userHeader); user != "" {
+			r = r.WithContext(auth.WithUserID(r.Context(), value.UserID(user)))
+		}
+		handler.Serve(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, eventBus
+}
+
+func newRequest(t *testing.T, ctx context.Context, url, query string, variables map[string]any) *http.Request {
+	t.Helper()
+
+	body, err := json.Marshal(graphqlserver.Request{Query: query, Variables: variables})
+	require.NoError(t, err)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(userHeader, "user-1")
+	return req
+}
+
+func do(t *testing.T, req *http.Request) graphQLResponse {
+	t.Helper()
+
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	var body graphQLResponse
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&body))
+	return body
+}
+
+func TestHandler_TodoList(t *testing.T) {
+	readable := uuid.New().String()
+	query := `query ($id: ID!) {
+		todoList(id: $id) { id userId title version items { id text priority tags } history { type version data } }
+	}`
+
+	tests := map[string]struct {
+		id       string
+		user     string
+		wantCode string
+	}{
+		"reads a list with its history": {
+			id:   readable,
+			user: "user-1",
+		},
+		"reports a list the caller cannot read as FORBIDDEN": {
+			id:       uuid.New().String(),
+			user:     "user-1",
+			wantCode: "FORBIDDEN",
+		},
+		"reports a request without a user as UNAUTHENTICATED": {
+			id:       readable,
+			wantCode: "UNAUTHENTICATED",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			server, _ := newTestServer(t, nil, map[string]bool{readable: true}, nil)
+			req := newRequest(t, context.Background(), server.URL, query, map[string]any{"id": tt.id})
+			req.Header.Set(userHeader, tt.user)
+
+			// Act
+			body := do(t, req)
+
+			// Assert
+			if tt.wantCode != "" {
+				require.Len(t, body.Errors, 1)
+				require.Equal(t, tt.wantCode, body.Errors[0].Extensions["code"])
+				require.JSONEq(t, `null`, string(body.Data["todoList"]))
+				return
+			}
+			require.Empty(t, body.Errors)
+			var list struct {
+				ID      string `json:"id"`
+				UserID  string `json:"userId"`
+				Version int    `json:"version"`
+				Items   []struct {
+					Text string   `json:"text"`
+					Tags []string `json:"tags"`
+				} `json:"items"`
+				History []struct {
+					Type    string `json:"type"`
+					Version int    `json:"version"`
+					Data    string `json:"data"`
+				} `json:"history"`
+			}
+			require.NoError(t, json.Unmarshal(body.Data["todoList"], &list))
+			require.Equal(t, readable, list.ID)
+			require.Equal(t, "user-1", list.UserID)
+			require.Equal(t, 2, list.Version)
+			require.Len(t, list.Items, 1)
+			require.Equal(t, "Buy milk", list.Items[0].Text)
+			require.Empty(t, list.Items[0].Tags)
+			require.Len(t, list.History, 2)
+			require.Equal(t, "TodoAddedEvent", list.History[1].Type)
+			require.Contains(t, list.History[1].Data, `"TodoText":"Buy milk"`)
+		})
+	}
+}
+
+func TestHandler_TodoLists(t *testing.T) {
+	// Arrange
+	readable := uuid.New().String()
+	server, _ := newTestServer(t, nil, map[string]bool{readable: true}, []output.TodoListSummary{
+		{AggregateID: readable, OwnerID: "user-1", Role: "owner", ItemCount: 1, Version: 2},
+	})
+	req := newRequest(t, context.Background(), server.URL, `{ todoLists { total todoLists { aggregateId itemCount todoList { title } } } }`, nil)
+
+	// Act
+	body := do(t, req)
+
+	// Assert
+	require.Empty(t, body.Errors)
+	require.JSONEq(t,
+		`{"total":1,"todoLists":[{"aggregateId":"`+readable+`","itemCount":1,"todoList":{"title":"Groceries"}}]}`,
+		string(body.Data["todoLists"]))
+}
+
+func TestHandler_CreateTodoList(t *testing.T) {
+	tests := map[string]struct {
+		title    string
+		wantCode string
+	}{
+		"creates a list for the caller": {
+			title: "Groceries",
+		},
+		"reports an invalid command as BAD_USER_INPUT": {
+			wantCode: "BAD_USER_INPUT",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			server, _ := newTestServer(t, nil, nil, nil)
+			req := newRequest(t, context.Background(), server.URL,
+				`mutation ($title: String) { createTodoList(title: $title) { aggregateId version events { type data } } }`,
+				map[string]any{"title": tt.title})
+
+			// Act
+			body := do(t, req)
+
+			// Assert
+			if tt.wantCode != "" {
+				require.Len(t, body.Errors, 1)
+				require.Equal(t, tt.wantCode, body.Errors[0].Extensions["code"])
+				require.Equal(t, "title must not be empty", body.Errors[0].Message)
+				return
+			}
+			require.Empty(t, body.Errors)
+			var result struct {
+				Version int `json:"version"`
+				Events  []struct {
+					Type string `json:"type"`
+					Data string `json:"data"`
+				} `json:"events"`
+			}
+			require.NoError(t, json.Unmarshal(body.Data["createTodoList"], &result))
+			require.Equal(t, 1, result.Version)
+			require.Len(t, result.Events, 1)
+			require.Equal(t, "TodoListCreatedEvent", result.Events[0].Type)
+			require.Contains(t, result.Events[0].Data, `"UserID":"user-1"`)
+		})
+	}
+}
+
+func TestHandler_AddTodo_MapsErrorCodes(t *testing.T) {
+	tests := map[string]struct {
+		err        error
+		wantCode   string
+		wantStatus float64
+	}{
+		"invalid parameter":  {err: errors.InvalidParameter.New("todo text must not be empty"), wantCode: "BAD_USER_INPUT", wantStatus: 422},
+		"forbidden":          {err: errors.Forbidden.New("viewers cannot add todos"), wantCode: "FORBIDDEN", wantStatus: 403},
+		"not found":          {err: errors.NotFound.New("todo list not found"), wantCode: "NOT_FOUND", wantStatus: 404},
+		"archived":           {err: errors.Archived.New("todo list is archived"), wantCode: "CONFLICT", wantStatus: 409},
+		"deleted":            {err: errors.Deleted.New("todo list is deleted"), wantCode: "GONE", wantStatus: 410},
+		"repository failure": {err: errors.RepositoryError.New("connection refused"), wantCode: "INTERNAL_SERVER_ERROR", wantStatus: 500},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			server, _ := newTestServer(t, tt.err, nil, nil)
+			req := newRequest(t, context.Background(), server.URL,
+				`mutation ($id: ID!) { addTodo(todoListId: $id, text: "Buy milk") { version } }`,
+				map[string]any{"id": uuid.New().String()})
+
+			// Act
+			body := do(t, req)
+
+			// Assert
+			require.Len(t, body.Errors, 1)
+			require.Equal(t, tt.wantCode, body.Errors[0].Extensions["code"])
+			require.Equal(t, tt.wantStatus, body.Errors[0].Extensions["status"])
+		})
+	}
+}
+
+func TestHandler_TodoListEvents(t *testing.T) {
+	followed := uuid.New()
+	other := uuid.New()
+	subscription := `subscription ($id: ID!) { todoListEvents(id: $id) { type version } }`
+
+	t.Run("streams the events of the followed list", func(t *testing.T) {
+		// Arrange
+		server, eventBus := newTestServer(t, nil, map[string]bool{followed.String(): true}, nil)
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		req := newRequest(t, ctx, server.URL, subscription, map[string]any{"id": followed.String()})
+		req.Header.Set("Accept", "text/event-stream")
+
+		res, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer res.Body.Close()
+		require.Equal(t, "text/event-stream", res.Header.Get("Content-Type"))
+
+		// Act
+		// The subscription is only known to exist once the request got
+		// through the access check, so publish until the first event arrives.
+		received := make(chan string, 1)
+		go func() {
+			scanner := bufio.NewScanner(res.Body)
+			for scanner.Scan() {
+				if data, ok := strings.CutPrefix(scanner.Text(), "data: "); ok {
+					received <- data
+					return
+				}
+			}
+		}()
+		var got string
+		for got == "" {
+			require.NoError(t, eventBus.Publish(ctx,
+				event.TodoListCreatedEvent{AggregateID: other, EventID: uuid.New(), Version: 1},
+				event.TodoListCreatedEvent{AggregateID: followed, EventID: uuid.New(), Version: 2},
+			))
+			select {
+			case got = <-received:
+			case <-time.After(10 * time.Millisecond):
+			case <-ctx.Done():
+				t.Fatal("no event received")
+			}
+		}
+
+		// Assert
+		require.JSONEq(t, `{"data":{"todoListEvents":{"type":"TodoListCreatedEvent","version":2}}}`, got)
+	})
+
+	accessEnding := map[string]event.Event{
+		"completes once the caller is removed from the list": event.CollaboratorRemovedEvent{AggregateID: followed, CollaboratorID: "user-1", EventID: uuid.New(), Version: 2},
+		"completes once the list is deleted":                 event.TodoListDeletedEvent{AggregateID: followed, EventID: uuid.New(), Version: 2},
+	}
+	for name, ending := range accessEnding {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			server, eventBus := newTestServer(t, nil, map[string]bool{followed.String(): true}, nil)
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			req := newRequest(t, ctx, server.URL, subscription, map[string]any{"id": followed.String()})
+			req.Header.Set("Accept", "text/event-stream")
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			// Act
+			// Publish until the subscription got through the access check
+			// and completed; another collaborator's removal does not end it.
+			var last string
+			completed := make(chan struct{})
+			go func() {
+				defer close(completed)
+				scanner := bufio.NewScanner(res.Body)
+				for scanner.Scan() {
+					if data, ok := strings.CutPrefix(scanner.Text(), "data: "); ok {
+						last = data
+					}
+					if scanner.Text() == "event: complete" {
+						return
+					}
+				}
+			}()
+			for done := false; !done; {
+				require.NoError(t, eventBus.Publish(ctx,
+					event.CollaboratorRemovedEvent{AggregateID: followed, CollaboratorID: "user-2", EventID: uuid.New(), Version: 1},
+					ending,
+				))
+				select {
+				case <-completed:
+					done = true
+				case <-time.After(10 * time.Millisecond):
+				case <-ctx.Done():
+					t.Fatal("subscription did not complete")
+				}
+			}
+
+			// Assert
+			require.Contains(t, last, `"version":2`)
+		})
+	}
+
+	t.Run("refuses a list the caller cannot read", func(t *testing.T) {
+		// Arrange
+		server, _ := newTestServer(t, nil, nil, nil)
+		req := newRequest(t, context.Background(), server.URL, subscription, map[string]any{"id": followed.String()})
+		req.Header.Set("Accept", "text/event-stream")
+
+		// Act
+		res, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer res.Body.Close()
+		scanner := bufio.NewScanner(res.Body)
+		var lines []string
+		for scanner.Scan() {
+			lines = append(lines, scanner.Text())
+		}
+
+		// Assert
+		require.Equal(t, "event: next", lines[0])
+		require.Contains(t, lines[1], `"code":"FORBIDDEN"`)
+		require.Contains(t, lines, "event: complete")
+	})
+
+	t.Run("requires an event stream", func(t *testing.T) {
+		// Arrange
+		server, _ := newTestServer(t, nil, nil, nil)
+		req := newRequest(t, context.Background(), server.URL, subscription, map[string]any{"id": followed.String()})
+
+		// Act
+		res, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer res.Body.Close()
+
+		// Assert
+		require.Equal(t, http.StatusBadRequest, res.StatusCode)
+	})
+}
@@ -0,0 +1,277 @@
+package graphqlserver
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/auth"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/bus"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/presenter/viewmodel"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/view"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command"
+	commandInput "github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/query"
+	queryInput "github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/query/input"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/query/output"
+)
+
+// Resolver resolves the fields of the schema with the use cases, rendering
+// their results through the presenters into GraphQL views.
+type Resolver struct {
+	createCommand      command.TodoListCreateCommandInterface
+	addCommand         command.TodoAddItemCommandInterface
+	todoListQuery      query.TodoListQueryInterface
+	userTodoListsQuery query.UserTodoListsQueryInterface
+	historyQuery       query.TodoListHistoryQueryInterface
+	events             *bus.EventStream
+}
+
+func NewResolver(
+	createCommand command.TodoListCreateCommandInterface,
+	addCommand command.TodoAddItemCommandInterface,
+	todoListQuery query.TodoListQueryInterface,
+	userTodoListsQuery query.UserTodoListsQueryInterface,
+	historyQuery query.TodoListHistoryQueryInterface,
+	events *bus.EventStream,
+) *Resolver {
+	return &Resolver{
+		createCommand:      createCommand,
+		addCommand:         addCommand,
+		todoListQuery:      todoListQuery,
+		userTodoListsQuery: userTodoListsQuery,
+		historyQuery:       historyQuery,
+		events:             events,
+	}
+}
+
+func (r *Resolver) todoList(p graphql.ResolveParams) (any, error) {
+	in := &queryInput.GetTodoListInput{
+		AggregateID: stringArg(p, "id"),
+		MinVersion:  intArg(p, "minVersion"),
+		Filter:      stringArg(p, "filter"),
+		Sort:        stringArg(p, "sort"),
+		Tags:        stringsArg(p, "tags"),
+	}
+	return r.findTodoList(p.Context, in)
+}
+
+func (r *Resolver) summaryTodoList(p graphql.ResolveParams) (any, error) {
+	summary := p.Source.(viewmodel.TodoListSummaryVM)
+	return r.findTodoList(p.Context, &queryInput.GetTodoListInput{AggregateID: summary.AggregateID})
+}
+
+// findTodoList runs the todo list query on behalf of the authenticated user.
+func (r *Resolver) findTodoList(ctx context.Context, in *queryInput.GetTodoListInput) (*viewmodel.TodoListVM, error) {
+	v := view.NewGraphQLTodoListView()
+	p := presenter.NewHTTPTodoListPresenter(v)
+
+	userID, err := auth.UserIDFromContext(ctx)
+	if err != nil {
+		_ = p.PresentError(ctx, err)
+		return v.Result()
+	}
+	in.UserID = userID.String()
+
+	_ = r.todoListQuery.Execute(ctx, in, p)
+	return v.Result()
+}
+
+func (r *Resolver) history(p graphql.ResolveParams) (any, error) {
+	list := p.Source.(*viewmodel.TodoListVM)
+
+	v := view.NewGraphQLTodoListHistoryView()
+	pr := presenter.NewTodoListHistoryPresenterImpl(v)
+
+	userID, err := auth.UserIDFromContext(p.Context)
+	if err != nil {
+		_ = pr.PresentError(p.Context, err)
+		_, err := v.Result()
+		return nil, err
+	}
+
+	in := &queryInput.GetTodoListHistoryInput{
+		AggregateID: list.AggregateID,
+		UserID:      userID.String(),
+	}
+	_ = r.historyQuery.Execute(p.Context, in, pr)
+	vm, err := v.Result()
+	if err != nil {
+		return nil, err
+	}
+	return vm.Events, nil
+}
+
+func (r *Resolver) todoLists(p graphql.ResolveParams) (any, error) {
+	v := view.NewGraphQLUserTodoListsView()
+	pr := presenter.NewHTTPUserTodoListsPresenter(v)
+
+	userID, err := auth.UserIDFromContext(p.Context)
+	if err != nil {
+		_ = pr.PresentError(p.Context, err)
+		return v.Result()
+	}
+
+	in := &queryInput.ListUserTodoListsInput{
+		UserID:          userID.String(),
+		RequesterID:     userID.String(),
+		SortBy:          stringArg(p, "sort"),
+		Order:           stringArg(p, "order"),
+		Limit:           intArg(p, "limit"),
+		Offset:          intArg(p, "offset"),
+		Shared:          boolArg(p, "shared"),
+		IncludeArchived: boolArg(p, "includeArchived"),
+	}
+	_ = r.userTodoListsQuery.Execute(p.Context, in, pr)
+	return v.Result()
+}
+
+func (r *Resolver) createTodoList(p graphql.ResolveParams) (any, error) {
+	v := view.NewGraphQLCommandResultView()
+	pr := presenter.NewCommandResultPresenterImpl(v)
+
+	userID, err := auth.UserIDFromContext(p.Context)
+	if err != nil {
+		_ = pr.PresentError(p.Context, err)
+		return v.Result()
+	}
+
+	in := &commandInput.CreateTodoListInput{
+		UserID:      userID.String(),
+		Title:       stringArg(p, "title"),
+		Description: stringArg(p, "description"),
+	}
+	if err := r.createCommand.Execute(p.Context, in, pr); err != nil {
+		return nil, err
+	}
+	return v.Result()
+}
+
+func (r *Resolver) addTodo(p graphql.ResolveParams) (any, error) {
+	v := view.NewGraphQLCommandResultView()
+	pr := presenter.NewCommandResultPresenterImpl(v)
+
+	userID, err := auth.UserIDFromContext(p.Context)
+	if err != nil {
+		_ = pr.PresentError(p.Context, err)
+		return v.Result()
+	}
+
+	in := &commandInput.AddTodoInput{
+		AggregateID: stringArg(p, "todoListId"),
+		UserID:      userID.String(),
+		Todo:        stringArg(p, "text"),
+		DueDate:     stringArg(p, "dueDate"),
+		Priority:    stringArg(p, "priority"),
+	}
+	if err := r.addCommand.Execute(p.Context, in, pr); err != nil {
+		return nil, err
+	}
+	return v.Result()
+}
+
+// subscribeTodoListEvents streams the events recorded on a todo list the
+// caller can read, presented as in its history, until the subscription's
+// context is done or an event ends the caller's access to the list.
+func (r *Resolver) subscribeTodoListEvents(p graphql.ResolveParams) (any, error) {
+	ctx := p.Context
+	id := stringArg(p, "id")
+
+	aggregateID, err := uuid.Parse(id)
+	if err != nil {
+		v := view.NewGraphQLTodoListView()
+		_ = presenter.NewHTTPTodoListPresenter(v).PresentError(ctx, errors.InvalidParameter.New("id must be a valid UUID"))
+		_, err := v.Result()
+		return nil, subscriptionError(err)
+	}
+
+	// Subscribing first leaves no gap between the check and the stream.
+	events, cancel := r.events.Subscribe(aggregateID)
+
+	if _, err := r.findTodoList(ctx, &queryInput.GetTodoListInput{AggregateID: id}); err != nil {
+		cancel()
+		return nil, subscriptionError(err)
+	}
+	// findTodoList only succeeds for an authenticated caller.
+	userID, _ := auth.UserIDFromContext(ctx)
+
+	payloads := make(chan any)
+	go func() {
+		defer close(payloads)
+		defer cancel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case ev, ok := <-events:
+				if !ok {
+					return
+				}
+				vm, err := presentEvent(ctx, aggregateID, ev)
+				if err != nil {
+					return
+				}
+				select {
+				case payloads <- vm:
+				case <-ctx.Done():
+					return
+				}
+				if bus.EndsAccess(ev, userID) {
+					return
+				}
+			}
+		}
+	}()
+	return payloads, nil
+}
+
+// presentEvent renders one event through the history presenter, so that
+// subscribers see events as the history field shows them.
+func presentEvent(ctx context.Context, aggregateID uuid.UUID, ev event.Event) (viewmodel.EventViewModel, error) {
+	v := view.NewGraphQLTodoListHistoryView()
+	_ = presenter.NewTodoListHistoryPresenterImpl(v).Present(ctx, &output.GetTodoListHistoryOutput{
+		AggregateID: aggregateID.String(),
+		Events:      []event.Event{ev},
+	})
+	vm, err := v.Result()
+	if err != nil {
+		return viewmodel.EventViewModel{}, err
+	}
+	return vm.Events[0], nil
+}
+
+// subscriptionError keeps the extensions of err, which graphql-go only reads
+// from the original error of a located error.
+func subscriptionError(err error) error {
+	return &gqlerrors.Error{Message: err.Error(), OriginalError: err}
+}
+
+func stringArg(p graphql.ResolveParams, name string) string {
+	s, _ := p.Args[name].(string)
+	return s
+}
+
+func intArg(p graphql.ResolveParams, name string) int {
+	i, _ := p.Args[name].(int)
+	return i
+}
+
+func boolArg(p graphql.ResolveParams, name string) bool {
+	b, _ := p.Args[name].(bool)
+	return b
+}
+
+func stringsArg(p graphql.ResolveParams, name string) []string {
+	raw, _ := p.Args[name].([]any)
+	values := make([]string, 0, len(raw))
+	for _, v := range raw {
+		if s, ok := v.(string); ok {
+			values = append(values, s)
+		}
+	}
+	return values
+}
@@ -0,0 +1,217 @@
+package graphqlserver
+
+import (
+	"encoding/json"
+
+	"github.com/graphql-go/graphql"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/presenter/viewmodel"
+)
+
+// NewSchema returns the GraphQL schema served by Handler, resolved by r.
+// Fields resolve from the view models the presenters render, by name.
+func NewSchema(r *Resolver) (graphql.Schema, error) {
+	eventType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Event",
+		Fields: graphql.Fields{
+			"type":       &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"version":    &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"occurredAt": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"data": &graphql.Field{
+				Type:        graphql.NewNonNull(graphql.String),
+				Description: "The event as JSON, as in HTTP command results.",
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					data, err := json.Marshal(p.Source.(viewmodel.EventViewModel).Data)
+					return string(data), err
+				},
+			},
+		},
+	})
+
+	commandResultType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "CommandResult",
+		Fields: graphql.Fields{
+			"aggregateId": &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+			"version":     &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"events":      &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(eventType)))},
+		},
+	})
+
+	checklistItemType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "ChecklistItem",
+		Fields: graphql.Fields{
+			"id":        &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+			"text":      &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"completed": &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean)},
+		},
+	})
+
+	todoItemType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "TodoItem",
+		Fields: graphql.Fields{
+			"id":           &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+			"text":         &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"dueDate":      &graphql.Field{Type: graphql.String},
+			"priority":     &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"tags":         &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.String)))},
+			"overdue":      &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean)},
+			"completed":    &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean)},
+			"checklist":    &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(checklistItemType)))},
+			"recurrenceId": &graphql.Field{Type: graphql.ID},
+		},
+	})
+
+	collaboratorType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Collaborator",
+		Fields: graphql.Fields{
+			"userId": &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+			"role":   &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+		},
+	})
+
+	recurringTodoType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "RecurringTodo",
+		Fields: graphql.Fields{
+			"id":             &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+			"text":           &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"rule":           &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"startDate":      &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"priority":       &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"lastOccurrence": &graphql.Field{Type: graphql.String},
+		},
+	})
+
+	todoListType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "TodoList",
+		Fields: graphql.Fields{
+			"id": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.ID),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(*viewmodel.TodoListVM).AggregateID, nil
+				},
+			},
+			"userId": &graphql.Field{
+				Type:        graphql.NewNonNull(graphql.ID),
+				Description: "The owner of the list.",
+			},
+			"title":          &graphql.Field{Type: graphql.String},
+			"description":    &graphql.Field{Type: graphql.String},
+			"archived":       &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean)},
+			"collaborators":  &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(collaboratorType)))},
+			"items":          &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(todoItemType)))},
+			"recurringTodos": &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(recurringTodoType)))},
+			"version":        &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"updatedAt":      &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"history": &graphql.Field{
+				Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(eventType))),
+				Description: "The events of the list from the event store, oldest first.",
+				Resolve:     r.history,
+			},
+		},
+	})
+
+	todoListSummaryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "TodoListSummary",
+		Fields: graphql.Fields{
+			"aggregateId": &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+			"ownerId":     &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+			"role":        &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"itemCount":   &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"archived":    &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean)},
+			"version":     &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"createdAt":   &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"updatedAt":   &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"todoList": &graphql.Field{
+				Type:        todoListType,
+				Description: "The full list, with its items and history.",
+				Resolve:     r.summaryTodoList,
+			},
+		},
+	})
+
+	todoListPageType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "TodoListPage",
+		Fields: graphql.Fields{
+			"todoLists": &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(todoListSummaryType)))},
+			"total":     &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"limit":     &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"offset":    &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+		},
+	})
+
+	queryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"todoList": &graphql.Field{
+				Type: todoListType,
+				Args: graphql.FieldConfigArgument{
+					"id":         &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+					"minVersion": &graphql.ArgumentConfig{Type: graphql.Int},
+					"filter":     &graphql.ArgumentConfig{Type: graphql.String, Description: "overdue or due_today"},
+					"sort":       &graphql.ArgumentConfig{Type: graphql.String, Description: "priority"},
+					"tags":       &graphql.ArgumentConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.String))},
+				},
+				Resolve: r.todoList,
+			},
+			"todoLists": &graphql.Field{
+				Type:        graphql.NewNonNull(todoListPageType),
+				Description: "The caller's own lists, or with shared the lists shared with the caller.",
+				Args: graphql.FieldConfigArgument{
+					"shared":          &graphql.ArgumentConfig{Type: graphql.Boolean},
+					"sort":            &graphql.ArgumentConfig{Type: graphql.String},
+					"order":           &graphql.ArgumentConfig{Type: graphql.String},
+					"limit":           &graphql.ArgumentConfig{Type: graphql.Int},
+					"offset":          &graphql.ArgumentConfig{Type: graphql.Int},
+					"includeArchived": &graphql.ArgumentConfig{Type: graphql.Boolean},
+				},
+				Resolve: r.todoLists,
+			},
+		},
+	})
+
+	mutationType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Mutation",
+		Fields: graphql.Fields{
+			"createTodoList": &graphql.Field{
+				Type: graphql.NewNonNull(commandResultType),
+				Args: graphql.FieldConfigArgument{
+					"title":       &graphql.ArgumentConfig{Type: graphql.String},
+					"description": &graphql.ArgumentConfig{Type: graphql.String},
+				},
+				Resolve: r.createTodoList,
+			},
+			"addTodo": &graphql.Field{
+				Type: graphql.NewNonNull(commandResultType),
+				Args: graphql.FieldConfigArgument{
+					"todoListId": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+					"text":       &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+					"dueDate":    &graphql.ArgumentConfig{Type: graphql.String},
+					"priority":   &graphql.ArgumentConfig{Type: graphql.String},
+				},
+				Resolve: r.addTodo,
+			},
+		},
+	})
+
+	subscriptionType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Subscription",
+		Fields: graphql.Fields{
+			"todoListEvents": &graphql.Field{
+				Type:        graphql.NewNonNull(eventType),
+				Description: "The events recorded on a list from the time of the subscription.",
+				Args: graphql.FieldConfigArgument{
+					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+				},
+				Subscribe: r.subscribeTodoListEvents,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source, nil
+				},
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{
+		Query:        queryType,
+		Mutation:     mutationType,
+		Subscription: subscriptionType,
+	})
+}
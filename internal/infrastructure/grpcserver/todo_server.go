@@ -0,0 +1,152 @@
+package grpcserver
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/auth"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/bus"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/grpcserver/todov1"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/query"
+	queryInput "github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/query/input"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TodoServer serves TodoService through the use cases behind the HTTP API.
+type TodoServer struct {
+	todov1.UnimplementedTodoServiceServer
+
+	createCommand command.TodoListCreateCommandInterface
+	addCommand    command.TodoAddItemCommandInterface
+	queryUsecase  query.TodoListQueryInterface
+	events        *bus.EventStream
+}
+
+func NewTodoServer(createCommand command.TodoListCreateCommandInterface, addCommand command.TodoAddItemCommandInterface, queryUsecase query.TodoListQueryInterface, events *bus.EventStream) *TodoServer {
+	return &TodoServer{
+		createCommand: createCommand,
+		addCommand:    addCommand,
+		queryUsecase:  queryUsecase,
+		events:        events,
+	}
+}
+
+// NewServer returns a gRPC server serving todoServer to authenticated
+// callers.
+func NewServer(todoServer *TodoServer, authenticator Authenticator) *grpc.Server {
+	server := grpc.NewServer(
+		grpc.UnaryInterceptor(UnaryAuthInterceptor(authenticator)),
+		grpc.StreamInterceptor(StreamAuthInterceptor(authenticator)),
+	)
+	todov1.RegisterTodoServiceServer(server, todoServer)
+	return server
+}
+
+func (s *TodoServer) CreateTodoList(ctx context.Context, req *todov1.CreateTodoListRequest) (*todov1.CommandResult, error) {
+	userID, err := auth.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	p := presenter.NewGRPCCommandResultPresenter()
+	in := &input.CreateTodoListInput{
+		UserID:      userID.String(),
+		Title:       req.GetTitle(),
+		Description: req.GetDescription(),
+	}
+	if err := s.createCommand.Execute(ctx, in, p); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return p.Result()
+}
+
+func (s *TodoServer) AddTodo(ctx context.Context, req *todov1.AddTodoRequest) (*todov1.CommandResult, error) {
+	userID, err := auth.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	p := presenter.NewGRPCCommandResultPresenter()
+	in := &input.AddTodoInput{
+		AggregateID: req.GetAggregateId(),
+		UserID:      userID.String(),
+		Todo:        req.GetText(),
+		DueDate:     req.GetDueDate(),
+		Priority:    req.GetPriority(),
+	}
+	if err := s.addCommand.Execute(ctx, in, p); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return p.Result()
+}
+
+func (s *TodoServer) GetTodoList(ctx context.Context, req *todov1.GetTodoListRequest) (*todov1.TodoList, error) {
+	userID, err := auth.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if req.GetMinVersion() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "min_version must be a non-negative integer")
+	}
+
+	p := presenter.NewGRPCTodoListPresenter()
+	in := &queryInput.GetTodoListInput{
+		AggregateID: req.GetAggregateId(),
+		UserID:      userID.String(),
+		MinVersion:  int(req.GetMinVersion()),
+		Filter:      req.GetFilter(),
+		Sort:        req.GetSort(),
+		Tags:        req.GetTags(),
+	}
+	if err := s.queryUsecase.Execute(ctx, in, p); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return p.Result()
+}
+
+// StreamEvents checks that the caller may read the list through the query
+// use case, then sends its events until the call ends or an event ends the
+// caller's access to the list.
+func (s *TodoServer) StreamEvents(req *todov1.StreamEventsRequest, stream grpc.ServerStreamingServer[todov1.Event]) error {
+	ctx := stream.Context()
+	aggregateID, err := uuid.Parse(req.GetAggregateId())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "aggregate_id must be a valid UUID")
+	}
+
+	// Subscribing first leaves no gap between the check and the stream.
+	events, cancel := s.events.Subscribe(aggregateID)
+	defer cancel()
+
+	if _, err := s.GetTodoList(ctx, &todov1.GetTodoListRequest{AggregateId: aggregateID.String()}); err != nil {
+		return err
+	}
+	// GetTodoList only succeeds for an authenticated caller.
+	userID, _ := auth.UserIDFromContext(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case ev, ok := <-events:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "the stream fell too far behind the todo list's events")
+			}
+			pbEvent, err := presenter.GRPCEvent(ev)
+			if err != nil {
+				return status.Error(codes.Internal, err.Error())
+			}
+			if err := stream.Send(pbEvent); err != nil {
+				return err
+			}
+			if bus.EndsAccess(ev, userID) {
+				return nil
+			}
+		}
+	}
+}
@@ -0,0 +1,276 @@
+package grpcserver_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/bus"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/grpcserver"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/grpcserver/todov1"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/gateway"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/presenter"
+	queryInput "github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/query/input"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/query/output"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const testToken = "Bearer token-of-user-1"
+
+type fakeAuthenticator struct{}
+
+func (fakeAuthenticator) Authenticate(authorization string) (value.UserID, error) {
+	if authorization != testToken {
+		return "", errors.Unauthenticated.New("invalid token")
+	}
+	return value.UserID("user-1"), nil
+}
+
+type fakeCreateCommand struct{}
+
+func (fakeCreateCommand) Execute(ctx context.Context, in *input.CreateTodoListInput, out presenter.CommandResultPresenter) error {
+	if in.Title == "" {
+		return out.PresentError(ctx, errors.InvalidParameter.New("title must not be empty"))
+	}
+	ev := event.TodoListCreatedEvent{AggregateID: uuid.New(), UserID: value.UserID(in.UserID), EventID: uuid.New(), Timestamp: time.Now(), Version: 1}
+	return out.PresentSuccess(ctx, ev.AggregateID.String(), 1, []event.Event{ev})
+}
+
+// fakeAddCommand fails with err.
+type fakeAddCommand struct {
+	err error
+}
+
+func (f fakeAddCommand) Execute(ctx context.Context, in *input.AddTodoInput, out presenter.CommandResultPresenter) error {
+	return out.PresentError(ctx, f.err)
+}
+
+// fakeQuery lets user-1 read the lists in readable.
+type fakeQuery struct {
+	readable map[string]bool
+}
+
+func (f fakeQuery) Execute(ctx context.Context, in *queryInput.GetTodoListInput, out presenter.TodoListPresenter) error {
+	if !f.readable[in.AggregateID] {
+		return out.PresentError(ctx, errors.Forbidden.New("not a member of this todo list"))
+	}
+	return out.Present(ctx, &output.GetTodoListOutput{AggregateID: in.AggregateID, UserID: in.UserID, Version: 1})
+}
+
+func newTestClient(t *testing.T, addErr error, readable map[string]bool) (todov1.TodoServiceClient, gateway.EventBus) {
+	t.Helper()
+
+	eventBus := bus.NewInMemoryEventBus()
+	events := bus.NewEventStream()
+	require.NoError(t, events.Start(context.Background(), eventBus))
+
+	todoServer := grpcserver.NewTodoServer(fakeCreateCommand{}, fakeAddCommand{err: addErr}, fakeQuery{readable: readable}, events)
+	server := grpcserver.NewServer(todoServer, fakeAuthenticator{})
+
+	listener := bufconn.Listen(1024 * 1024)
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return todov1.NewTodoServiceClient(conn), eventBus
+}
+
+func authenticated(ctx context.Context) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "authorization", testToken)
+}
+
+func TestTodoServer_CreateTodoList(t *testing.T) {
+	tests := map[string]struct {
+		ctx      context.Context
+		title    string
+		wantCode codes.Code
+	}{
+		"creates a list for the caller": {
+			ctx:      authenticated(context.Background()),
+			title:    "Groceries",
+			wantCode: codes.OK,
+		},
+		"rejects a call without a token": {
+			ctx:      context.Background(),
+			title:    "Groceries",
+			wantCode: codes.Unauthenticated,
+		},
+		"reports an invalid command as INVALID_ARGUMENT": {
+			ctx:      authenticated(context.Background()),
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			client, _ := newTestClient(t, nil, nil)
+
+			// Act
+			result, err := client.CreateTodoList(tt.ctx, &todov1.CreateTodoListRequest{Title: tt.title})
+
+			// Assert
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode == codes.OK {
+				require.Equal(t, int32(1), result.GetVersion())
+				require.Len(t, result.GetEvents(), 1)
+				require.Equal(t, "TodoListCreatedEvent", result.GetEvents()[0].GetType())
+				require.Contains(t, string(result.GetEvents()[0].GetData()), `"UserID":"user-1"`)
+			}
+		})
+	}
+}
+
+func TestTodoServer_AddTodo_MapsErrorCodes(t *testing.T) {
+	tests := map[string]struct {
+		err      error
+		wantCode codes.Code
+	}{
+		"invalid parameter":  {err: errors.InvalidParameter.New("todo text must not be empty"), wantCode: codes.InvalidArgument},
+		"forbidden":          {err: errors.Forbidden.New("viewers cannot add todos"), wantCode: codes.PermissionDenied},
+		"unpermitted":        {err: errors.UnpermittedOp.New("daily limit reached"), wantCode: codes.PermissionDenied},
+		"not found":          {err: errors.NotFound.New("todo list not found"), wantCode: codes.NotFound},
+		"deleted":            {err: errors.Deleted.New("todo list is deleted"), wantCode: codes.NotFound},
+		"archived":           {err: errors.Archived.New("todo list is archived"), wantCode: codes.FailedPrecondition},
+		"optimistic lock":    {err: errors.OptimisticLock.New("version conflict"), wantCode: codes.Aborted},
+		"repository failure": {err: errors.RepositoryError.New("connection refused"), wantCode: codes.Internal},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			client, _ := newTestClient(t, tt.err, nil)
+
+			// Act
+			_, err := client.AddTodo(authenticated(context.Background()), &todov1.AddTodoRequest{AggregateId: uuid.New().String(), Text: "Buy milk"})
+
+			// Assert
+			require.Equal(t, tt.wantCode, status.Code(err))
+			require.Equal(t, tt.err.Error(), status.Convert(err).Message())
+		})
+	}
+}
+
+func TestTodoServer_StreamEvents(t *testing.T) {
+	followed := uuid.New()
+	other := uuid.New()
+
+	t.Run("sends the events of the followed list", func(t *testing.T) {
+		// Arrange
+		client, eventBus := newTestClient(t, nil, map[string]bool{followed.String(): true})
+		ctx, cancel := context.WithTimeout(authenticated(context.Background()), 5*time.Second)
+		defer cancel()
+
+		stream, err := client.StreamEvents(ctx, &todov1.StreamEventsRequest{AggregateId: followed.String()})
+		require.NoError(t, err)
+
+		// Act
+		// The subscription is only known to exist once the call got through
+		// the access check, so publish until the first event arrives.
+		received := make(chan *todov1.Event, 1)
+		go func() {
+			ev, err := stream.Recv()
+			if err == nil {
+				received <- ev
+			}
+		}()
+		var got *todov1.Event
+		for got == nil {
+			require.NoError(t, eventBus.Publish(ctx,
+				event.TodoListCreatedEvent{AggregateID: other, EventID: uuid.New(), Version: 1},
+				event.TodoListCreatedEvent{AggregateID: followed, EventID: uuid.New(), Version: 2},
+			))
+			select {
+			case got = <-received:
+			case <-time.After(10 * time.Millisecond):
+			case <-ctx.Done():
+				t.Fatal("no event received")
+			}
+		}
+
+		// Assert
+		require.Equal(t, followed.String(), got.GetAggregateId())
+		require.Equal(t, int32(2), got.GetVersion())
+	})
+
+	accessEnding := map[string]event.Event{
+		"ends once the caller is removed from the list": event.CollaboratorRemovedEvent{AggregateID: followed, CollaboratorID: "user-1", EventID: uuid.New(), Version: 2},
+		"ends once the list is deleted":                 event.TodoListDeletedEvent{AggregateID: followed, EventID: uuid.New(), Version: 2},
+	}
+	for name, ending := range accessEnding {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			client, eventBus := newTestClient(t, nil, map[string]bool{followed.String(): true})
+			ctx, cancel := context.WithTimeout(authenticated(context.Background()), 5*time.Second)
+			defer cancel()
+
+			stream, err := client.StreamEvents(ctx, &todov1.StreamEventsRequest{AggregateId: followed.String()})
+			require.NoError(t, err)
+
+			// Act
+			// Publish until the stream got through the access check and
+			// ended; another collaborator's removal does not end it.
+			var last *todov1.Event
+			ended := make(chan error, 1)
+			go func() {
+				for {
+					ev, err := stream.Recv()
+					if err != nil {
+						ended <- err
+						return
+					}
+					last = ev
+				}
+			}()
+			var endErr error
+			for endErr == nil {
+				require.NoError(t, eventBus.Publish(ctx,
+					event.CollaboratorRemovedEvent{AggregateID: followed, CollaboratorID: "user-2", EventID: uuid.New(), Version: 1},
+					ending,
+				))
+				select {
+				case endErr = <-ended:
+				case <-time.After(10 * time.Millisecond):
+				case <-ctx.Done():
+					t.Fatal("stream did not end")
+				}
+			}
+
+			// Assert
+			require.ErrorIs(t, endErr, io.EOF)
+			require.Equal(t, int32(2), last.GetVersion())
+		})
+	}
+
+	t.Run("refuses a list the caller cannot read", func(t *testing.T) {
+		// Arrange
+		client, _ := newTestClient(t, nil, nil)
+
+		// Act
+		stream, err := client.StreamEvents(authenticated(context.Background()), &todov1.StreamEventsRequest{AggregateId: followed.String()})
+		require.NoError(t, err)
+		_, err = stream.Recv()
+
+		// Assert
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
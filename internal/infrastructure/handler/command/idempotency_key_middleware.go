@@ -0,0 +1,38 @@
+package command
+
+import (
+	"net/http"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/auth"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/commandbus"
+)
+
+const maxIdempotencyKeyLength = 255
+
+// IdempotencyKeyMiddleware passes the Idempotency-Key header of a command
+// request on to the command bus, which answers a request sent again with the
+// same key with the original result. It must run after authentication, since
+// keys are scoped to the caller.
+func IdempotencyKeyMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get("Idempotency-Key")
+		if key == "" || r.Method == http.MethodGet {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if len(key) > maxIdempotencyKeyLength {
+			http.Error(w, "Idempotency-Key must be at most 255 characters", http.StatusBadRequest)
+			return
+		}
+
+		userID, err := auth.UserIDFromContext(r.Context())
+		if err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx := commandbus.WithIdempotencyKey(r.Context(), userID.String(), key)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
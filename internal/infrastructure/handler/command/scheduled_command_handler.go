@@ -0,0 +1,86 @@
+package command
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/auth"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/handler/request"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/view"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/input"
+)
+
+type ScheduledCommandHandler struct {
+	createCommand command.ScheduledCommandCreateCommandInterface
+	cancelCommand command.ScheduledCommandCancelCommandInterface
+}
+
+func NewScheduledCommandHandler(
+	createCommand command.ScheduledCommandCreateCommandInterface,
+	cancelCommand command.ScheduledCommandCancelCommandInterface,
+) *ScheduledCommandHandler {
+	return &ScheduledCommandHandler{
+		createCommand: createCommand,
+		cancelCommand: cancelCommand,
+	}
+}
+
+func (h *ScheduledCommandHandler) Schedule(w http.ResponseWriter, r *http.Request) {
+	view := view.NewHTTPScheduledCommandView(w)
+	presenter := presenter.NewHTTPScheduledCommandPresenter(view)
+
+	userID, err := auth.UserIDFromContext(r.Context())
+	if err != nil {
+		_ = presenter.PresentError(r.Context(), err)
+		return
+	}
+
+	var req request.ScheduleCommandRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	usecaseInput := &input.ScheduleCommandInput{
+		UserID:      userID.String(),
+		Type:        req.Type,
+		AggregateID: req.AggregateID,
+		Title:       req.Title,
+		Description: req.Description,
+		Todo:        req.Text,
+		DueDate:     req.DueDate,
+		Priority:    req.Priority,
+		RunAt:       req.RunAt,
+	}
+
+	if err := h.createCommand.Execute(r.Context(), usecaseInput, presenter); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *ScheduledCommandHandler) Cancel(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	view := view.NewHTTPScheduledCommandView(w)
+	presenter := presenter.NewHTTPScheduledCommandPresenter(view)
+
+	userID, err := auth.UserIDFromContext(r.Context())
+	if err != nil {
+		_ = presenter.PresentError(r.Context(), err)
+		return
+	}
+
+	usecaseInput := &input.CancelScheduledCommandInput{
+		UserID:             userID.String(),
+		ScheduledCommandID: vars["scheduled_command_id"],
+	}
+
+	if err := h.cancelCommand.Execute(r.Context(), usecaseInput, presenter); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"github.com/gorilla/mux"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/auth"
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/handler/request"
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/presenter"
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/view"
@@ -26,6 +27,15 @@ func (h *TodoAddItemCommandHandler) AddTodo(w http.ResponseWriter, r *http.Reque
 	vars := mux.Vars(r)
 	aggregateID := vars["aggregate_id"]
 
+	view := view.NewHTTPCommandResultView(w)
+	presenter := presenter.NewCommandResultPresenterImpl(view)
+
+	userID, err := auth.UserIDFromContext(r.Context())
+	if err != nil {
+		_ = presenter.PresentError(r.Context(), err)
+		return
+	}
+
 	var req request.AddTodoRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, "Invalid JSON", http.StatusBadRequest)
@@ -34,14 +44,13 @@ func (h *TodoAddItemCommandHandler) AddTodo(w http.ResponseWriter, r *http.Reque
 
 	usecaseInput := &input.AddTodoInput{
 		AggregateID: aggregateID,
-		UserID:      req.UserID,
+		UserID:      userID.String(),
 		Todo:        req.Text,
+		DueDate:     req.DueDate,
+		Priority:    req.Priority,
 	}
 
-	view := view.NewHTTPCommandResultView(w)
-	presenter := presenter.NewCommandResultPresenterImpl(view)
-
-	err := h.addCommand.Execute(r.Context(), usecaseInput, presenter)
+	err = h.addCommand.Execute(r.Context(), usecaseInput, presenter)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -0,0 +1,64 @@
+package command
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/auth"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/handler/request"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/view"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/input"
+)
+
+type TodoBatchCommandHandler struct {
+	batchCommand command.TodoBatchCommandInterface
+}
+
+func NewTodoBatchCommandHandler(batchCommand command.TodoBatchCommandInterface) *TodoBatchCommandHandler {
+	return &TodoBatchCommandHandler{
+		batchCommand: batchCommand,
+	}
+}
+
+func (h *TodoBatchCommandHandler) Batch(w http.ResponseWriter, r *http.Request) {
+	view := view.NewHTTPBatchCommandResultView(w)
+	presenter := presenter.NewBatchCommandResultPresenterImpl(view)
+
+	userID, err := auth.UserIDFromContext(r.Context())
+	if err != nil {
+		_ = presenter.PresentError(r.Context(), err)
+		return
+	}
+
+	var req request.BatchCommandsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	usecaseInput := &input.BatchCommandsInput{
+		UserID:   userID.String(),
+		Atomic:   req.Atomic,
+		Commands: make([]input.BatchCommandInput, 0, len(req.Commands)),
+	}
+	for _, cmd := range req.Commands {
+		usecaseInput.Commands = append(usecaseInput.Commands, input.BatchCommandInput{
+			Type:        cmd.Type,
+			AggregateID: cmd.AggregateID,
+			ListIndex:   cmd.ListIndex,
+			Title:       cmd.Title,
+			Description: cmd.Description,
+			Todo:        cmd.Text,
+			DueDate:     cmd.DueDate,
+			Priority:    cmd.Priority,
+		})
+	}
+
+	err = h.batchCommand.Execute(r.Context(), usecaseInput, presenter)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
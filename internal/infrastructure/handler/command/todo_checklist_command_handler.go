@@ -0,0 +1,113 @@
+package command
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/auth"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/handler/request"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/view"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/input"
+)
+
+type TodoChecklistCommandHandler struct {
+	addCommand      command.TodoAddChecklistItemCommandInterface
+	completeCommand command.TodoCompleteChecklistItemCommandInterface
+	removeCommand   command.TodoRemoveChecklistItemCommandInterface
+}
+
+func NewTodoChecklistCommandHandler(
+	addCommand command.TodoAddChecklistItemCommandInterface,
+	completeCommand command.TodoCompleteChecklistItemCommandInterface,
+	removeCommand command.TodoRemoveChecklistItemCommandInterface,
+) *TodoChecklistCommandHandler {
+	return &TodoChecklistCommandHandler{
+		addCommand:      addCommand,
+		completeCommand: completeCommand,
+		removeCommand:   removeCommand,
+	}
+}
+
+func (h *TodoChecklistCommandHandler) Add(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	view := view.NewHTTPCommandResultView(w)
+	presenter := presenter.NewCommandResultPresenterImpl(view)
+
+	userID, err := auth.UserIDFromContext(r.Context())
+	if err != nil {
+		_ = presenter.PresentError(r.Context(), err)
+		return
+	}
+
+	var req request.AddChecklistItemRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	usecaseInput := &input.AddChecklistItemInput{
+		AggregateID: vars["aggregate_id"],
+		UserID:      userID.String(),
+		TodoID:      vars["todo_id"],
+		Text:        req.Text,
+	}
+
+	if err := h.addCommand.Execute(r.Context(), usecaseInput, presenter); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *TodoChecklistCommandHandler) Complete(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	view := view.NewHTTPCommandResultView(w)
+	presenter := presenter.NewCommandResultPresenterImpl(view)
+
+	userID, err := auth.UserIDFromContext(r.Context())
+	if err != nil {
+		_ = presenter.PresentError(r.Context(), err)
+		return
+	}
+
+	usecaseInput := &input.CompleteChecklistItemInput{
+		AggregateID:     vars["aggregate_id"],
+		UserID:          userID.String(),
+		TodoID:          vars["todo_id"],
+		ChecklistItemID: vars["checklist_id"],
+	}
+
+	if err := h.completeCommand.Execute(r.Context(), usecaseInput, presenter); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *TodoChecklistCommandHandler) Remove(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	view := view.NewHTTPCommandResultView(w)
+	presenter := presenter.NewCommandResultPresenterImpl(view)
+
+	userID, err := auth.UserIDFromContext(r.Context())
+	if err != nil {
+		_ = presenter.PresentError(r.Context(), err)
+		return
+	}
+
+	usecaseInput := &input.RemoveChecklistItemInput{
+		AggregateID:     vars["aggregate_id"],
+		UserID:          userID.String(),
+		TodoID:          vars["todo_id"],
+		ChecklistItemID: vars["checklist_id"],
+	}
+
+	if err := h.removeCommand.Execute(r.Context(), usecaseInput, presenter); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
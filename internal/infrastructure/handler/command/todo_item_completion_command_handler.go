@@ -0,0 +1,55 @@
+package command
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/auth"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/view"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/input"
+)
+
+type TodoItemCompletionCommandHandler struct {
+	setCompletionCommand command.TodoSetCompletionCommandInterface
+}
+
+func NewTodoItemCompletionCommandHandler(setCompletionCommand command.TodoSetCompletionCommandInterface) *TodoItemCompletionCommandHandler {
+	return &TodoItemCompletionCommandHandler{
+		setCompletionCommand: setCompletionCommand,
+	}
+}
+
+func (h *TodoItemCompletionCommandHandler) Complete(w http.ResponseWriter, r *http.Request) {
+	h.setCompletion(w, r, true)
+}
+
+func (h *TodoItemCompletionCommandHandler) Reopen(w http.ResponseWriter, r *http.Request) {
+	h.setCompletion(w, r, false)
+}
+
+func (h *TodoItemCompletionCommandHandler) setCompletion(w http.ResponseWriter, r *http.Request, completed bool) {
+	vars := mux.Vars(r)
+
+	view := view.NewHTTPCommandResultView(w)
+	presenter := presenter.NewCommandResultPresenterImpl(view)
+
+	userID, err := auth.UserIDFromContext(r.Context())
+	if err != nil {
+		_ = presenter.PresentError(r.Context(), err)
+		return
+	}
+
+	usecaseInput := &input.SetTodoCompletionInput{
+		AggregateID: vars["aggregate_id"],
+		UserID:      userID.String(),
+		TodoID:      vars["todo_id"],
+		Completed:   completed,
+	}
+
+	if err := h.setCompletionCommand.Execute(r.Context(), usecaseInput, presenter); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
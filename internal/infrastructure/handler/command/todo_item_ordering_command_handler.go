@@ -0,0 +1,90 @@
+package command
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/auth"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/handler/request"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/view"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/input"
+)
+
+type TodoItemOrderingCommandHandler struct {
+	setPriorityCommand command.TodoSetPriorityCommandInterface
+	reorderCommand     command.TodoReorderItemsCommandInterface
+}
+
+func NewTodoItemOrderingCommandHandler(
+	setPriorityCommand command.TodoSetPriorityCommandInterface,
+	reorderCommand command.TodoReorderItemsCommandInterface,
+) *TodoItemOrderingCommandHandler {
+	return &TodoItemOrderingCommandHandler{
+		setPriorityCommand: setPriorityCommand,
+		reorderCommand:     reorderCommand,
+	}
+}
+
+func (h *TodoItemOrderingCommandHandler) SetPriority(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	view := view.NewHTTPCommandResultView(w)
+	presenter := presenter.NewCommandResultPresenterImpl(view)
+
+	userID, err := auth.UserIDFromContext(r.Context())
+	if err != nil {
+		_ = presenter.PresentError(r.Context(), err)
+		return
+	}
+
+	var req request.SetPriorityRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	usecaseInput := &input.SetTodoPriorityInput{
+		AggregateID: vars["aggregate_id"],
+		UserID:      userID.String(),
+		TodoID:      vars["todo_id"],
+		Priority:    req.Priority,
+	}
+
+	if err := h.setPriorityCommand.Execute(r.Context(), usecaseInput, presenter); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *TodoItemOrderingCommandHandler) Reorder(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	view := view.NewHTTPCommandResultView(w)
+	presenter := presenter.NewCommandResultPresenterImpl(view)
+
+	userID, err := auth.UserIDFromContext(r.Context())
+	if err != nil {
+		_ = presenter.PresentError(r.Context(), err)
+		return
+	}
+
+	var req request.ReorderTodosRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	usecaseInput := &input.ReorderTodoItemsInput{
+		AggregateID: vars["aggregate_id"],
+		UserID:      userID.String(),
+		TodoIDs:     req.TodoIDs,
+	}
+
+	if err := h.reorderCommand.Execute(r.Context(), usecaseInput, presenter); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
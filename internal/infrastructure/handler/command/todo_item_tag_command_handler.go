@@ -0,0 +1,85 @@
+package command
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/auth"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/handler/request"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/view"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/input"
+)
+
+type TodoItemTagCommandHandler struct {
+	tagCommand   command.TodoTagCommandInterface
+	untagCommand command.TodoUntagCommandInterface
+}
+
+func NewTodoItemTagCommandHandler(
+	tagCommand command.TodoTagCommandInterface,
+	untagCommand command.TodoUntagCommandInterface,
+) *TodoItemTagCommandHandler {
+	return &TodoItemTagCommandHandler{
+		tagCommand:   tagCommand,
+		untagCommand: untagCommand,
+	}
+}
+
+func (h *TodoItemTagCommandHandler) Tag(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	view := view.NewHTTPCommandResultView(w)
+	presenter := presenter.NewCommandResultPresenterImpl(view)
+
+	userID, err := auth.UserIDFromContext(r.Context())
+	if err != nil {
+		_ = presenter.PresentError(r.Context(), err)
+		return
+	}
+
+	var req request.TagTodoRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	usecaseInput := &input.TagTodoInput{
+		AggregateID: vars["aggregate_id"],
+		UserID:      userID.String(),
+		TodoID:      vars["todo_id"],
+		Tag:         req.Tag,
+	}
+
+	if err := h.tagCommand.Execute(r.Context(), usecaseInput, presenter); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *TodoItemTagCommandHandler) Untag(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	view := view.NewHTTPCommandResultView(w)
+	presenter := presenter.NewCommandResultPresenterImpl(view)
+
+	userID, err := auth.UserIDFromContext(r.Context())
+	if err != nil {
+		_ = presenter.PresentError(r.Context(), err)
+		return
+	}
+
+	usecaseInput := &input.UntagTodoInput{
+		AggregateID: vars["aggregate_id"],
+		UserID:      userID.String(),
+		TodoID:      vars["todo_id"],
+		Tag:         vars["tag"],
+	}
+
+	if err := h.untagCommand.Execute(r.Context(), usecaseInput, presenter); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
@@ -0,0 +1,118 @@
+package command
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/auth"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/handler/request"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/view"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/input"
+)
+
+type TodoListCollaboratorCommandHandler struct {
+	inviteCommand     command.TodoListInviteCollaboratorCommandInterface
+	changeRoleCommand command.TodoListChangeCollaboratorRoleCommandInterface
+	removeCommand     command.TodoListRemoveCollaboratorCommandInterface
+}
+
+func NewTodoListCollaboratorCommandHandler(
+	inviteCommand command.TodoListInviteCollaboratorCommandInterface,
+	changeRoleCommand command.TodoListChangeCollaboratorRoleCommandInterface,
+	removeCommand command.TodoListRemoveCollaboratorCommandInterface,
+) *TodoListCollaboratorCommandHandler {
+	return &TodoListCollaboratorCommandHandler{
+		inviteCommand:     inviteCommand,
+		changeRoleCommand: changeRoleCommand,
+		removeCommand:     removeCommand,
+	}
+}
+
+func (h *TodoListCollaboratorCommandHandler) Invite(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	view := view.NewHTTPCommandResultView(w)
+	presenter := presenter.NewCommandResultPresenterImpl(view)
+
+	userID, err := auth.UserIDFromContext(r.Context())
+	if err != nil {
+		_ = presenter.PresentError(r.Context(), err)
+		return
+	}
+
+	var req request.InviteCollaboratorRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	usecaseInput := &input.InviteCollaboratorInput{
+		AggregateID:    vars["aggregate_id"],
+		UserID:         userID.String(),
+		CollaboratorID: req.UserID,
+		Role:           req.Role,
+	}
+
+	if err := h.inviteCommand.Execute(r.Context(), usecaseInput, presenter); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *TodoListCollaboratorCommandHandler) ChangeRole(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	view := view.NewHTTPCommandResultView(w)
+	presenter := presenter.NewCommandResultPresenterImpl(view)
+
+	userID, err := auth.UserIDFromContext(r.Context())
+	if err != nil {
+		_ = presenter.PresentError(r.Context(), err)
+		return
+	}
+
+	var req request.ChangeCollaboratorRoleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	usecaseInput := &input.ChangeCollaboratorRoleInput{
+		AggregateID:    vars["aggregate_id"],
+		UserID:         userID.String(),
+		CollaboratorID: vars["user_id"],
+		Role:           req.Role,
+	}
+
+	if err := h.changeRoleCommand.Execute(r.Context(), usecaseInput, presenter); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *TodoListCollaboratorCommandHandler) Remove(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	view := view.NewHTTPCommandResultView(w)
+	presenter := presenter.NewCommandResultPresenterImpl(view)
+
+	userID, err := auth.UserIDFromContext(r.Context())
+	if err != nil {
+		_ = presenter.PresentError(r.Context(), err)
+		return
+	}
+
+	usecaseInput := &input.RemoveCollaboratorInput{
+		AggregateID:    vars["aggregate_id"],
+		UserID:         userID.String(),
+		CollaboratorID: vars["user_id"],
+	}
+
+	if err := h.removeCommand.Execute(r.Context(), usecaseInput, presenter); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
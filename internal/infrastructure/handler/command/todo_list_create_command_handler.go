@@ -2,8 +2,11 @@ package command
 
 import (
 	"encoding/json"
+	"errors"
+	"io"
 	"net/http"
 
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/auth"
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/handler/request"
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/presenter"
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/view"
@@ -22,20 +25,29 @@ func NewTodoListCreateCommandHandler(createCommand command.TodoListCreateCommand
 }
 
 func (h *TodoListCreateCommandHandler) CreateTodoList(w http.ResponseWriter, r *http.Request) {
+	view := view.NewHTTPCommandResultView(w)
+	presenter := presenter.NewCommandResultPresenterImpl(view)
+
+	userID, err := auth.UserIDFromContext(r.Context())
+	if err != nil {
+		_ = presenter.PresentError(r.Context(), err)
+		return
+	}
+
+	// The body is optional; a list without a title is still valid.
 	var req request.CreateTodoListRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
 		http.Error(w, "Invalid JSON", http.StatusBadRequest)
 		return
 	}
 
 	usecaseInput := &input.CreateTodoListInput{
-		UserID: req.UserID,
+		UserID:      userID.String(),
+		Title:       req.Title,
+		Description: req.Description,
 	}
 
-	view := view.NewHTTPCommandResultView(w)
-	presenter := presenter.NewCommandResultPresenterImpl(view)
-
-	err := h.createCommand.Execute(r.Context(), usecaseInput, presenter)
+	err = h.createCommand.Execute(r.Context(), usecaseInput, presenter)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -0,0 +1,93 @@
+package command
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/auth"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/view"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/input"
+)
+
+type TodoListLifecycleCommandHandler struct {
+	archiveCommand command.TodoListArchiveCommandInterface
+	restoreCommand command.TodoListRestoreCommandInterface
+	deleteCommand  command.TodoListDeleteCommandInterface
+}
+
+func NewTodoListLifecycleCommandHandler(
+	archiveCommand command.TodoListArchiveCommandInterface,
+	restoreCommand command.TodoListRestoreCommandInterface,
+	deleteCommand command.TodoListDeleteCommandInterface,
+) *TodoListLifecycleCommandHandler {
+	return &TodoListLifecycleCommandHandler{
+		archiveCommand: archiveCommand,
+		restoreCommand: restoreCommand,
+		deleteCommand:  deleteCommand,
+	}
+}
+
+func (h *TodoListLifecycleCommandHandler) Archive(w http.ResponseWriter, r *http.Request) {
+	view := view.NewHTTPCommandResultView(w)
+	presenter := presenter.NewCommandResultPresenterImpl(view)
+
+	userID, err := auth.UserIDFromContext(r.Context())
+	if err != nil {
+		_ = presenter.PresentError(r.Context(), err)
+		return
+	}
+
+	usecaseInput := &input.ArchiveTodoListInput{
+		AggregateID: mux.Vars(r)["aggregate_id"],
+		UserID:      userID.String(),
+	}
+
+	if err := h.archiveCommand.Execute(r.Context(), usecaseInput, presenter); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *TodoListLifecycleCommandHandler) Restore(w http.ResponseWriter, r *http.Request) {
+	view := view.NewHTTPCommandResultView(w)
+	presenter := presenter.NewCommandResultPresenterImpl(view)
+
+	userID, err := auth.UserIDFromContext(r.Context())
+	if err != nil {
+		_ = presenter.PresentError(r.Context(), err)
+		return
+	}
+
+	usecaseInput := &input.RestoreTodoListInput{
+		AggregateID: mux.Vars(r)["aggregate_id"],
+		UserID:      userID.String(),
+	}
+
+	if err := h.restoreCommand.Execute(r.Context(), usecaseInput, presenter); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *TodoListLifecycleCommandHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	view := view.NewHTTPCommandResultView(w)
+	presenter := presenter.NewCommandResultPresenterImpl(view)
+
+	userID, err := auth.UserIDFromContext(r.Context())
+	if err != nil {
+		_ = presenter.PresentError(r.Context(), err)
+		return
+	}
+
+	usecaseInput := &input.DeleteTodoListInput{
+		AggregateID: mux.Vars(r)["aggregate_id"],
+		UserID:      userID.String(),
+	}
+
+	if err := h.deleteCommand.Execute(r.Context(), usecaseInput, presenter); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
@@ -0,0 +1,55 @@
+package command
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/auth"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/handler/request"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/view"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/input"
+)
+
+type TodoListRenameCommandHandler struct {
+	renameCommand command.TodoListRenameCommandInterface
+}
+
+func NewTodoListRenameCommandHandler(renameCommand command.TodoListRenameCommandInterface) *TodoListRenameCommandHandler {
+	return &TodoListRenameCommandHandler{
+		renameCommand: renameCommand,
+	}
+}
+
+func (h *TodoListRenameCommandHandler) RenameTodoList(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	view := view.NewHTTPCommandResultView(w)
+	presenter := presenter.NewCommandResultPresenterImpl(view)
+
+	userID, err := auth.UserIDFromContext(r.Context())
+	if err != nil {
+		_ = presenter.PresentError(r.Context(), err)
+		return
+	}
+
+	var req request.RenameTodoListRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	usecaseInput := &input.RenameTodoListInput{
+		AggregateID: vars["aggregate_id"],
+		UserID:      userID.String(),
+		Title:       req.Title,
+		Description: req.Description,
+	}
+
+	if err := h.renameCommand.Execute(r.Context(), usecaseInput, presenter); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
@@ -0,0 +1,43 @@
+package command
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/auth"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/view"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/input"
+)
+
+type TodoListUndoCommandHandler struct {
+	undoCommand command.TodoListUndoCommandInterface
+}
+
+func NewTodoListUndoCommandHandler(undoCommand command.TodoListUndoCommandInterface) *TodoListUndoCommandHandler {
+	return &TodoListUndoCommandHandler{
+		undoCommand: undoCommand,
+	}
+}
+
+func (h *TodoListUndoCommandHandler) Undo(w http.ResponseWriter, r *http.Request) {
+	view := view.NewHTTPCommandResultView(w)
+	presenter := presenter.NewCommandResultPresenterImpl(view)
+
+	userID, err := auth.UserIDFromContext(r.Context())
+	if err != nil {
+		_ = presenter.PresentError(r.Context(), err)
+		return
+	}
+
+	usecaseInput := &input.UndoInput{
+		AggregateID: mux.Vars(r)["aggregate_id"],
+		UserID:      userID.String(),
+	}
+
+	if err := h.undoCommand.Execute(r.Context(), usecaseInput, presenter); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
@@ -0,0 +1,86 @@
+package command
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/auth"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/handler/request"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/view"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/input"
+)
+
+type TodoRecurringCommandHandler struct {
+	scheduleCommand command.TodoScheduleRecurringCommandInterface
+	cancelCommand   command.TodoCancelRecurringCommandInterface
+}
+
+func NewTodoRecurringCommandHandler(
+	scheduleCommand command.TodoScheduleRecurringCommandInterface,
+	cancelCommand command.TodoCancelRecurringCommandInterface,
+) *TodoRecurringCommandHandler {
+	return &TodoRecurringCommandHandler{
+		scheduleCommand: scheduleCommand,
+		cancelCommand:   cancelCommand,
+	}
+}
+
+func (h *TodoRecurringCommandHandler) Schedule(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	view := view.NewHTTPCommandResultView(w)
+	presenter := presenter.NewCommandResultPresenterImpl(view)
+
+	userID, err := auth.UserIDFromContext(r.Context())
+	if err != nil {
+		_ = presenter.PresentError(r.Context(), err)
+		return
+	}
+
+	var req request.ScheduleRecurringTodoRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	usecaseInput := &input.ScheduleRecurringTodoInput{
+		AggregateID: vars["aggregate_id"],
+		UserID:      userID.String(),
+		Todo:        req.Text,
+		Rule:        req.Rule,
+		StartDate:   req.StartDate,
+		Priority:    req.Priority,
+	}
+
+	if err := h.scheduleCommand.Execute(r.Context(), usecaseInput, presenter); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *TodoRecurringCommandHandler) Cancel(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	view := view.NewHTTPCommandResultView(w)
+	presenter := presenter.NewCommandResultPresenterImpl(view)
+
+	userID, err := auth.UserIDFromContext(r.Context())
+	if err != nil {
+		_ = presenter.PresentError(r.Context(), err)
+		return
+	}
+
+	usecaseInput := &input.CancelRecurringTodoInput{
+		AggregateID:  vars["aggregate_id"],
+		UserID:       userID.String(),
+		RecurrenceID: vars["recurrence_id"],
+	}
+
+	if err := h.cancelCommand.Execute(r.Context(), usecaseInput, presenter); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
@@ -0,0 +1,54 @@
+package command
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/auth"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/handler/request"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/view"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/input"
+)
+
+type WebhookSubscribeCommandHandler struct {
+	subscribeCommand command.WebhookSubscribeCommandInterface
+}
+
+func NewWebhookSubscribeCommandHandler(subscribeCommand command.WebhookSubscribeCommandInterface) *WebhookSubscribeCommandHandler {
+	return &WebhookSubscribeCommandHandler{
+		subscribeCommand: subscribeCommand,
+	}
+}
+
+func (h *WebhookSubscribeCommandHandler) Subscribe(w http.ResponseWriter, r *http.Request) {
+	view := view.NewHTTPWebhookSubscriptionView(w)
+	presenter := presenter.NewHTTPWebhookSubscriptionPresenter(view)
+
+	userID, err := auth.UserIDFromContext(r.Context())
+	if err != nil {
+		_ = presenter.PresentError(r.Context(), err)
+		return
+	}
+
+	var req request.SubscribeWebhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	usecaseInput := &input.SubscribeWebhookInput{
+		UserID:      userID.String(),
+		AggregateID: req.AggregateID,
+		TargetURL:   req.TargetURL,
+		EventTypes:  req.EventTypes,
+		Secret:      req.Secret,
+	}
+
+	err = h.subscribeCommand.Execute(r.Context(), usecaseInput, presenter)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
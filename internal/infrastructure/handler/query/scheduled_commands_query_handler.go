@@ -0,0 +1,38 @@
+package query
+
+import (
+	"net/http"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/auth"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/view"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/query"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/query/input"
+)
+
+type ScheduledCommandsQueryHandler struct {
+	scheduledCommandsQuery query.ScheduledCommandsQueryInterface
+}
+
+func NewScheduledCommandsQueryHandler(scheduledCommandsQuery query.ScheduledCommandsQueryInterface) *ScheduledCommandsQueryHandler {
+	return &ScheduledCommandsQueryHandler{
+		scheduledCommandsQuery: scheduledCommandsQuery,
+	}
+}
+
+// Query lists the authenticated user's scheduled commands.
+func (h *ScheduledCommandsQueryHandler) Query(w http.ResponseWriter, r *http.Request) {
+	v := view.NewHTTPScheduledCommandsView(w)
+	p := presenter.NewHTTPScheduledCommandsPresenter(v)
+
+	userID, err := auth.UserIDFromContext(r.Context())
+	if err != nil {
+		_ = p.PresentError(r.Context(), err)
+		return
+	}
+
+	in := &input.ListScheduledCommandsInput{UserID: userID.String()}
+	if err := h.scheduledCommandsQuery.Execute(r.Context(), in, p); err != nil {
+		return
+	}
+}
@@ -2,14 +2,18 @@ package query
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/gorilla/mux"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/auth"
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/presenter"
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/view"
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/query"
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/query/input"
 )
 
+const MinVersionHeader = "X-Min-Version"
+
 type TodoListQueryHandler struct {
 	todoListQueryUsecase query.TodoListQueryInterface
 }
@@ -29,13 +33,34 @@ func (h *TodoListQueryHandler) Query(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	v := view.NewHTTPTodoListView(w)
+	p := presenter.NewHTTPTodoListPresenter(v)
+
+	userID, err := auth.UserIDFromContext(r.Context())
+	if err != nil {
+		_ = p.PresentError(r.Context(), err)
+		return
+	}
+
+	var minVersion int
+	if header := r.Header.Get(MinVersionHeader); header != "" {
+		v, err := strconv.Atoi(header)
+		if err != nil || v < 0 {
+			http.Error(w, MinVersionHeader+" must be a non-negative integer", http.StatusBadRequest)
+			return
+		}
+		minVersion = v
+	}
+
 	in := &input.GetTodoListInput{
 		AggregateID: aggregateID,
+		UserID:      userID.String(),
+		MinVersion:  minVersion,
+		Filter:      r.URL.Query().Get("filter"),
+		Sort:        r.URL.Query().Get("sort"),
+		Tags:        r.URL.Query()["tag"],
 	}
 
-	v := view.NewHTTPTodoListView(w)
-	p := presenter.NewHTTPTodoListPresenter(v)
-
 	if err := h.todoListQueryUsecase.Execute(r.Context(), in, p); err != nil {
 		return
 	}
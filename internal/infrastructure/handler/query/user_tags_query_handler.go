@@ -0,0 +1,42 @@
+package query
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/auth"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/view"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/query"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/query/input"
+)
+
+type UserTagsQueryHandler struct {
+	userTagsQueryUsecase query.UserTagsQueryInterface
+}
+
+func NewUserTagsQueryHandler(userTagsQueryUsecase query.UserTagsQueryInterface) *UserTagsQueryHandler {
+	return &UserTagsQueryHandler{
+		userTagsQueryUsecase: userTagsQueryUsecase,
+	}
+}
+
+func (h *UserTagsQueryHandler) Query(w http.ResponseWriter, r *http.Request) {
+	v := view.NewHTTPUserTagsView(w)
+	p := presenter.NewHTTPUserTagsPresenter(v)
+
+	requesterID, err := auth.UserIDFromContext(r.Context())
+	if err != nil {
+		_ = p.PresentError(r.Context(), err)
+		return
+	}
+
+	in := &input.ListUserTagsInput{
+		UserID:      mux.Vars(r)["user_id"],
+		RequesterID: requesterID.String(),
+	}
+
+	if err := h.userTagsQueryUsecase.Execute(r.Context(), in, p); err != nil {
+		return
+	}
+}
@@ -0,0 +1,103 @@
+package query
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/auth"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/view"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/query"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/query/input"
+)
+
+type UserTodoListsQueryHandler struct {
+	userTodoListsQueryUsecase query.UserTodoListsQueryInterface
+}
+
+func NewUserTodoListsQueryHandler(userTodoListsQueryUsecase query.UserTodoListsQueryInterface) *UserTodoListsQueryHandler {
+	return &UserTodoListsQueryHandler{
+		userTodoListsQueryUsecase: userTodoListsQueryUsecase,
+	}
+}
+
+func (h *UserTodoListsQueryHandler) Query(w http.ResponseWriter, r *http.Request) {
+	requesterID, err := auth.UserIDFromContext(r.Context())
+	if err != nil {
+		v := view.NewHTTPUserTodoListsView(w)
+		_ = presenter.NewHTTPUserTodoListsPresenter(v).PresentError(r.Context(), err)
+		return
+	}
+	h.list(w, r, mux.Vars(r)["user_id"], requesterID.String(), false)
+}
+
+// QueryShared lists the todo lists the authenticated user collaborates on.
+func (h *UserTodoListsQueryHandler) QueryShared(w http.ResponseWriter, r *http.Request) {
+	userID, err := auth.UserIDFromContext(r.Context())
+	if err != nil {
+		v := view.NewHTTPUserTodoListsView(w)
+		_ = presenter.NewHTTPUserTodoListsPresenter(v).PresentError(r.Context(), err)
+		return
+	}
+	h.list(w, r, userID.String(), userID.String(), true)
+}
+
+func (h *UserTodoListsQueryHandler) list(w http.ResponseWriter, r *http.Request, userID, requesterID string, shared bool) {
+	params := r.URL.Query()
+
+	limit, ok := intParam(w, params.Get("limit"), "limit")
+	if !ok {
+		return
+	}
+	offset, ok := intParam(w, params.Get("offset"), "offset")
+	if !ok {
+		return
+	}
+	includeArchived, ok := boolParam(w, params.Get("include_archived"), "include_archived")
+	if !ok {
+		return
+	}
+
+	in := &input.ListUserTodoListsInput{
+		UserID:          userID,
+		RequesterID:     requesterID,
+		SortBy:          params.Get("sort"),
+		Order:           params.Get("order"),
+		Limit:           limit,
+		Offset:          offset,
+		Shared:          shared,
+		IncludeArchived: includeArchived,
+	}
+
+	v := view.NewHTTPUserTodoListsView(w)
+	p := presenter.NewHTTPUserTodoListsPresenter(v)
+
+	if err := h.userTodoListsQueryUsecase.Execute(r.Context(), in, p); err != nil {
+		return
+	}
+}
+
+func intParam(w http.ResponseWriter, raw, name string) (int, bool) {
+	if raw == "" {
+		return 0, true
+	}
+	v, err := strconv.Atoi(raw)
+	if err != nil {
+		http.Error(w, name+" must be an integer", http.StatusBadRequest)
+		return 0, false
+	}
+	return v, true
+}
+
+func boolParam(w http.ResponseWriter, raw, name string) (bool, bool) {
+	if raw == "" {
+		return false, true
+	}
+	v, err := strconv.ParseBool(raw)
+	if err != nil {
+		http.Error(w, name+" must be a boolean", http.StatusBadRequest)
+		return false, false
+	}
+	return v, true
+}
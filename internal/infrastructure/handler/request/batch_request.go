@@ -0,0 +1,23 @@
+package request
+
+// BatchCommandsRequest sends several commands at once. With atomic set they
+// are stored all together or not at all; otherwise each succeeds or fails on
+// its own.
+type BatchCommandsRequest struct {
+	Atomic   bool                  `json:"atomic"`
+	Commands []BatchCommandRequest `json:"commands"`
+}
+
+// BatchCommandRequest is a create_todo_list or add_todo command. add_todo
+// names its list by aggregate_id, or by list_index, the index of a
+// create_todo_list earlier in the batch.
+type BatchCommandRequest struct {
+	Type        string `json:"type"`
+	AggregateID string `json:"aggregate_id"`
+	ListIndex   *int   `json:"list_index"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Text        string `json:"text"`
+	DueDate     string `json:"due_date"`
+	Priority    string `json:"priority"`
+}
@@ -0,0 +1,10 @@
+package request
+
+type InviteCollaboratorRequest struct {
+	UserID string `json:"user_id"`
+	Role   string `json:"role"`
+}
+
+type ChangeCollaboratorRoleRequest struct {
+	Role string `json:"role"`
+}
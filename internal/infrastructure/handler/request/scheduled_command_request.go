@@ -0,0 +1,12 @@
+package request
+
+type ScheduleCommandRequest struct {
+	Type        string `json:"type"`
+	AggregateID string `json:"aggregate_id"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Text        string `json:"text"`
+	DueDate     string `json:"due_date"`
+	Priority    string `json:"priority"`
+	RunAt       string `json:"run_at"`
+}
@@ -0,0 +1,13 @@
+package request
+
+type CreateTodoListRequest struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
+// RenameTodoListRequest is a partial update: omitted fields are left as they
+// are.
+type RenameTodoListRequest struct {
+	Title       *string `json:"title"`
+	Description *string `json:"description"`
+}
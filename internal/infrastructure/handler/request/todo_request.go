@@ -1,10 +1,44 @@
 package request
 
-type CreateTodoListRequest struct {
-	UserID string `json:"user_id"`
+type AddTodoRequest struct {
+	Text     string `json:"text"`
+	DueDate  string `json:"due_date"`
+	Priority string `json:"priority"`
 }
 
-type AddTodoRequest struct {
-	Text   string `json:"text"`
-	UserID string `json:"user_id"`
+// SetDueDateRequest sets a todo's due date; an empty or null due_date clears
+// it.
+type SetDueDateRequest struct {
+	DueDate string `json:"due_date"`
+}
+
+type SetPriorityRequest struct {
+	Priority string `json:"priority"`
+}
+
+// ReorderTodosRequest lists every todo ID of the list in the new order.
+type ReorderTodosRequest struct {
+	TodoIDs []string `json:"todo_ids"`
+}
+
+type TagTodoRequest struct {
+	Tag string `json:"tag"`
+}
+
+type AddChecklistItemRequest struct {
+	Text string `json:"text"`
+}
+
+// ScheduleRecurringTodoRequest schedules a recurring todo. rule is daily,
+// weekly, monthly or an RRULE such as "FREQ=WEEKLY;BYDAY=MO,FR"; start_date
+// defaults to today.
+type ScheduleRecurringTodoRequest struct {
+	Text      string `json:"text"`
+	Rule      string `json:"rule"`
+	StartDate string `json:"start_date"`
+	Priority  string `json:"priority"`
+}
+
+type MoveTodoRequest struct {
+	TargetListID string `json:"target_list_id"`
 }
@@ -0,0 +1,8 @@
+package request
+
+type SubscribeWebhookRequest struct {
+	AggregateID string   `json:"aggregate_id"`
+	TargetURL   string   `json:"target_url"`
+	EventTypes  []string `json:"event_types"`
+	Secret      string   `json:"secret"`
+}
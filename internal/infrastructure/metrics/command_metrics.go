@@ -0,0 +1,56 @@
+package metrics
+
+import (
+	"errors"
+	"expvar"
+	"fmt"
+	"time"
+
+	appErrors "github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/gateway"
+)
+
+// CommandMetricsImpl counts commands and conflicting attempts by name and
+// failures by name and error code. It is an expvar.Var, so publishing it serves the counters at
+// /debug/vars.
+type CommandMetricsImpl struct {
+	calls     *expvar.Map
+	failures  *expvar.Map
+	seconds   *expvar.Map
+	conflicts *expvar.Map
+}
+
+var _ gateway.CommandMetrics = (*CommandMetricsImpl)(nil)
+
+func NewCommandMetrics() *CommandMetricsImpl {
+	return &CommandMetricsImpl{
+		calls:     new(expvar.Map).Init(),
+		failures:  new(expvar.Map).Init(),
+		seconds:   new(expvar.Map).Init(),
+		conflicts: new(expvar.Map).Init(),
+	}
+}
+
+func (m *CommandMetricsImpl) ObserveCommand(command string, duration time.Duration, err error) {
+	m.calls.Add(command, 1)
+	m.seconds.AddFloat(command, duration.Seconds())
+	if err != nil {
+		m.failures.Add(command+"."+string(codeOf(err)), 1)
+	}
+}
+
+func (m *CommandMetricsImpl) ObserveConflict(command string) {
+	m.conflicts.Add(command, 1)
+}
+
+func (m *CommandMetricsImpl) String() string {
+	return fmt.Sprintf(`{"calls": %s, "failures": %s, "seconds": %s, "conflicts": %s}`, m.calls, m.failures, m.seconds, m.conflicts)
+}
+
+func codeOf(err error) appErrors.ErrCode {
+	var appErr *appErrors.Error
+	if errors.As(err, &appErr) {
+		return appErr.ErrCode
+	}
+	return appErrors.Unknown
+}
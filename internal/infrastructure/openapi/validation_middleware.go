@@ -0,0 +1,180 @@
+package openapi
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/gorilla/mux"
+)
+
+// ValidationError names a part of a request that does not match the
+// document.
+type ValidationError struct {
+	In      string `json:"in"`
+	Name    string `json:"name,omitempty"`
+	Message string `json:"message"`
+}
+
+type ValidationMiddleware struct {
+	doc     *openapi3.T
+	options *openapi3filter.Options
+}
+
+func NewValidationMiddleware(doc *openapi3.T) *ValidationMiddleware {
+	return &ValidationMiddleware{
+		doc: doc,
+		options: &openapi3filter.Options{
+			MultiError: true,
+			// Tokens are checked by the JWT middleware, which runs first.
+			AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+			// Requests reach the handlers as sent; defaults are theirs to apply.
+			SkipSettingDefaults: true,
+		},
+	}
+}
+
+// Handler validates the parameters and body of requests against the
+// operation of the matched route. Malformed requests get 400; well-formed
+// bodies that do not match their schema get 422. Both answer the error body
+// of the handlers, with the offending fields listed in errors.
+//
+// Routes without an operation in the document are passed through; the router
+// tests keep every route described.
+func (m *ValidationMiddleware) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route, ok := m.findRoute(r)
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		err := openapi3filter.ValidateRequest(r.Context(), &openapi3filter.RequestValidationInput{
+			Request:    r,
+			PathParams: mux.Vars(r),
+			Route:      route,
+			Options:    m.options,
+		})
+		if err != nil {
+			writeValidationError(w, err)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (m *ValidationMiddleware) findRoute(r *http.Request) (*routers.Route, bool) {
+	current := mux.CurrentRoute(r)
+	if current == nil {
+		return nil, false
+	}
+	template, err := current.GetPathTemplate()
+	if err != nil {
+		return nil, false
+	}
+	pathItem := m.doc.Paths.Value(template)
+	if pathItem == nil {
+		return nil, false
+	}
+	operation := pathItem.GetOperation(r.Method)
+	if operation == nil {
+		return nil, false
+	}
+	return &routers.Route{
+		Spec:      m.doc,
+		Path:      template,
+		PathItem:  pathItem,
+		Method:    r.Method,
+		Operation: operation,
+	}, true
+}
+
+func writeValidationError(w http.ResponseWriter, err error) {
+	status := http.StatusUnprocessableEntity
+	var details []ValidationError
+	for _, err := range flatten(err) {
+		var requestErr *openapi3filter.RequestError
+		if !errors.As(err, &requestErr) {
+			status = http.StatusBadRequest
+			details = append(details, ValidationError{In: "body", Message: err.Error()})
+			continue
+		}
+
+		if requestErr.Parameter != nil {
+			status = http.StatusBadRequest
+			details = append(details, ValidationError{
+				In:      requestErr.Parameter.In,
+				Name:    requestErr.Parameter.Name,
+				Message: parameterMessage(requestErr),
+			})
+			continue
+		}
+
+		schemaErrs := schemaErrors(requestErr.Err)
+		if len(schemaErrs) == 0 {
+			// The body is missing, not JSON or of another content type.
+			status = http.StatusBadRequest
+			details = append(details, ValidationError{In: "body", Message: requestErr.Error()})
+			continue
+		}
+		for _, schemaErr := range schemaErrs {
+			details = append(details, ValidationError{
+				In:      "body",
+				Name:    strings.Join(schemaErr.JSONPointer(), "."),
+				Message: schemaErr.Reason,
+			})
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	errorResponse := map[string]any{
+		"status":  "error",
+		"message": "request does not match the API specification",
+		"errors":  details,
+	}
+	_ = json.NewEncoder(w).Encode(errorResponse)
+}
+
+// parameterMessage says what is wrong with a parameter without repeating its
+// name, which the error names already.
+func parameterMessage(err *openapi3filter.RequestError) string {
+	if schemaErrs := schemaErrors(err.Err); len(schemaErrs) > 0 {
+		return schemaErrs[0].Reason
+	}
+	if err.Err != nil {
+		return err.Err.Error()
+	}
+	return err.Reason
+}
+
+// flatten lists the errors of err if it is a MultiError itself; errors
+// wrapping one are kept whole.
+func flatten(err error) []error {
+	multi, ok := err.(openapi3.MultiError)
+	if !ok {
+		return []error{err}
+	}
+	var errs []error
+	for _, err := range multi {
+		errs = append(errs, flatten(err)...)
+	}
+	return errs
+}
+
+func schemaErrors(err error) []*openapi3.SchemaError {
+	var schemaErrs []*openapi3.SchemaError
+	for _, err := range flatten(err) {
+		var schemaErr *openapi3.SchemaError
+		if errors.As(err, &schemaErr) {
+			schemaErrs = append(schemaErrs, schemaErr)
+		}
+	}
+	return schemaErrs
+}
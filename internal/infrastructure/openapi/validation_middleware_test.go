@@ -0,0 +1,212 @@
+package openapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/openapi"
+)
+
+const aggregateID = "550e8400-e29b-41d4-a716-446655440000"
+
+type errorResponse struct {
+	Status  string                    `json:"status"`
+	Message string                    `json:"message"`
+	Errors  []openapi.ValidationError `json:"errors"`
+}
+
+// newTestRouter routes a few operations of the document, and one it does not
+// describe, to a handler echoing the body it receives.
+func newTestRouter(t *testing.T) *mux.Router {
+	t.Helper()
+
+	doc, err := openapi.Load(context.Background())
+	require.NoError(t, err)
+
+	echo := func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(body)
+	}
+
+	router := mux.NewRouter()
+	router.Use(openapi.NewValidationMiddleware(doc).Handler)
+	router.HandleFunc("/todo-lists", echo).Methods("POST")
+	router.HandleFunc("/todo-lists/{aggregate_id}/items", echo).Methods("POST")
+	router.HandleFunc("/todo-lists/{aggregate_id}/items", echo).Methods("GET")
+	router.HandleFunc("/todo-lists/{aggregate_id}/items/order", echo).Methods("PUT")
+	router.HandleFunc("/users/{user_id}/todo-lists", echo).Methods("GET")
+	router.HandleFunc("/undocumented", echo).Methods("POST")
+	return router
+}
+
+func TestValidationMiddleware_Handler(t *testing.T) {
+	tests := map[string]struct {
+		method      string
+		target      string
+		body        string
+		contentType string
+		header      map[string]string
+		wantStatus  int
+		wantErrors  []openapi.ValidationError
+	}{
+		"passes a valid request with its body": {
+			method:     http.MethodPost,
+			target:     "/todo-lists/" + aggregateID + "/items",
+			body:       `{"text": "Buy milk", "due_date": "2025-03-31", "priority": "High"}`,
+			wantStatus: http.StatusOK,
+		},
+		"passes an optional body that is left out": {
+			method:     http.MethodPost,
+			target:     "/todo-lists",
+			wantStatus: http.StatusOK,
+		},
+		"passes an empty due date, which means none": {
+			method:     http.MethodPost,
+			target:     "/todo-lists/" + aggregateID + "/items",
+			body:       `{"text": "Buy milk", "due_date": ""}`,
+			wantStatus: http.StatusOK,
+		},
+		"passes a route the document does not describe": {
+			method:     http.MethodPost,
+			target:     "/undocumented",
+			body:       `not json`,
+			wantStatus: http.StatusOK,
+		},
+		"rejects a path parameter that is not a UUID": {
+			method:     http.MethodPost,
+			target:     "/todo-lists/not-a-uuid/items",
+			body:       `{"text": "Buy milk"}`,
+			wantStatus: http.StatusBadRequest,
+			wantErrors: []openapi.ValidationError{{In: "path", Name: "aggregate_id"}},
+		},
+		"rejects a query parameter of the wrong type": {
+			method:     http.MethodGet,
+			target:     "/users/user-1/todo-lists?limit=ten",
+			wantStatus: http.StatusBadRequest,
+			wantErrors: []openapi.ValidationError{{In: "query", Name: "limit"}},
+		},
+		"rejects a query parameter outside its enum": {
+			method:     http.MethodGet,
+			target:     "/todo-lists/" + aggregateID + "/items?filter=tomorrow",
+			wantStatus: http.StatusBadRequest,
+			wantErrors: []openapi.ValidationError{{In: "query", Name: "filter"}},
+		},
+		"rejects a negative X-Min-Version": {
+			method:     http.MethodGet,
+			target:     "/todo-lists/" + aggregateID + "/items",
+			header:     map[string]string{"X-Min-Version": "-1"},
+			wantStatus: http.StatusBadRequest,
+			wantErrors: []openapi.ValidationError{{In: "header", Name: "X-Min-Version"}},
+		},
+		"rejects a body that is not JSON": {
+			method:     http.MethodPost,
+			target:     "/todo-lists/" + aggregateID + "/items",
+			body:       `{"text": `,
+			wantStatus: http.StatusBadRequest,
+			wantErrors: []openapi.ValidationError{{In: "body"}},
+		},
+		"rejects a body of another content type": {
+			method:      http.MethodPost,
+			target:      "/todo-lists/" + aggregateID + "/items",
+			body:        `text=Buy+milk`,
+			contentType: "application/x-www-form-urlencoded",
+			wantStatus:  http.StatusBadRequest,
+			wantErrors:  []openapi.ValidationError{{In: "body"}},
+		},
+		"rejects a missing required body": {
+			method:     http.MethodPost,
+			target:     "/todo-lists/" + aggregateID + "/items",
+			wantStatus: http.StatusBadRequest,
+			wantErrors: []openapi.ValidationError{{In: "body"}},
+		},
+		"rejects a body without a required field": {
+			method:     http.MethodPost,
+			target:     "/todo-lists/" + aggregateID + "/items",
+			body:       `{"priority": "high"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantErrors: []openapi.ValidationError{{In: "body", Name: "text"}},
+		},
+		"rejects every field of the wrong type": {
+			method:     http.MethodPost,
+			target:     "/todo-lists/" + aggregateID + "/items",
+			body:       `{"text": 42, "due_date": "31/03/2025"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantErrors: []openapi.ValidationError{{In: "body", Name: "text"}, {In: "body", Name: "due_date"}},
+		},
+		"names nested fields by their path": {
+			method:     http.MethodPut,
+			target:     "/todo-lists/" + aggregateID + "/items/order",
+			body:       `{"todo_ids": ["` + aggregateID + `", "not-a-uuid"]}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantErrors: []openapi.ValidationError{{In: "body", Name: "todo_ids.1"}},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			router := newTestRouter(t)
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			if tt.body != "" {
+				contentType := tt.contentType
+				if contentType == "" {
+					contentType = "application/json"
+				}
+				req.Header.Set("Content-Type", contentType)
+			}
+			for key, value := range tt.header {
+				req.Header.Set(key, value)
+			}
+			rec := httptest.NewRecorder()
+
+			// Act
+			router.ServeHTTP(rec, req)
+
+			// Assert
+			require.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+			if tt.wantStatus == http.StatusOK {
+				require.Equal(t, tt.body, rec.Body.String())
+				return
+			}
+			require.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+			var body errorResponse
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+			require.Equal(t, "error", body.Status)
+			require.NotEmpty(t, body.Message)
+			// Fields are checked in no particular order, so only the set is compared.
+			var got []openapi.ValidationError
+			for _, detail := range body.Errors {
+				require.NotEmpty(t, detail.Message)
+				got = append(got, openapi.ValidationError{In: detail.In, Name: detail.Name})
+			}
+			require.ElementsMatch(t, tt.wantErrors, got)
+		})
+	}
+}
+
+func TestServeSpec(t *testing.T) {
+	// Arrange
+	rec := httptest.NewRecorder()
+
+	// Act
+	openapi.ServeSpec(rec, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+
+	// Assert
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	var doc struct {
+		OpenAPI string         `json:"openapi"`
+		Paths   map[string]any `json:"paths"`
+	}
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&doc))
+	require.Equal(t, "3.0.3", doc.OpenAPI)
+	require.Contains(t, doc.Paths, "/todo-lists/{aggregate_id}/items")
+}
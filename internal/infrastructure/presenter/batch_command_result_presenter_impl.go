@@ -0,0 +1,52 @@
+package presenter
+
+import (
+	"context"
+	"time"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/presenter/viewmodel"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/output"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/presenter"
+)
+
+type BatchCommandResultPresenterImpl struct {
+	view BatchCommandView
+}
+
+func NewBatchCommandResultPresenterImpl(view BatchCommandView) presenter.BatchCommandResultPresenter {
+	return &BatchCommandResultPresenterImpl{
+		view: view,
+	}
+}
+
+// PresentResults renders one entry per command. The response succeeds even
+// when some commands failed; each entry says how its command went.
+func (p *BatchCommandResultPresenterImpl) PresentResults(ctx context.Context, results []output.BatchCommandOutput) error {
+	executedAt := time.Now().Format(time.RFC3339)
+
+	vms := make([]viewmodel.CommandResultViewModel, 0, len(results))
+	for _, result := range results {
+		if result.Err != nil {
+			vms = append(vms, viewmodel.CommandResultViewModel{
+				Events:     []viewmodel.EventViewModel{},
+				Status:     "error",
+				Message:    result.Err.Error(),
+				ExecutedAt: executedAt,
+			})
+			continue
+		}
+		vms = append(vms, viewmodel.CommandResultViewModel{
+			AggregateID: result.AggregateID,
+			Version:     result.Version,
+			Events:      eventViewModels(result.Events),
+			Status:      "success",
+			ExecutedAt:  executedAt,
+		})
+	}
+
+	return p.view.Render(ctx, vms, 200, nil)
+}
+
+func (p *BatchCommandResultPresenterImpl) PresentError(ctx context.Context, err error) error {
+	return p.view.Render(ctx, nil, commandStatusCode(err), err)
+}
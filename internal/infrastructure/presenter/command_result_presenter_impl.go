@@ -21,6 +21,18 @@ func NewCommandResultPresenterImpl(view CommandView) presenter.CommandResultPres
 }
 
 func (p *CommandResultPresenterImpl) PresentSuccess(ctx context.Context, aggregateID string, version int, events []event.Event) error {
+	vm := &viewmodel.CommandResultViewModel{
+		AggregateID: aggregateID,
+		Version:     version,
+		Events:      eventViewModels(events),
+		Status:      "success",
+		ExecutedAt:  time.Now().Format(time.RFC3339),
+	}
+
+	return p.view.Render(ctx, vm, 200, nil)
+}
+
+func eventViewModels(events []event.Event) []viewmodel.EventViewModel {
 	eventVMs := make([]viewmodel.EventViewModel, 0, len(events))
 	for _, ev := range events {
 		eventVMs = append(eventVMs, viewmodel.EventViewModel{
@@ -30,16 +42,7 @@ func (p *CommandResultPresenterImpl) PresentSuccess(ctx context.Context, aggrega
 			OccurredAt: ev.GetTimestamp().Format(time.RFC3339),
 		})
 	}
-
-	vm := &viewmodel.CommandResultViewModel{
-		AggregateID: aggregateID,
-		Version:     version,
-		Events:      eventVMs,
-		Status:      "success",
-		ExecutedAt:  time.Now().Format(time.RFC3339),
-	}
-
-	return p.view.Render(ctx, vm, 200, nil)
+	return eventVMs
 }
 
 func (p *CommandResultPresenterImpl) PresentError(ctx context.Context, err error) error {
@@ -48,19 +51,28 @@ func (p *CommandResultPresenterImpl) PresentError(ctx context.Context, err error
 		ExecutedAt: time.Now().Format(time.RFC3339),
 	}
 
-	statusCode := p.determineStatusCode(err)
+	statusCode := commandStatusCode(err)
 	return p.view.Render(ctx, vm, statusCode, err)
 }
 
-func (p *CommandResultPresenterImpl) determineStatusCode(err error) int {
+func commandStatusCode(err error) int {
 	if errors.IsCode(err, errors.InvalidParameter) {
 		return 422
 	}
+	if errors.IsCode(err, errors.Unauthenticated) {
+		return 401
+	}
+	if errors.IsCode(err, errors.Forbidden) {
+		return 403
+	}
 	if errors.IsCode(err, errors.NotFound) {
 		return 404
 	}
-	if errors.IsCode(err, errors.OptimisticLock) {
+	if errors.IsCode(err, errors.OptimisticLock) || errors.IsCode(err, errors.Archived) {
 		return 409
 	}
+	if errors.IsCode(err, errors.Deleted) {
+		return 410
+	}
 	return 500
 }
@@ -0,0 +1,67 @@
+package presenter
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/grpcserver/todov1"
+)
+
+// GRPCCommandResultPresenter keeps the outcome of a command as the response
+// or status error of a gRPC call.
+type GRPCCommandResultPresenter struct {
+	result *todov1.CommandResult
+	err    error
+}
+
+func NewGRPCCommandResultPresenter() *GRPCCommandResultPresenter {
+	return &GRPCCommandResultPresenter{}
+}
+
+func (p *GRPCCommandResultPresenter) PresentSuccess(ctx context.Context, aggregateID string, version int, events []event.Event) error {
+	pbEvents := make([]*todov1.Event, 0, len(events))
+	for _, ev := range events {
+		pbEvent, err := GRPCEvent(ev)
+		if err != nil {
+			return err
+		}
+		pbEvents = append(pbEvents, pbEvent)
+	}
+
+	p.result = &todov1.CommandResult{
+		AggregateId: aggregateID,
+		Version:     int32(version),
+		Events:      pbEvents,
+	}
+	return nil
+}
+
+func (p *GRPCCommandResultPresenter) PresentError(ctx context.Context, err error) error {
+	p.err = grpcStatusError(err)
+	return nil
+}
+
+// Result returns the response, or the status error the command failed with.
+func (p *GRPCCommandResultPresenter) Result() (*todov1.CommandResult, error) {
+	return p.result, p.err
+}
+
+// GRPCEvent converts ev to its gRPC message, carrying the event as the same
+// JSON the HTTP API returns.
+func GRPCEvent(ev event.Event) (*todov1.Event, error) {
+	data, err := json.Marshal(ev)
+	if err != nil {
+		return nil, err
+	}
+
+	return &todov1.Event{
+		EventId:     ev.GetEventID().String(),
+		AggregateId: ev.GetAggregateID().String(),
+		Type:        ev.GetEventType(),
+		Version:     int32(ev.GetVersion()),
+		Data:        data,
+		OccurredAt:  ev.GetTimestamp().Format(time.RFC3339),
+	}, nil
+}
@@ -0,0 +1,31 @@
+package presenter
+
+import (
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// grpcStatusError turns err into a gRPC status error whose code matches the
+// HTTP status the HTTP presenters answer err with.
+func grpcStatusError(err error) error {
+	return status.Error(grpcCode(err), err.Error())
+}
+
+func grpcCode(err error) codes.Code {
+	switch {
+	case errors.IsCode(err, errors.InvalidParameter):
+		return codes.InvalidArgument
+	case errors.IsCode(err, errors.Unauthenticated):
+		return codes.Unauthenticated
+	case errors.IsCode(err, errors.Forbidden), errors.IsCode(err, errors.UnpermittedOp):
+		return codes.PermissionDenied
+	case errors.IsCode(err, errors.NotFound), errors.IsCode(err, errors.Deleted):
+		return codes.NotFound
+	case errors.IsCode(err, errors.OptimisticLock):
+		return codes.Aborted
+	case errors.IsCode(err, errors.Archived):
+		return codes.FailedPrecondition
+	}
+	return codes.Internal
+}
@@ -0,0 +1,101 @@
+package presenter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/grpcserver/todov1"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/query/output"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GRPCTodoListPresenter keeps a todo list query's outcome as the response or
+// status error of a gRPC call.
+type GRPCTodoListPresenter struct {
+	list *todov1.TodoList
+	err  error
+}
+
+func NewGRPCTodoListPresenter() *GRPCTodoListPresenter {
+	return &GRPCTodoListPresenter{}
+}
+
+func (p *GRPCTodoListPresenter) Present(ctx context.Context, out *output.GetTodoListOutput) error {
+	p.list = toGRPCTodoList(out)
+	return nil
+}
+
+// PresentStale fails the call as UNAVAILABLE, the counterpart of the HTTP
+// 503, so that the caller retries once the projection caught up.
+func (p *GRPCTodoListPresenter) PresentStale(ctx context.Context, out *output.GetTodoListOutput) error {
+	p.err = status.Error(codes.Unavailable, fmt.Sprintf("todo list %s is at version %d", out.AggregateID, out.Version))
+	return nil
+}
+
+func (p *GRPCTodoListPresenter) PresentNotFound(ctx context.Context, err error) error {
+	p.err = status.Error(codes.NotFound, err.Error())
+	return nil
+}
+
+func (p *GRPCTodoListPresenter) PresentError(ctx context.Context, err error) error {
+	p.err = grpcStatusError(err)
+	return nil
+}
+
+// Result returns the response, or the status error the query failed with.
+func (p *GRPCTodoListPresenter) Result() (*todov1.TodoList, error) {
+	return p.list, p.err
+}
+
+func toGRPCTodoList(out *output.GetTodoListOutput) *todov1.TodoList {
+	collaborators := make([]*todov1.Collaborator, 0, len(out.Collaborators))
+	for _, c := range out.Collaborators {
+		collaborators = append(collaborators, &todov1.Collaborator{UserId: c.UserID, Role: c.Role})
+	}
+
+	items := make([]*todov1.TodoItem, 0, len(out.Items))
+	for _, item := range out.Items {
+		checklist := make([]*todov1.ChecklistItem, 0, len(item.Checklist))
+		for _, c := range item.Checklist {
+			checklist = append(checklist, &todov1.ChecklistItem{Id: c.ID, Text: c.Text, Completed: c.Completed})
+		}
+		items = append(items, &todov1.TodoItem{
+			Id:           item.ID,
+			Text:         item.Text,
+			DueDate:      item.DueDate,
+			Priority:     item.Priority,
+			Tags:         item.Tags,
+			Overdue:      item.Overdue,
+			Completed:    item.Completed,
+			Checklist:    checklist,
+			RecurrenceId: item.RecurrenceID,
+		})
+	}
+
+	recurring := make([]*todov1.RecurringTodo, 0, len(out.RecurringTodos))
+	for _, r := range out.RecurringTodos {
+		recurring = append(recurring, &todov1.RecurringTodo{
+			Id:             r.ID,
+			Text:           r.Text,
+			Rule:           r.Rule,
+			StartDate:      r.StartDate,
+			Priority:       r.Priority,
+			LastOccurrence: r.LastOccurrence,
+		})
+	}
+
+	return &todov1.TodoList{
+		AggregateId:    out.AggregateID,
+		UserId:         out.UserID,
+		Title:          out.Title,
+		Description:    out.Description,
+		Archived:       out.Archived,
+		Collaborators:  collaborators,
+		Items:          items,
+		RecurringTodos: recurring,
+		Version:        int32(out.Version),
+		UpdatedAt:      out.UpdatedAt.Format(time.RFC3339),
+	}
+}
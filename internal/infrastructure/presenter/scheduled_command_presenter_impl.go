@@ -0,0 +1,68 @@
+package presenter
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/presenter/viewmodel"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/output"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/presenter"
+)
+
+type HTTPScheduledCommandPresenter struct {
+	view ScheduledCommandView
+}
+
+func NewHTTPScheduledCommandPresenter(view ScheduledCommandView) presenter.ScheduledCommandPresenter {
+	return &HTTPScheduledCommandPresenter{view: view}
+}
+
+func (p *HTTPScheduledCommandPresenter) PresentScheduled(ctx context.Context, out *output.ScheduledCommandOutput) error {
+	return p.view.Render(ctx, scheduledCommandViewModel(out), http.StatusCreated, nil)
+}
+
+func (p *HTTPScheduledCommandPresenter) PresentCancelled(ctx context.Context, out *output.ScheduledCommandOutput) error {
+	return p.view.Render(ctx, scheduledCommandViewModel(out), http.StatusOK, nil)
+}
+
+func (p *HTTPScheduledCommandPresenter) PresentError(ctx context.Context, err error) error {
+	return p.view.Render(ctx, nil, p.determineStatusCode(err), err)
+}
+
+func (p *HTTPScheduledCommandPresenter) determineStatusCode(err error) int {
+	if errors.IsCode(err, errors.InvalidParameter) {
+		return http.StatusUnprocessableEntity
+	}
+	if errors.IsCode(err, errors.Unauthenticated) {
+		return http.StatusUnauthorized
+	}
+	if errors.IsCode(err, errors.Forbidden) {
+		return http.StatusForbidden
+	}
+	if errors.IsCode(err, errors.NotFound) {
+		return http.StatusNotFound
+	}
+	return http.StatusInternalServerError
+}
+
+func scheduledCommandViewModel(out *output.ScheduledCommandOutput) *viewmodel.ScheduledCommandVM {
+	return &viewmodel.ScheduledCommandVM{
+		ScheduledCommandID: out.ID,
+		Type:               out.Type,
+		AggregateID:        out.AggregateID,
+		Title:              out.Title,
+		Description:        out.Description,
+		Text:               out.Todo,
+		DueDate:            out.DueDate,
+		Priority:           out.Priority,
+		RunAt:              out.RunAt.Format(time.RFC3339),
+		Status:             out.Status,
+		Attempts:           out.Attempts,
+		LastError:          out.LastError,
+		ResultAggregateID:  out.ResultAggregateID,
+		ResultVersion:      out.ResultVersion,
+		CreatedAt:          out.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,60 @@
+package presenter
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/presenter/viewmodel"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/query/output"
+)
+
+type HTTPScheduledCommandsPresenter struct {
+	view ScheduledCommandsView
+}
+
+func NewHTTPScheduledCommandsPresenter(view ScheduledCommandsView) presenter.ScheduledCommandsPresenter {
+	return &HTTPScheduledCommandsPresenter{view: view}
+}
+
+func (p *HTTPScheduledCommandsPresenter) Present(ctx context.Context, out *output.ListScheduledCommandsOutput) error {
+	cmds := make([]viewmodel.ScheduledCommandVM, 0, len(out.Commands))
+	for _, c := range out.Commands {
+		cmds = append(cmds, viewmodel.ScheduledCommandVM{
+			ScheduledCommandID: c.ID,
+			Type:               c.Type,
+			AggregateID:        c.AggregateID,
+			Title:              c.Title,
+			Description:        c.Description,
+			Text:               c.Todo,
+			DueDate:            c.DueDate,
+			Priority:           c.Priority,
+			RunAt:              c.RunAt.Format(time.RFC3339),
+			Status:             c.Status,
+			Attempts:           c.Attempts,
+			LastError:          c.LastError,
+			ResultAggregateID:  c.ResultAggregateID,
+			ResultVersion:      c.ResultVersion,
+			CreatedAt:          c.CreatedAt.Format(time.RFC3339),
+		})
+	}
+
+	vm := &viewmodel.ScheduledCommandsVM{
+		UserID:   out.UserID,
+		Commands: cmds,
+	}
+	return p.view.Render(ctx, vm, http.StatusOK, nil)
+}
+
+func (p *HTTPScheduledCommandsPresenter) PresentError(ctx context.Context, err error) error {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.IsCode(err, errors.InvalidParameter):
+		status = http.StatusBadRequest
+	case errors.IsCode(err, errors.Unauthenticated):
+		status = http.StatusUnauthorized
+	}
+	return p.view.Render(ctx, nil, status, err)
+}
@@ -0,0 +1,44 @@
+package presenter
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/presenter/viewmodel"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/query/output"
+)
+
+type TodoListHistoryPresenterImpl struct {
+	view TodoListHistoryView
+}
+
+func NewTodoListHistoryPresenterImpl(view TodoListHistoryView) presenter.TodoListHistoryPresenter {
+	return &TodoListHistoryPresenterImpl{view: view}
+}
+
+func (p *TodoListHistoryPresenterImpl) Present(ctx context.Context, out *output.GetTodoListHistoryOutput) error {
+	vm := &viewmodel.TodoListHistoryVM{
+		AggregateID: out.AggregateID,
+		Events:      eventViewModels(out.Events),
+	}
+	return p.view.Render(ctx, vm, http.StatusOK, nil)
+}
+
+func (p *TodoListHistoryPresenterImpl) PresentError(ctx context.Context, err error) error {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.IsCode(err, errors.InvalidParameter):
+		status = http.StatusBadRequest
+	case errors.IsCode(err, errors.Unauthenticated):
+		status = http.StatusUnauthorized
+	case errors.IsCode(err, errors.Forbidden):
+		status = http.StatusForbidden
+	case errors.IsCode(err, errors.NotFound):
+		status = http.StatusNotFound
+	case errors.IsCode(err, errors.Deleted):
+		status = http.StatusGone
+	}
+	return p.view.Render(ctx, nil, status, err)
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/presenter/viewmodel"
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/presenter"
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/query/output"
@@ -19,23 +20,92 @@ func NewHTTPTodoListPresenter(view TodoListView) presenter.TodoListPresenter {
 }
 
 func (p *HTTPTodoListPresenter) Present(ctx context.Context, out *output.GetTodoListOutput) error {
+	return p.view.Render(ctx, p.toViewModel(out), http.StatusOK, nil)
+}
+
+// PresentStale answers with the newest projected state but flags it as stale,
+// so clients can tell the projection has not yet reached the version they asked for.
+func (p *HTTPTodoListPresenter) PresentStale(ctx context.Context, out *output.GetTodoListOutput) error {
+	vm := p.toViewModel(out)
+	vm.Stale = true
+	return p.view.Render(ctx, vm, http.StatusServiceUnavailable, nil)
+}
+
+func (p *HTTPTodoListPresenter) PresentNotFound(ctx context.Context, err error) error {
+	return p.view.Render(ctx, nil, http.StatusNotFound, err)
+}
+
+func (p *HTTPTodoListPresenter) PresentError(ctx context.Context, err error) error {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.IsCode(err, errors.InvalidParameter):
+		status = http.StatusBadRequest
+	case errors.IsCode(err, errors.Unauthenticated):
+		status = http.StatusUnauthorized
+	case errors.IsCode(err, errors.Forbidden):
+		status = http.StatusForbidden
+	case errors.IsCode(err, errors.Deleted):
+		status = http.StatusGone
+	}
+	return p.view.Render(ctx, nil, status, err)
+}
+
+func (p *HTTPTodoListPresenter) toViewModel(out *output.GetTodoListOutput) *viewmodel.TodoListVM {
 	var items []viewmodel.TodoItem
 	for _, it := range out.Items {
-		items = append(items, viewmodel.TodoItem{Text: it.Text})
+		items = append(items, viewmodel.TodoItem{
+			ID:           it.ID,
+			Text:         it.Text,
+			DueDate:      it.DueDate,
+			Priority:     it.Priority,
+			Tags:         it.Tags,
+			Completed:    it.Completed,
+			Checklist:    toChecklistVM(it.Checklist),
+			Overdue:      it.Overdue,
+			RecurrenceID: it.RecurrenceID,
+		})
 	}
-	vm := &viewmodel.TodoListVM{
-		AggregateID: out.AggregateID,
-		UserID:      out.UserID,
-		Items:       items,
-		UpdatedAt:   out.UpdatedAt.Format(time.RFC3339),
+	collaborators := make([]viewmodel.Collaborator, 0, len(out.Collaborators))
+	for _, c := range out.Collaborators {
+		collaborators = append(collaborators, viewmodel.Collaborator{UserID: c.UserID, Role: c.Role})
+	}
+	return &viewmodel.TodoListVM{
+		AggregateID:    out.AggregateID,
+		UserID:         out.UserID,
+		Title:          out.Title,
+		Description:    out.Description,
+		Archived:       out.Archived,
+		Collaborators:  collaborators,
+		Items:          items,
+		RecurringTodos: toRecurringVM(out.RecurringTodos),
+		Version:        out.Version,
+		UpdatedAt:      out.UpdatedAt.Format(time.RFC3339),
 	}
-	return p.view.Render(ctx, vm, http.StatusOK, nil)
 }
 
-func (p *HTTPTodoListPresenter) PresentNotFound(ctx context.Context, err error) error {
-	return p.view.Render(ctx, nil, http.StatusNotFound, err)
+func toChecklistVM(checklist []output.ChecklistItem) []viewmodel.ChecklistItemVM {
+	vms := make([]viewmodel.ChecklistItemVM, 0, len(checklist))
+	for _, c := range checklist {
+		vms = append(vms, viewmodel.ChecklistItemVM{
+			ID:        c.ID,
+			Text:      c.Text,
+			Completed: c.Completed,
+		})
+	}
+	return vms
 }
 
-func (p *HTTPTodoListPresenter) PresentError(ctx context.Context, err error) error {
-	return p.view.Render(ctx, nil, http.StatusInternalServerError, err)
+func toRecurringVM(recurring []output.RecurringTodo) []viewmodel.RecurringTodoVM {
+	vms := make([]viewmodel.RecurringTodoVM, 0, len(recurring))
+	for _, r := range recurring {
+		vms = append(vms, viewmodel.RecurringTodoVM{
+			ID:             r.ID,
+			Text:           r.Text,
+			Rule:           r.Rule,
+			StartDate:      r.StartDate,
+			Priority:       r.Priority,
+			LastOccurrence: r.LastOccurrence,
+		})
+	}
+	return vms
 }
@@ -156,6 +156,27 @@ func TestHTTPTodoListPresenter_PresentError(t *testing.T) {
 				}
 			},
 		},
+		"Deleted list presentation": {
+			inputError: errors.Deleted.New("todo list has been deleted"),
+			setupMock: func(m *mockTodoListView) {
+				m.renderFunc = func(ctx context.Context, vm *viewmodel.TodoListVM, status int, err error) error {
+					require.Equal(t, http.StatusGone, status)
+					require.Nil(t, vm)
+					require.True(t, errors.IsCode(err, errors.Deleted))
+					return nil
+				}
+			},
+		},
+		"Unauthenticated presentation": {
+			inputError: errors.Unauthenticated.New("authentication required"),
+			setupMock: func(m *mockTodoListView) {
+				m.renderFunc = func(ctx context.Context, vm *viewmodel.TodoListVM, status int, err error) error {
+					require.Equal(t, http.StatusUnauthorized, status)
+					require.Nil(t, vm)
+					return nil
+				}
+			},
+		},
 	}
 
 	for name, tt := range tests {
@@ -172,3 +193,46 @@ func TestHTTPTodoListPresenter_PresentError(t *testing.T) {
 		})
 	}
 }
+
+func TestHTTPTodoListPresenter_PresentStale(t *testing.T) {
+	testAggregateID := uuid.New()
+
+	tests := map[string]struct {
+		input     *output.GetTodoListOutput
+		setupMock func(*mockTodoListView)
+	}{
+		"stale presentation keeps data and flags it": {
+			input: &output.GetTodoListOutput{
+				AggregateID: testAggregateID.String(),
+				UserID:      "user123",
+				Items:       []output.TodoItem{{Text: "First todo"}},
+				Version:     2,
+				UpdatedAt:   time.Now(),
+			},
+			setupMock: func(m *mockTodoListView) {
+				m.renderFunc = func(ctx context.Context, vm *viewmodel.TodoListVM, status int, err error) error {
+					require.Equal(t, http.StatusServiceUnavailable, status)
+					require.Nil(t, err)
+					require.True(t, vm.Stale)
+					require.Equal(t, 2, vm.Version)
+					require.Len(t, vm.Items, 1)
+					return nil
+				}
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockView := &mockTodoListView{}
+			if tt.setupMock != nil {
+				tt.setupMock(mockView)
+			}
+
+			presenter := NewHTTPTodoListPresenter(mockView)
+			err := presenter.PresentStale(context.Background(), tt.input)
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package presenter
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/presenter/viewmodel"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/query/output"
+)
+
+type HTTPUserTagsPresenter struct {
+	view UserTagsView
+}
+
+func NewHTTPUserTagsPresenter(view UserTagsView) presenter.UserTagsPresenter {
+	return &HTTPUserTagsPresenter{view: view}
+}
+
+func (p *HTTPUserTagsPresenter) Present(ctx context.Context, out *output.ListUserTagsOutput) error {
+	tags := make([]viewmodel.TagCountVM, 0, len(out.Tags))
+	for _, t := range out.Tags {
+		tags = append(tags, viewmodel.TagCountVM{
+			Tag:       t.Tag,
+			TodoCount: t.TodoCount,
+		})
+	}
+
+	vm := &viewmodel.UserTagsVM{
+		UserID: out.UserID,
+		Tags:   tags,
+	}
+	return p.view.Render(ctx, vm, http.StatusOK, nil)
+}
+
+func (p *HTTPUserTagsPresenter) PresentError(ctx context.Context, err error) error {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.IsCode(err, errors.InvalidParameter):
+		status = http.StatusBadRequest
+	case errors.IsCode(err, errors.Unauthenticated):
+		status = http.StatusUnauthorized
+	case errors.IsCode(err, errors.Forbidden):
+		status = http.StatusForbidden
+	}
+	return p.view.Render(ctx, nil, status, err)
+}
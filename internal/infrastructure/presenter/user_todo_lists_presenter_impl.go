@@ -0,0 +1,58 @@
+package presenter
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/presenter/viewmodel"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/query/output"
+)
+
+type HTTPUserTodoListsPresenter struct {
+	view UserTodoListsView
+}
+
+func NewHTTPUserTodoListsPresenter(view UserTodoListsView) presenter.UserTodoListsPresenter {
+	return &HTTPUserTodoListsPresenter{view: view}
+}
+
+func (p *HTTPUserTodoListsPresenter) Present(ctx context.Context, out *output.ListUserTodoListsOutput) error {
+	lists := make([]viewmodel.TodoListSummaryVM, 0, len(out.TodoLists))
+	for _, l := range out.TodoLists {
+		lists = append(lists, viewmodel.TodoListSummaryVM{
+			AggregateID: l.AggregateID,
+			OwnerID:     l.OwnerID,
+			Role:        l.Role,
+			ItemCount:   l.ItemCount,
+			Archived:    l.Archived,
+			Version:     l.Version,
+			CreatedAt:   l.CreatedAt.Format(time.RFC3339),
+			UpdatedAt:   l.UpdatedAt.Format(time.RFC3339),
+		})
+	}
+
+	vm := &viewmodel.UserTodoListsVM{
+		UserID:    out.UserID,
+		TodoLists: lists,
+		Total:     out.Total,
+		Limit:     out.Limit,
+		Offset:    out.Offset,
+	}
+	return p.view.Render(ctx, vm, http.StatusOK, nil)
+}
+
+func (p *HTTPUserTodoListsPresenter) PresentError(ctx context.Context, err error) error {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.IsCode(err, errors.InvalidParameter):
+		status = http.StatusBadRequest
+	case errors.IsCode(err, errors.Unauthenticated):
+		status = http.StatusUnauthorized
+	case errors.IsCode(err, errors.Forbidden):
+		status = http.StatusForbidden
+	}
+	return p.view.Render(ctx, nil, status, err)
+}
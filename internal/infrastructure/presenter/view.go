@@ -10,6 +10,34 @@ type CommandView interface {
 	Render(ctx context.Context, vm *viewmodel.CommandResultViewModel, status int, err error) error
 }
 
+type BatchCommandView interface {
+	Render(ctx context.Context, vms []viewmodel.CommandResultViewModel, status int, err error) error
+}
+
 type TodoListView interface {
 	Render(ctx context.Context, vm *viewmodel.TodoListVM, status int, err error) error
 }
+
+type WebhookSubscriptionView interface {
+	Render(ctx context.Context, vm *viewmodel.WebhookSubscriptionVM, status int, err error) error
+}
+
+type UserTodoListsView interface {
+	Render(ctx context.Context, vm *viewmodel.UserTodoListsVM, status int, err error) error
+}
+
+type UserTagsView interface {
+	Render(ctx context.Context, vm *viewmodel.UserTagsVM, status int, err error) error
+}
+
+type ScheduledCommandView interface {
+	Render(ctx context.Context, vm *viewmodel.ScheduledCommandVM, status int, err error) error
+}
+
+type ScheduledCommandsView interface {
+	Render(ctx context.Context, vm *viewmodel.ScheduledCommandsVM, status int, err error) error
+}
+
+type TodoListHistoryView interface {
+	Render(ctx context.Context, vm *viewmodel.TodoListHistoryVM, status int, err error) error
+}
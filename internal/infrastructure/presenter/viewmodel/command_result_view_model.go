@@ -5,7 +5,10 @@ type CommandResultViewModel struct {
 	Version     int              `json:"version"`
 	Events      []EventViewModel `json:"events"`
 	Status      string           `json:"status"`
-	ExecutedAt  string           `json:"executedAt"`
+	// Message explains why the command failed; only batch results, which
+	// carry several outcomes in one response, set it.
+	Message    string `json:"message,omitempty"`
+	ExecutedAt string `json:"executedAt"`
 }
 
 type EventViewModel struct {
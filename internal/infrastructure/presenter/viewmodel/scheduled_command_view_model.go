@@ -0,0 +1,24 @@
+package viewmodel
+
+type ScheduledCommandVM struct {
+	ScheduledCommandID string `json:"scheduled_command_id"`
+	Type               string `json:"type"`
+	AggregateID        string `json:"aggregate_id,omitempty"`
+	Title              string `json:"title,omitempty"`
+	Description        string `json:"description,omitempty"`
+	Text               string `json:"text,omitempty"`
+	DueDate            string `json:"due_date,omitempty"`
+	Priority           string `json:"priority,omitempty"`
+	RunAt              string `json:"run_at"`
+	Status             string `json:"status"`
+	Attempts           int    `json:"attempts"`
+	LastError          string `json:"last_error,omitempty"`
+	ResultAggregateID  string `json:"result_aggregate_id,omitempty"`
+	ResultVersion      int    `json:"result_version,omitempty"`
+	CreatedAt          string `json:"created_at"`
+}
+
+type ScheduledCommandsVM struct {
+	UserID   string               `json:"user_id"`
+	Commands []ScheduledCommandVM `json:"scheduled_commands"`
+}
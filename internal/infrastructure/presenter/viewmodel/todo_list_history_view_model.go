@@ -0,0 +1,6 @@
+package viewmodel
+
+type TodoListHistoryVM struct {
+	AggregateID string           `json:"aggregate_id"`
+	Events      []EventViewModel `json:"events"`
+}
@@ -1,12 +1,47 @@
 package viewmodel
 
 type TodoListVM struct {
-	AggregateID string     `json:"aggregate_id"`
-	UserID      string     `json:"user_id"`
-	Items       []TodoItem `json:"items"`
-	UpdatedAt   string     `json:"updated_at"`
+	AggregateID    string            `json:"aggregate_id"`
+	UserID         string            `json:"user_id"`
+	Title          string            `json:"title,omitempty"`
+	Description    string            `json:"description,omitempty"`
+	Archived       bool              `json:"archived"`
+	Collaborators  []Collaborator    `json:"collaborators"`
+	Items          []TodoItem        `json:"items"`
+	RecurringTodos []RecurringTodoVM `json:"recurring_todos"`
+	Version        int               `json:"version"`
+	UpdatedAt      string            `json:"updated_at"`
+	Stale          bool              `json:"stale,omitempty"`
+}
+
+type Collaborator struct {
+	UserID string `json:"user_id"`
+	Role   string `json:"role"`
 }
 
 type TodoItem struct {
-	Text string `json:"text"`
+	ID           string            `json:"id"`
+	Text         string            `json:"text"`
+	DueDate      string            `json:"due_date,omitempty"`
+	Priority     string            `json:"priority"`
+	Tags         []string          `json:"tags"`
+	Overdue      bool              `json:"overdue"`
+	Completed    bool              `json:"completed"`
+	Checklist    []ChecklistItemVM `json:"checklist"`
+	RecurrenceID string            `json:"recurrence_id,omitempty"`
+}
+
+type ChecklistItemVM struct {
+	ID        string `json:"id"`
+	Text      string `json:"text"`
+	Completed bool   `json:"completed"`
+}
+
+type RecurringTodoVM struct {
+	ID             string `json:"id"`
+	Text           string `json:"text"`
+	Rule           string `json:"rule"`
+	StartDate      string `json:"start_date"`
+	Priority       string `json:"priority"`
+	LastOccurrence string `json:"last_occurrence,omitempty"`
 }
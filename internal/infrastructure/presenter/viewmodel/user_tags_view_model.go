@@ -0,0 +1,11 @@
+package viewmodel
+
+type UserTagsVM struct {
+	UserID string       `json:"user_id"`
+	Tags   []TagCountVM `json:"tags"`
+}
+
+type TagCountVM struct {
+	Tag       string `json:"tag"`
+	TodoCount int    `json:"todo_count"`
+}
@@ -0,0 +1,20 @@
+package viewmodel
+
+type UserTodoListsVM struct {
+	UserID    string              `json:"user_id"`
+	TodoLists []TodoListSummaryVM `json:"todo_lists"`
+	Total     int                 `json:"total"`
+	Limit     int                 `json:"limit"`
+	Offset    int                 `json:"offset"`
+}
+
+type TodoListSummaryVM struct {
+	AggregateID string `json:"aggregate_id"`
+	OwnerID     string `json:"owner_id"`
+	Role        string `json:"role"`
+	ItemCount   int    `json:"item_count"`
+	Archived    bool   `json:"archived"`
+	Version     int    `json:"version"`
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+}
@@ -0,0 +1,10 @@
+package viewmodel
+
+type WebhookSubscriptionVM struct {
+	SubscriptionID string   `json:"subscription_id"`
+	UserID         string   `json:"user_id"`
+	AggregateID    string   `json:"aggregate_id,omitempty"`
+	TargetURL      string   `json:"target_url"`
+	EventTypes     []string `json:"event_types"`
+	CreatedAt      string   `json:"created_at"`
+}
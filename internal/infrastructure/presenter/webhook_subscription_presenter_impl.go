@@ -0,0 +1,60 @@
+package presenter
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/presenter/viewmodel"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/output"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/presenter"
+)
+
+type HTTPWebhookSubscriptionPresenter struct {
+	view WebhookSubscriptionView
+}
+
+func NewHTTPWebhookSubscriptionPresenter(view WebhookSubscriptionView) presenter.WebhookSubscriptionPresenter {
+	return &HTTPWebhookSubscriptionPresenter{view: view}
+}
+
+func (p *HTTPWebhookSubscriptionPresenter) PresentSuccess(ctx context.Context, out *output.SubscribeWebhookOutput) error {
+	eventTypes := out.EventTypes
+	if eventTypes == nil {
+		eventTypes = []string{}
+	}
+
+	vm := &viewmodel.WebhookSubscriptionVM{
+		SubscriptionID: out.SubscriptionID,
+		UserID:         out.UserID,
+		AggregateID:    out.AggregateID,
+		TargetURL:      out.TargetURL,
+		EventTypes:     eventTypes,
+		CreatedAt:      out.CreatedAt.Format(time.RFC3339),
+	}
+	return p.view.Render(ctx, vm, http.StatusCreated, nil)
+}
+
+func (p *HTTPWebhookSubscriptionPresenter) PresentError(ctx context.Context, err error) error {
+	return p.view.Render(ctx, nil, p.determineStatusCode(err), err)
+}
+
+func (p *HTTPWebhookSubscriptionPresenter) determineStatusCode(err error) int {
+	if errors.IsCode(err, errors.InvalidParameter) {
+		return http.StatusUnprocessableEntity
+	}
+	if errors.IsCode(err, errors.Unauthenticated) {
+		return http.StatusUnauthorized
+	}
+	if errors.IsCode(err, errors.NotFound) {
+		return http.StatusNotFound
+	}
+	if errors.IsCode(err, errors.Deleted) {
+		return http.StatusGone
+	}
+	if errors.IsCode(err, errors.UnpermittedOp) {
+		return http.StatusForbidden
+	}
+	return http.StatusInternalServerError
+}
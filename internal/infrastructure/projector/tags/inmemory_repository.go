@@ -0,0 +1,94 @@
+package tags
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"sync"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/readmodelstore/dto"
+)
+
+type InMemoryTagIndexRepository struct {
+	mu   sync.RWMutex
+	data map[string]*dto.TodoListTagsDTO
+}
+
+func NewInMemoryTagIndexRepository() *InMemoryTagIndexRepository {
+	return &InMemoryTagIndexRepository{
+		data: make(map[string]*dto.TodoListTagsDTO),
+	}
+}
+
+func (r *InMemoryTagIndexRepository) Get(ctx context.Context, aggregateID string) (*dto.TodoListTagsDTO, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	view := r.data[aggregateID]
+	if view == nil {
+		return nil, errors.NotFound.New("tag index not found")
+	}
+
+	return cloneView(view), nil
+}
+
+func (r *InMemoryTagIndexRepository) Upsert(ctx context.Context, view *dto.TodoListTagsDTO) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.data[view.AggregateID] = cloneView(view)
+	return nil
+}
+
+func (r *InMemoryTagIndexRepository) Delete(ctx context.Context, aggregateID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.data, aggregateID)
+	return nil
+}
+
+func (r *InMemoryTagIndexRepository) ListByUserID(ctx context.Context, userID string) ([]dto.TagCountDTO, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	counts := make(map[string]int)
+	for _, view := range r.data {
+		if !view.IsMember(userID) {
+			continue
+		}
+		for tag, todoIDs := range view.TodoIDsByTag {
+			counts[tag] += len(todoIDs)
+		}
+	}
+
+	result := make([]dto.TagCountDTO, 0, len(counts))
+	for tag, count := range counts {
+		result = append(result, dto.TagCountDTO{Tag: tag, TodoCount: count})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].TodoCount == result[j].TodoCount {
+			return result[i].Tag < result[j].Tag
+		}
+		return result[i].TodoCount > result[j].TodoCount
+	})
+
+	return result, nil
+}
+
+func cloneView(view *dto.TodoListTagsDTO) *dto.TodoListTagsDTO {
+	collaborators := make([]dto.CollaboratorViewDTO, len(view.Collaborators))
+	copy(collaborators, view.Collaborators)
+
+	todoIDsByTag := make(map[string][]string, len(view.TodoIDsByTag))
+	for tag, todoIDs := range view.TodoIDsByTag {
+		todoIDsByTag[tag] = slices.Clone(todoIDs)
+	}
+
+	cloned := *view
+	cloned.Collaborators = collaborators
+	cloned.TodoIDsByTag = todoIDsByTag
+	return &cloned
+}
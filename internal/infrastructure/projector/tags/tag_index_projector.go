@@ -0,0 +1,123 @@
+package tags
+
+import (
+	"context"
+	"slices"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/gateway"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/readmodelstore"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/readmodelstore/dto"
+)
+
+// TagIndexProjectorImpl maintains, for every todo list, which todos carry
+// which tag, along with the list's members so tags can be listed per user.
+type TagIndexProjectorImpl struct {
+	store readmodelstore.TagIndexStore
+	seen  map[string]struct{}
+}
+
+func NewTagIndexProjector(store readmodelstore.TagIndexStore) gateway.Projector {
+	return &TagIndexProjectorImpl{
+		store: store,
+		seen:  make(map[string]struct{}),
+	}
+}
+
+func (p *TagIndexProjectorImpl) Handle(ctx context.Context, e event.Event) error {
+	eventID := e.GetEventID().String()
+	if _, ok := p.seen[eventID]; ok {
+		return nil
+	}
+	p.seen[eventID] = struct{}{}
+
+	switch evt := e.(type) {
+	case event.TodoListCreatedEvent:
+		return p.store.Upsert(ctx, &dto.TodoListTagsDTO{
+			AggregateID:   evt.AggregateID.String(),
+			UserID:        evt.UserID.String(),
+			Collaborators: []dto.CollaboratorViewDTO{},
+			TodoIDsByTag:  map[string][]string{},
+		})
+	case event.TodoTaggedEvent:
+		return p.update(ctx, e, func(view *dto.TodoListTagsDTO) {
+			tag := evt.Tag.String()
+			view.TodoIDsByTag[tag] = append(view.TodoIDsByTag[tag], evt.TodoID.String())
+		})
+	case event.TodoUntaggedEvent:
+		return p.update(ctx, e, func(view *dto.TodoListTagsDTO) {
+			tag := evt.Tag.String()
+			remaining := slices.DeleteFunc(view.TodoIDsByTag[tag], func(todoID string) bool {
+				return todoID == evt.TodoID.String()
+			})
+			if len(remaining) == 0 {
+				delete(view.TodoIDsByTag, tag)
+				return
+			}
+			view.TodoIDsByTag[tag] = remaining
+		})
+	case event.TodoRemovedEvent:
+		return p.update(ctx, e, func(view *dto.TodoListTagsDTO) {
+			removeTodo(view, evt.TodoID.String())
+		})
+	case event.TodoMovedOutEvent:
+		return p.update(ctx, e, func(view *dto.TodoListTagsDTO) {
+			removeTodo(view, evt.TodoID.String())
+		})
+	case event.TodoMovedInEvent:
+		return p.update(ctx, e, func(view *dto.TodoListTagsDTO) {
+			for _, tag := range evt.Tags {
+				view.TodoIDsByTag[tag.String()] = append(view.TodoIDsByTag[tag.String()], evt.TodoID.String())
+			}
+		})
+	case event.CollaboratorInvitedEvent:
+		return p.update(ctx, e, func(view *dto.TodoListTagsDTO) {
+			view.Collaborators = dto.WithCollaborator(view.Collaborators, evt.CollaboratorID.String(), evt.Role.String())
+		})
+	case event.CollaboratorRoleChangedEvent:
+		return p.update(ctx, e, func(view *dto.TodoListTagsDTO) {
+			view.Collaborators = dto.WithCollaborator(view.Collaborators, evt.CollaboratorID.String(), evt.Role.String())
+		})
+	case event.CollaboratorRemovedEvent:
+		return p.update(ctx, e, func(view *dto.TodoListTagsDTO) {
+			view.Collaborators = dto.WithoutCollaborator(view.Collaborators, evt.CollaboratorID.String())
+		})
+	case event.TodoListDeletedEvent:
+		return p.store.Delete(ctx, evt.AggregateID.String())
+	default:
+		return nil
+	}
+}
+
+func (p *TagIndexProjectorImpl) update(ctx context.Context, e event.Event, mutate func(*dto.TodoListTagsDTO)) error {
+	current, err := p.store.Get(ctx, e.GetAggregateID().String())
+	if err != nil {
+		if errors.IsCode(err, errors.NotFound) {
+			return nil
+		}
+		return err
+	}
+
+	mutate(current)
+	return p.store.Upsert(ctx, current)
+}
+
+// removeTodo drops todoID from every tag, and tags left without todos.
+func removeTodo(view *dto.TodoListTagsDTO, todoID string) {
+	for tag, todoIDs := range view.TodoIDsByTag {
+		remaining := slices.DeleteFunc(todoIDs, func(id string) bool {
+			return id == todoID
+		})
+		if len(remaining) == 0 {
+			delete(view.TodoIDsByTag, tag)
+			continue
+		}
+		view.TodoIDsByTag[tag] = remaining
+	}
+}
+
+func (p *TagIndexProjectorImpl) Start(ctx context.Context, bus gateway.EventSubscriber) error {
+	bus.Subscribe(p.Handle)
+	return nil
+}
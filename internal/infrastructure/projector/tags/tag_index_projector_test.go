@@ -0,0 +1,91 @@
+package tags_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/projector/tags"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/readmodelstore/dto"
+)
+
+func TestTagIndexProjectorImpl_Handle(t *testing.T) {
+	listA := uuid.MustParse("550e8400-e29b-41d4-a716-446655440000")
+	listB := uuid.MustParse("550e8400-e29b-41d4-a716-446655440001")
+	todo1, todo2, todo3 := uuid.New(), uuid.New(), uuid.New()
+	now := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	owner := value.UserID("user123")
+
+	created := func(id uuid.UUID) event.Event {
+		return event.TodoListCreatedEvent{AggregateID: id, UserID: owner, EventID: uuid.New(), Timestamp: now, Version: 1}
+	}
+	tagged := func(id, todoID uuid.UUID, tag value.Tag) event.Event {
+		return event.TodoTaggedEvent{AggregateID: id, UserID: owner, TodoID: todoID, Tag: tag, EventID: uuid.New(), Timestamp: now}
+	}
+
+	tests := map[string]struct {
+		events []event.Event
+		userID string
+		want   []dto.TagCountDTO
+	}{
+		"counts todos across the owner's lists": {
+			events: []event.Event{
+				created(listA), created(listB),
+				tagged(listA, todo1, "home"),
+				tagged(listA, todo2, "urgent"),
+				tagged(listB, todo3, "urgent"),
+			},
+			userID: "user123",
+			want:   []dto.TagCountDTO{{Tag: "urgent", TodoCount: 2}, {Tag: "home", TodoCount: 1}},
+		},
+		"untagged todos drop out of the index": {
+			events: []event.Event{
+				created(listA),
+				tagged(listA, todo1, "home"),
+				event.TodoUntaggedEvent{AggregateID: listA, UserID: owner, TodoID: todo1, Tag: "home", EventID: uuid.New(), Timestamp: now},
+			},
+			userID: "user123",
+			want:   []dto.TagCountDTO{},
+		},
+		"collaborators see the tags of shared lists": {
+			events: []event.Event{
+				created(listA),
+				tagged(listA, todo1, "home"),
+				event.CollaboratorInvitedEvent{AggregateID: listA, UserID: owner, CollaboratorID: "alice", Role: value.RoleViewer, EventID: uuid.New(), Timestamp: now},
+			},
+			userID: "alice",
+			want:   []dto.TagCountDTO{{Tag: "home", TodoCount: 1}},
+		},
+		"deleted lists are removed": {
+			events: []event.Event{
+				created(listA),
+				tagged(listA, todo1, "home"),
+				event.TodoListDeletedEvent{AggregateID: listA, UserID: owner, EventID: uuid.New(), Timestamp: now},
+			},
+			userID: "user123",
+			want:   []dto.TagCountDTO{},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			repo := tags.NewInMemoryTagIndexRepository()
+			projector := tags.NewTagIndexProjector(repo)
+
+			// Act
+			for _, e := range tt.events {
+				require.NoError(t, projector.Handle(context.Background(), e))
+			}
+
+			// Assert
+			got, err := repo.ListByUserID(context.Background(), tt.userID)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -2,6 +2,8 @@ package todo
 
 import (
 	"context"
+	"slices"
+	"sort"
 	"sync"
 
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
@@ -40,19 +42,53 @@ func (r *InMemoryTodoListViewRepository) Upsert(ctx context.Context, aggregateID
 	return nil
 }
 
+func (r *InMemoryTodoListViewRepository) ListIDsWithOverdueTodos(ctx context.Context, today string) ([]string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ids := make([]string, 0)
+	for id, view := range r.data {
+		if view.Archived || view.Deleted {
+			continue
+		}
+		for _, item := range view.Items {
+			if item.DueDate != "" && item.DueDate < today && !item.Overdue && !item.Completed {
+				ids = append(ids, id)
+				break
+			}
+		}
+	}
+
+	sort.Strings(ids)
+	return ids, nil
+}
+
 func (r *InMemoryTodoListViewRepository) cloneView(view *dto.TodoListViewDTO) *dto.TodoListViewDTO {
 	if view == nil {
 		return nil
 	}
 
+	collaborators := make([]dto.CollaboratorViewDTO, len(view.Collaborators))
+	copy(collaborators, view.Collaborators)
+
 	items := make([]dto.TodoItemViewDTO, len(view.Items))
-	copy(items, view.Items)
+	for i, item := range view.Items {
+		item.Tags = slices.Clone(item.Tags)
+		item.Checklist = slices.Clone(item.Checklist)
+		items[i] = item
+	}
 
 	return &dto.TodoListViewDTO{
-		AggregateID: view.AggregateID,
-		UserID:      view.UserID,
-		Items:       items,
-		Version:     view.Version,
-		UpdatedAt:   view.UpdatedAt,
+		AggregateID:    view.AggregateID,
+		UserID:         view.UserID,
+		Title:          view.Title,
+		Description:    view.Description,
+		Archived:       view.Archived,
+		Deleted:        view.Deleted,
+		Collaborators:  collaborators,
+		Items:          items,
+		RecurringTodos: slices.Clone(view.RecurringTodos),
+		Version:        view.Version,
+		UpdatedAt:      view.UpdatedAt,
 	}
 }
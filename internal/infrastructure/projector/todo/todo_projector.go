@@ -2,7 +2,9 @@ package todo
 
 import (
 	"context"
+	"slices"
 
+	"github.com/google/uuid"
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/gateway"
@@ -30,7 +32,14 @@ func (p *TodoProjectorImpl) Handle(ctx context.Context, e event.Event) error {
 	p.seen[eventID] = struct{}{}
 
 	switch e.(type) {
-	case event.TodoListCreatedEvent, event.TodoAddedEvent:
+	case event.TodoListCreatedEvent, event.TodoListRenamedEvent, event.TodoAddedEvent, event.TodoRemovedEvent,
+		event.TodoMovedOutEvent, event.TodoMovedInEvent,
+		event.TodoDueDateSetEvent, event.TodoBecameOverdueEvent, event.TodoPriorityChangedEvent, event.TodoItemsReorderedEvent,
+		event.TodoTaggedEvent, event.TodoUntaggedEvent, event.TodoCompletedEvent, event.TodoReopenedEvent,
+		event.ChecklistItemAddedEvent, event.ChecklistItemCompletedEvent, event.ChecklistItemReopenedEvent, event.ChecklistItemRemovedEvent,
+		event.RecurringTodoScheduledEvent, event.RecurringTodoCancelledEvent, event.CommandUndoneEvent,
+		event.CollaboratorInvitedEvent, event.CollaboratorRoleChangedEvent, event.CollaboratorRemovedEvent,
+		event.TodoListArchivedEvent, event.TodoListRestoredEvent, event.TodoListDeletedEvent:
 		aggID := e.GetAggregateID().String()
 
 		current, err := p.viewRepo.Get(ctx, aggID)
@@ -59,30 +68,294 @@ func (p *TodoProjectorImpl) Start(ctx context.Context, bus gateway.EventSubscrib
 }
 
 func (p *TodoProjectorImpl) applyToView(view *dto.TodoListViewDTO, e event.Event) *dto.TodoListViewDTO {
+	if _, created := e.(event.TodoListCreatedEvent); !created && view == nil {
+		return nil
+	}
+
 	switch evt := e.(type) {
 	case event.TodoListCreatedEvent:
 		return &dto.TodoListViewDTO{
-			AggregateID: evt.GetAggregateID().String(),
-			UserID:      evt.UserID.String(),
-			Items:       []dto.TodoItemViewDTO{},
-			Version:     evt.GetVersion(),
-			UpdatedAt:   evt.GetTimestamp(),
+			AggregateID:    evt.GetAggregateID().String(),
+			UserID:         evt.UserID.String(),
+			Title:          evt.Title.String(),
+			Description:    evt.Description.String(),
+			Collaborators:  []dto.CollaboratorViewDTO{},
+			Items:          []dto.TodoItemViewDTO{},
+			RecurringTodos: []dto.RecurringTodoViewDTO{},
+			Version:        evt.GetVersion(),
+			UpdatedAt:      evt.GetTimestamp(),
 		}
+	case event.TodoListRenamedEvent:
+		return p.withChange(view, e, func(v *dto.TodoListViewDTO) {
+			v.Title = evt.Title.String()
+			v.Description = evt.Description.String()
+		})
 	case event.TodoAddedEvent:
-		newItems := make([]dto.TodoItemViewDTO, len(view.Items))
-		copy(newItems, view.Items)
-		newItems = append(newItems, dto.TodoItemViewDTO{
-			Text: evt.TodoText.String(),
+		return p.withChange(view, e, func(v *dto.TodoListViewDTO) {
+			items := make([]dto.TodoItemViewDTO, len(view.Items), len(view.Items)+1)
+			copy(items, view.Items)
+			v.Items = append(items, dto.TodoItemViewDTO{
+				ID:           evt.ItemID().String(),
+				Text:         evt.TodoText.String(),
+				DueDate:      evt.DueDate.String(),
+				Priority:     evt.Priority.OrDefault().String(),
+				Tags:         []string{},
+				Checklist:    []dto.ChecklistItemViewDTO{},
+				RecurrenceID: recurrenceIDOf(evt),
+			})
+			v.RecurringTodos = updateRecurring(view.RecurringTodos, recurrenceIDOf(evt), func(recurring *dto.RecurringTodoViewDTO) {
+				if evt.DueDate.String() > recurring.LastOccurrence {
+					recurring.LastOccurrence = evt.DueDate.String()
+				}
+			})
+		})
+	case event.TodoRemovedEvent:
+		return p.withChange(view, e, func(v *dto.TodoListViewDTO) {
+			v.Items = slices.DeleteFunc(slices.Clone(view.Items), func(item dto.TodoItemViewDTO) bool {
+				return item.ID == evt.TodoID.String()
+			})
+		})
+	case event.TodoMovedOutEvent:
+		return p.withChange(view, e, func(v *dto.TodoListViewDTO) {
+			v.Items = slices.DeleteFunc(slices.Clone(view.Items), func(item dto.TodoItemViewDTO) bool {
+				return item.ID == evt.TodoID.String()
+			})
+		})
+	case event.TodoMovedInEvent:
+		return p.withChange(view, e, func(v *dto.TodoListViewDTO) {
+			tags := make([]string, 0, len(evt.Tags))
+			for _, tag := range evt.Tags {
+				tags = append(tags, tag.String())
+			}
+			checklist := make([]dto.ChecklistItemViewDTO, 0, len(evt.Checklist))
+			for _, entry := range evt.Checklist {
+				checklist = append(checklist, dto.ChecklistItemViewDTO{
+					ID:        entry.ID.String(),
+					Text:      entry.Text.String(),
+					Completed: entry.Completed,
+				})
+			}
+			items := make([]dto.TodoItemViewDTO, len(view.Items), len(view.Items)+1)
+			copy(items, view.Items)
+			v.Items = append(items, dto.TodoItemViewDTO{
+				ID:        evt.TodoID.String(),
+				Text:      evt.TodoText.String(),
+				DueDate:   evt.DueDate.String(),
+				Priority:  evt.Priority.OrDefault().String(),
+				Tags:      tags,
+				Completed: evt.Completed,
+				Checklist: checklist,
+			})
+		})
+	case event.TodoDueDateSetEvent:
+		return p.withChange(view, e, func(v *dto.TodoListViewDTO) {
+			v.Items = updateItem(view.Items, evt.TodoID.String(), func(item *dto.TodoItemViewDTO) {
+				item.DueDate = evt.DueDate.String()
+				item.Overdue = false
+			})
+		})
+	case event.TodoBecameOverdueEvent:
+		return p.withChange(view, e, func(v *dto.TodoListViewDTO) {
+			v.Items = updateItem(view.Items, evt.TodoID.String(), func(item *dto.TodoItemViewDTO) {
+				item.Overdue = true
+			})
+		})
+	case event.TodoPriorityChangedEvent:
+		return p.withChange(view, e, func(v *dto.TodoListViewDTO) {
+			v.Items = updateItem(view.Items, evt.TodoID.String(), func(item *dto.TodoItemViewDTO) {
+				item.Priority = evt.Priority.String()
+			})
+		})
+	case event.TodoItemsReorderedEvent:
+		return p.withChange(view, e, func(v *dto.TodoListViewDTO) {
+			v.Items = reorderItems(view.Items, evt.TodoIDs)
+		})
+	case event.TodoTaggedEvent:
+		return p.withChange(view, e, func(v *dto.TodoListViewDTO) {
+			v.Items = updateItem(view.Items, evt.TodoID.String(), func(item *dto.TodoItemViewDTO) {
+				item.Tags = append(slices.Clone(item.Tags), evt.Tag.String())
+			})
+		})
+	case event.TodoUntaggedEvent:
+		return p.withChange(view, e, func(v *dto.TodoListViewDTO) {
+			v.Items = updateItem(view.Items, evt.TodoID.String(), func(item *dto.TodoItemViewDTO) {
+				item.Tags = slices.DeleteFunc(slices.Clone(item.Tags), func(tag string) bool {
+					return tag == evt.Tag.String()
+				})
+			})
+		})
+	case event.TodoCompletedEvent:
+		return p.withChange(view, e, func(v *dto.TodoListViewDTO) {
+			v.Items = updateItem(view.Items, evt.TodoID.String(), func(item *dto.TodoItemViewDTO) {
+				item.Completed = true
+			})
+		})
+	case event.TodoReopenedEvent:
+		return p.withChange(view, e, func(v *dto.TodoListViewDTO) {
+			v.Items = updateItem(view.Items, evt.TodoID.String(), func(item *dto.TodoItemViewDTO) {
+				item.Completed = false
+			})
+		})
+	case event.ChecklistItemAddedEvent:
+		return p.withChange(view, e, func(v *dto.TodoListViewDTO) {
+			v.Items = updateItem(view.Items, evt.TodoID.String(), func(item *dto.TodoItemViewDTO) {
+				item.Checklist = append(slices.Clone(item.Checklist), dto.ChecklistItemViewDTO{
+					ID:   evt.ChecklistItemID.String(),
+					Text: evt.Text.String(),
+				})
+			})
+		})
+	case event.ChecklistItemCompletedEvent:
+		return p.withChange(view, e, func(v *dto.TodoListViewDTO) {
+			v.Items = updateItem(view.Items, evt.TodoID.String(), func(item *dto.TodoItemViewDTO) {
+				item.Checklist = slices.Clone(item.Checklist)
+				for i := range item.Checklist {
+					if item.Checklist[i].ID == evt.ChecklistItemID.String() {
+						item.Checklist[i].Completed = true
+					}
+				}
+			})
+		})
+	case event.ChecklistItemReopenedEvent:
+		return p.withChange(view, e, func(v *dto.TodoListViewDTO) {
+			v.Items = updateItem(view.Items, evt.TodoID.String(), func(item *dto.TodoItemViewDTO) {
+				item.Checklist = slices.Clone(item.Checklist)
+				for i := range item.Checklist {
+					if item.Checklist[i].ID == evt.ChecklistItemID.String() {
+						item.Checklist[i].Completed = false
+					}
+				}
+			})
+		})
+	case event.ChecklistItemRemovedEvent:
+		return p.withChange(view, e, func(v *dto.TodoListViewDTO) {
+			v.Items = updateItem(view.Items, evt.TodoID.String(), func(item *dto.TodoItemViewDTO) {
+				item.Checklist = slices.DeleteFunc(slices.Clone(item.Checklist), func(entry dto.ChecklistItemViewDTO) bool {
+					return entry.ID == evt.ChecklistItemID.String()
+				})
+			})
+		})
+	case event.RecurringTodoScheduledEvent:
+		return p.withChange(view, e, func(v *dto.TodoListViewDTO) {
+			v.RecurringTodos = append(slices.Clone(view.RecurringTodos), dto.RecurringTodoViewDTO{
+				ID:        evt.RecurrenceID.String(),
+				Text:      evt.TodoText.String(),
+				Rule:      evt.Rule.String(),
+				StartDate: evt.StartDate.String(),
+				Priority:  evt.Priority.OrDefault().String(),
+				// Scheduled again by an undo, it keeps its occurrences.
+				LastOccurrence: lastOccurrence(view.Items, evt.RecurrenceID.String()),
+			})
+		})
+	case event.RecurringTodoCancelledEvent:
+		return p.withChange(view, e, func(v *dto.TodoListViewDTO) {
+			v.RecurringTodos = slices.DeleteFunc(slices.Clone(view.RecurringTodos), func(recurring dto.RecurringTodoViewDTO) bool {
+				return recurring.ID == evt.RecurrenceID.String()
+			})
+		})
+	case event.CollaboratorInvitedEvent:
+		return p.withChange(view, e, func(v *dto.TodoListViewDTO) {
+			v.Collaborators = dto.WithCollaborator(view.Collaborators, evt.CollaboratorID.String(), evt.Role.String())
+		})
+	case event.CollaboratorRoleChangedEvent:
+		return p.withChange(view, e, func(v *dto.TodoListViewDTO) {
+			v.Collaborators = dto.WithCollaborator(view.Collaborators, evt.CollaboratorID.String(), evt.Role.String())
+		})
+	case event.CollaboratorRemovedEvent:
+		return p.withChange(view, e, func(v *dto.TodoListViewDTO) {
+			v.Collaborators = dto.WithoutCollaborator(view.Collaborators, evt.CollaboratorID.String())
+		})
+	case event.TodoListArchivedEvent:
+		return p.withChange(view, e, func(v *dto.TodoListViewDTO) {
+			v.Archived = true
 		})
+	case event.TodoListRestoredEvent:
+		return p.withChange(view, e, func(v *dto.TodoListViewDTO) {
+			v.Archived = false
+		})
+	case event.CommandUndoneEvent:
+		// The compensating events before it already changed the view.
+		return p.withChange(view, e, func(*dto.TodoListViewDTO) {})
+	case event.TodoListDeletedEvent:
+		// Keep a tombstone so the query can answer 410 rather than 404.
+		return p.withChange(view, e, func(v *dto.TodoListViewDTO) {
+			v.Deleted = true
+			v.Items = []dto.TodoItemViewDTO{}
+		})
+	}
 
-		return &dto.TodoListViewDTO{
-			AggregateID: view.AggregateID,
-			UserID:      view.UserID,
-			Items:       newItems,
-			Version:     evt.GetVersion(),
-			UpdatedAt:   evt.GetTimestamp(),
+	return view
+}
+
+// withChange returns a copy of view with mutate applied and the version and
+// update time taken from e. The stored view is never modified in place.
+func (p *TodoProjectorImpl) withChange(view *dto.TodoListViewDTO, e event.Event, mutate func(*dto.TodoListViewDTO)) *dto.TodoListViewDTO {
+	updated := *view
+	mutate(&updated)
+	updated.Version = e.GetVersion()
+	updated.UpdatedAt = e.GetTimestamp()
+	return &updated
+}
+
+// updateItem returns a copy of items with mutate applied to the todo with the
+// given ID.
+func updateItem(items []dto.TodoItemViewDTO, todoID string, mutate func(*dto.TodoItemViewDTO)) []dto.TodoItemViewDTO {
+	updated := make([]dto.TodoItemViewDTO, len(items))
+	copy(updated, items)
+	for i := range updated {
+		if updated[i].ID == todoID {
+			mutate(&updated[i])
 		}
 	}
+	return updated
+}
 
-	return view
+// updateRecurring returns a copy of recurring with mutate applied to the
+// recurring todo with the given ID.
+func updateRecurring(recurring []dto.RecurringTodoViewDTO, recurrenceID string, mutate func(*dto.RecurringTodoViewDTO)) []dto.RecurringTodoViewDTO {
+	updated := slices.Clone(recurring)
+	for i := range updated {
+		if updated[i].ID == recurrenceID {
+			mutate(&updated[i])
+		}
+	}
+	return updated
+}
+
+// lastOccurrence returns the latest due date among the occurrences of the
+// recurring todo with the given ID.
+func lastOccurrence(items []dto.TodoItemViewDTO, recurrenceID string) string {
+	last := ""
+	for _, item := range items {
+		if item.RecurrenceID == recurrenceID && item.DueDate > last {
+			last = item.DueDate
+		}
+	}
+	return last
+}
+
+// recurrenceIDOf returns the recurring todo evt is an occurrence of, or ""
+// for a todo added by a user.
+func recurrenceIDOf(evt event.TodoAddedEvent) string {
+	if evt.RecurrenceID == uuid.Nil {
+		return ""
+	}
+	return evt.RecurrenceID.String()
+}
+
+// reorderItems returns the items in the order of todoIDs. The aggregate
+// guarantees todoIDs is a permutation of the items.
+func reorderItems(items []dto.TodoItemViewDTO, todoIDs []uuid.UUID) []dto.TodoItemViewDTO {
+	byID := make(map[string]dto.TodoItemViewDTO, len(items))
+	for _, item := range items {
+		byID[item.ID] = item
+	}
+
+	reordered := make([]dto.TodoItemViewDTO, 0, len(items))
+	for _, todoID := range todoIDs {
+		if item, ok := byID[todoID.String()]; ok {
+			reordered = append(reordered, item)
+		}
+	}
+	return reordered
 }
@@ -61,6 +61,26 @@ func TestTodoProjectorImpl_Handle_TodoListCreatedEvent(t *testing.T) {
 				Version:     1,
 			},
 		},
+		"should project title and description": {
+			existingView: nil,
+			event: event.TodoListCreatedEvent{
+				AggregateID: uuid.MustParse("550e8400-e29b-41d4-a716-446655440000"),
+				UserID:      mustNewUserID(t, "user123"),
+				Title:       value.TodoListTitle("Groceries"),
+				Description: value.TodoListDescription("Saturday shopping"),
+				EventID:     uuid.New(),
+				Timestamp:   time.Now(),
+				Version:     1,
+			},
+			want: &dto.TodoListViewDTO{
+				AggregateID: "550e8400-e29b-41d4-a716-446655440000",
+				UserID:      "user123",
+				Title:       "Groceries",
+				Description: "Saturday shopping",
+				Items:       []dto.TodoItemViewDTO{},
+				Version:     1,
+			},
+		},
 	}
 
 	for name, tt := range tests {
@@ -83,6 +103,8 @@ func TestTodoProjectorImpl_Handle_TodoListCreatedEvent(t *testing.T) {
 			require.NotNil(t, saved)
 			require.Equal(t, tt.want.AggregateID, saved.AggregateID)
 			require.Equal(t, tt.want.UserID, saved.UserID)
+			require.Equal(t, tt.want.Title, saved.Title)
+			require.Equal(t, tt.want.Description, saved.Description)
 			require.Equal(t, tt.want.Version, saved.Version)
 			require.Equal(t, len(tt.want.Items), len(saved.Items))
 		})
@@ -154,6 +176,278 @@ func TestTodoProjectorImpl_Handle_TodoAddedEvent(t *testing.T) {
 	}
 }
 
+func TestTodoProjectorImpl_Handle_TodoListRenamedEvent(t *testing.T) {
+	aggregateID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440000")
+
+	tests := map[string]struct {
+		existingView *dto.TodoListViewDTO
+		event        event.TodoListRenamedEvent
+		want         *dto.TodoListViewDTO
+	}{
+		"should replace title and description and keep items": {
+			existingView: &dto.TodoListViewDTO{
+				AggregateID: aggregateID.String(),
+				UserID:      "user123",
+				Title:       "Groceries",
+				Items:       []dto.TodoItemViewDTO{{Text: "Buy milk"}},
+				Version:     2,
+			},
+			event: event.TodoListRenamedEvent{
+				AggregateID: aggregateID,
+				UserID:      mustNewUserID(t, "user123"),
+				Title:       value.TodoListTitle("Weekend groceries"),
+				Description: value.TodoListDescription("Saturday shopping"),
+				EventID:     uuid.New(),
+				Timestamp:   time.Now(),
+				Version:     3,
+			},
+			want: &dto.TodoListViewDTO{
+				AggregateID: aggregateID.String(),
+				UserID:      "user123",
+				Title:       "Weekend groceries",
+				Description: "Saturday shopping",
+				Items:       []dto.TodoItemViewDTO{{Text: "Buy milk"}},
+				Version:     3,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			mockRepo := &mockViewRepository{
+				data: map[string]*dto.TodoListViewDTO{aggregateID.String(): tt.existingView},
+			}
+			projector := todo.NewTodoProjector(mockRepo)
+
+			// Act
+			err := projector.Handle(context.Background(), tt.event)
+
+			// Assert
+			require.NoError(t, err)
+			saved := mockRepo.data[aggregateID.String()]
+			require.Equal(t, tt.want.Title, saved.Title)
+			require.Equal(t, tt.want.Description, saved.Description)
+			require.Equal(t, tt.want.Items, saved.Items)
+			require.Equal(t, tt.want.Version, saved.Version)
+		})
+	}
+}
+
+func TestTodoProjectorImpl_Handle_TodoItemsReorderedEvent(t *testing.T) {
+	// Arrange
+	aggregateID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440000")
+	first, second := uuid.New(), uuid.New()
+	mockRepo := &mockViewRepository{
+		data: map[string]*dto.TodoListViewDTO{aggregateID.String(): {
+			AggregateID: aggregateID.String(),
+			UserID:      "user123",
+			Items: []dto.TodoItemViewDTO{
+				{ID: first.String(), Text: "first", Priority: "medium"},
+				{ID: second.String(), Text: "second", Priority: "high"},
+			},
+			Version: 3,
+		}},
+	}
+	projector := todo.NewTodoProjector(mockRepo)
+
+	// Act
+	err := projector.Handle(context.Background(), event.TodoItemsReorderedEvent{
+		AggregateID: aggregateID,
+		UserID:      mustNewUserID(t, "user123"),
+		TodoIDs:     []uuid.UUID{second, first},
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     4,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	saved := mockRepo.data[aggregateID.String()]
+	require.Equal(t, []dto.TodoItemViewDTO{
+		{ID: second.String(), Text: "second", Priority: "high"},
+		{ID: first.String(), Text: "first", Priority: "medium"},
+	}, saved.Items)
+	require.Equal(t, 4, saved.Version)
+}
+
+func TestTodoProjectorImpl_Handle_Checklist(t *testing.T) {
+	// Arrange
+	aggregateID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440000")
+	todoID, first, second := uuid.New(), uuid.New(), uuid.New()
+	userID := mustNewUserID(t, "user123")
+	mockRepo := &mockViewRepository{
+		data: map[string]*dto.TodoListViewDTO{aggregateID.String(): {
+			AggregateID: aggregateID.String(),
+			UserID:      "user123",
+			Items:       []dto.TodoItemViewDTO{{ID: todoID.String(), Text: "Move house"}},
+			Version:     2,
+		}},
+	}
+	projector := todo.NewTodoProjector(mockRepo)
+	events := []event.Event{
+		event.ChecklistItemAddedEvent{AggregateID: aggregateID, UserID: userID, TodoID: todoID, ChecklistItemID: first, Text: mustNewTodoText(t, "Book van"), EventID: uuid.New(), Timestamp: time.Now(), Version: 3},
+		event.ChecklistItemAddedEvent{AggregateID: aggregateID, UserID: userID, TodoID: todoID, ChecklistItemID: second, Text: mustNewTodoText(t, "Label boxes"), EventID: uuid.New(), Timestamp: time.Now(), Version: 4},
+		event.ChecklistItemCompletedEvent{AggregateID: aggregateID, UserID: userID, TodoID: todoID, ChecklistItemID: first, EventID: uuid.New(), Timestamp: time.Now(), Version: 5},
+		event.ChecklistItemRemovedEvent{AggregateID: aggregateID, UserID: userID, TodoID: todoID, ChecklistItemID: second, EventID: uuid.New(), Timestamp: time.Now(), Version: 6},
+		event.TodoCompletedEvent{AggregateID: aggregateID, UserID: userID, TodoID: todoID, EventID: uuid.New(), Timestamp: time.Now(), Version: 7},
+	}
+
+	// Act
+	for _, e := range events {
+		require.NoError(t, projector.Handle(context.Background(), e))
+	}
+
+	// Assert
+	saved := mockRepo.data[aggregateID.String()]
+	require.Equal(t, []dto.TodoItemViewDTO{{
+		ID:        todoID.String(),
+		Text:      "Move house",
+		Completed: true,
+		Checklist: []dto.ChecklistItemViewDTO{{ID: first.String(), Text: "Book van", Completed: true}},
+	}}, saved.Items)
+	require.Equal(t, 7, saved.Version)
+}
+
+func TestTodoProjectorImpl_Handle_RecurringTodos(t *testing.T) {
+	// Arrange
+	aggregateID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440000")
+	standUp, review, occurrenceID := uuid.New(), uuid.New(), uuid.New()
+	userID := mustNewUserID(t, "user123")
+	mockRepo := &mockViewRepository{
+		data: map[string]*dto.TodoListViewDTO{aggregateID.String(): {
+			AggregateID: aggregateID.String(),
+			UserID:      "user123",
+			Items:       []dto.TodoItemViewDTO{},
+			Version:     1,
+		}},
+	}
+	projector := todo.NewTodoProjector(mockRepo)
+	events := []event.Event{
+		event.RecurringTodoScheduledEvent{AggregateID: aggregateID, UserID: userID, RecurrenceID: standUp, TodoText: mustNewTodoText(t, "Stand-up"), Rule: value.RecurrenceRule("FREQ=DAILY;INTERVAL=1"), StartDate: value.DueDate("2025-03-01"), Priority: value.PriorityHigh, EventID: uuid.New(), Timestamp: time.Now(), Version: 2},
+		event.RecurringTodoScheduledEvent{AggregateID: aggregateID, UserID: userID, RecurrenceID: review, TodoText: mustNewTodoText(t, "Review"), Rule: value.RecurrenceRule("FREQ=WEEKLY;INTERVAL=1;BYDAY=FR"), StartDate: value.DueDate("2025-03-01"), EventID: uuid.New(), Timestamp: time.Now(), Version: 3},
+		event.TodoAddedEvent{AggregateID: aggregateID, UserID: userID, TodoID: occurrenceID, TodoText: mustNewTodoText(t, "Stand-up"), DueDate: value.DueDate("2025-03-31"), Priority: value.PriorityHigh, RecurrenceID: standUp, EventID: uuid.New(), Timestamp: time.Now(), Version: 4},
+		event.RecurringTodoCancelledEvent{AggregateID: aggregateID, UserID: userID, RecurrenceID: review, EventID: uuid.New(), Timestamp: time.Now(), Version: 5},
+	}
+
+	// Act
+	for _, e := range events {
+		require.NoError(t, projector.Handle(context.Background(), e))
+	}
+
+	// Assert
+	saved := mockRepo.data[aggregateID.String()]
+	require.Equal(t, []dto.RecurringTodoViewDTO{{
+		ID:             standUp.String(),
+		Text:           "Stand-up",
+		Rule:           "FREQ=DAILY;INTERVAL=1",
+		StartDate:      "2025-03-01",
+		Priority:       "high",
+		LastOccurrence: "2025-03-31",
+	}}, saved.RecurringTodos)
+	require.Len(t, saved.Items, 1)
+	require.Equal(t, standUp.String(), saved.Items[0].RecurrenceID)
+	require.Equal(t, 5, saved.Version)
+}
+
+func TestTodoProjectorImpl_Handle_CompensatingEvents(t *testing.T) {
+	// Arrange
+	aggregateID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440000")
+	kept, removed, entry := uuid.New(), uuid.New(), uuid.New()
+	userID := mustNewUserID(t, "user123")
+	mockRepo := &mockViewRepository{
+		data: map[string]*dto.TodoListViewDTO{aggregateID.String(): {
+			AggregateID: aggregateID.String(),
+			UserID:      "user123",
+			Items: []dto.TodoItemViewDTO{
+				{ID: kept.String(), Text: "Move house", Checklist: []dto.ChecklistItemViewDTO{{ID: entry.String(), Text: "Book van", Completed: true}}},
+				{ID: removed.String(), Text: "Buy milk"},
+			},
+			Version: 5,
+		}},
+	}
+	projector := todo.NewTodoProjector(mockRepo)
+	events := []event.Event{
+		event.TodoRemovedEvent{AggregateID: aggregateID, UserID: userID, TodoID: removed, EventID: uuid.New(), Timestamp: time.Now(), Version: 6},
+		event.ChecklistItemReopenedEvent{AggregateID: aggregateID, UserID: userID, TodoID: kept, ChecklistItemID: entry, EventID: uuid.New(), Timestamp: time.Now(), Version: 7},
+		event.CommandUndoneEvent{AggregateID: aggregateID, UserID: userID, UndoneFromVersion: 4, UndoneToVersion: 5, EventID: uuid.New(), Timestamp: time.Now(), Version: 8},
+	}
+
+	// Act
+	for _, e := range events {
+		require.NoError(t, projector.Handle(context.Background(), e))
+	}
+
+	// Assert
+	saved := mockRepo.data[aggregateID.String()]
+	require.Equal(t, []dto.TodoItemViewDTO{{
+		ID:        kept.String(),
+		Text:      "Move house",
+		Checklist: []dto.ChecklistItemViewDTO{{ID: entry.String(), Text: "Book van"}},
+	}}, saved.Items)
+	require.Equal(t, 8, saved.Version)
+}
+
+func TestTodoProjectorImpl_Handle_TodoMoved(t *testing.T) {
+	// Arrange
+	sourceID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440000")
+	targetID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440001")
+	todoID, entry := uuid.New(), uuid.New()
+	userID := mustNewUserID(t, "user123")
+	mockRepo := &mockViewRepository{
+		data: map[string]*dto.TodoListViewDTO{
+			sourceID.String(): {
+				AggregateID: sourceID.String(),
+				UserID:      "user123",
+				Items:       []dto.TodoItemViewDTO{{ID: todoID.String(), Text: "Move house"}},
+				Version:     3,
+			},
+			targetID.String(): {
+				AggregateID: targetID.String(),
+				UserID:      "user123",
+				Items:       []dto.TodoItemViewDTO{},
+				Version:     1,
+			},
+		},
+	}
+	projector := todo.NewTodoProjector(mockRepo)
+	events := []event.Event{
+		event.TodoMovedOutEvent{AggregateID: sourceID, UserID: userID, TodoID: todoID, TargetAggregateID: targetID, EventID: uuid.New(), Timestamp: time.Now(), Version: 4},
+		event.TodoMovedInEvent{
+			AggregateID:       targetID,
+			UserID:            userID,
+			TodoID:            todoID,
+			SourceAggregateID: sourceID,
+			TodoText:          mustNewTodoText(t, "Move house"),
+			DueDate:           value.DueDate("2025-03-30"),
+			Priority:          value.PriorityHigh,
+			Tags:              []value.Tag{"home"},
+			Checklist:         []event.MovedChecklistItem{{ID: entry, Text: mustNewTodoText(t, "Book van"), Completed: true}},
+			EventID:           uuid.New(),
+			Timestamp:         time.Now(),
+			Version:           2,
+		},
+	}
+
+	// Act
+	for _, e := range events {
+		require.NoError(t, projector.Handle(context.Background(), e))
+	}
+
+	// Assert
+	require.Empty(t, mockRepo.data[sourceID.String()].Items)
+	require.Equal(t, 4, mockRepo.data[sourceID.String()].Version)
+	require.Equal(t, []dto.TodoItemViewDTO{{
+		ID:        todoID.String(),
+		Text:      "Move house",
+		DueDate:   "2025-03-30",
+		Priority:  "high",
+		Tags:      []string{"home"},
+		Checklist: []dto.ChecklistItemViewDTO{{ID: entry.String(), Text: "Book van", Completed: true}},
+	}}, mockRepo.data[targetID.String()].Items)
+	require.Equal(t, 2, mockRepo.data[targetID.String()].Version)
+}
+
 func mustNewUserID(t *testing.T, id string) value.UserID {
 	t.Helper()
 
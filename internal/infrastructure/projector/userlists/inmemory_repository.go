@@ -0,0 +1,116 @@
+package userlists
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/readmodelstore/dto"
+)
+
+type InMemoryUserTodoListRepository struct {
+	mu   sync.RWMutex
+	data map[string]*dto.UserTodoListDTO
+}
+
+func NewInMemoryUserTodoListRepository() *InMemoryUserTodoListRepository {
+	return &InMemoryUserTodoListRepository{
+		data: make(map[string]*dto.UserTodoListDTO),
+	}
+}
+
+func (r *InMemoryUserTodoListRepository) Get(ctx context.Context, aggregateID string) (*dto.UserTodoListDTO, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	view := r.data[aggregateID]
+	if view == nil {
+		return nil, errors.NotFound.New("todo list not found")
+	}
+
+	return cloneView(view), nil
+}
+
+func (r *InMemoryUserTodoListRepository) Upsert(ctx context.Context, view *dto.UserTodoListDTO) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.data[view.AggregateID] = cloneView(view)
+	return nil
+}
+
+func (r *InMemoryUserTodoListRepository) Delete(ctx context.Context, aggregateID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.data, aggregateID)
+	return nil
+}
+
+func (r *InMemoryUserTodoListRepository) ListByUserID(ctx context.Context, query dto.UserTodoListQuery) (*dto.UserTodoListPage, error) {
+	return r.list(query, func(view *dto.UserTodoListDTO) bool {
+		return view.UserID == query.UserID
+	}), nil
+}
+
+func (r *InMemoryUserTodoListRepository) ListSharedWithUserID(ctx context.Context, query dto.UserTodoListQuery) (*dto.UserTodoListPage, error) {
+	return r.list(query, func(view *dto.UserTodoListDTO) bool {
+		return view.UserID != query.UserID && view.RoleOf(query.UserID) != ""
+	}), nil
+}
+
+func (r *InMemoryUserTodoListRepository) list(query dto.UserTodoListQuery, match func(*dto.UserTodoListDTO) bool) *dto.UserTodoListPage {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	matched := make([]*dto.UserTodoListDTO, 0)
+	for _, view := range r.data {
+		if view.Archived && !query.IncludeArchived {
+			continue
+		}
+		if match(view) {
+			matched = append(matched, cloneView(view))
+		}
+	}
+
+	sort.Slice(matched, func(i, j int) bool {
+		a, b := sortKey(matched[i], query.SortBy), sortKey(matched[j], query.SortBy)
+		if a.Equal(b) {
+			return matched[i].AggregateID < matched[j].AggregateID
+		}
+		if query.Descending {
+			return a.After(b)
+		}
+		return a.Before(b)
+	})
+
+	total := len(matched)
+	start := min(query.Offset, total)
+	end := total
+	if query.Limit > 0 {
+		end = min(start+query.Limit, total)
+	}
+
+	return &dto.UserTodoListPage{
+		TodoLists: matched[start:end],
+		Total:     total,
+	}
+}
+
+func cloneView(view *dto.UserTodoListDTO) *dto.UserTodoListDTO {
+	collaborators := make([]dto.CollaboratorViewDTO, len(view.Collaborators))
+	copy(collaborators, view.Collaborators)
+
+	cloned := *view
+	cloned.Collaborators = collaborators
+	return &cloned
+}
+
+func sortKey(view *dto.UserTodoListDTO, sortBy string) time.Time {
+	if sortBy == dto.SortByUpdatedAt {
+		return view.UpdatedAt
+	}
+	return view.CreatedAt
+}
@@ -0,0 +1,75 @@
+package userlists_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/projector/userlists"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/readmodelstore/dto"
+)
+
+func TestInMemoryUserTodoListRepository_ListByUserID(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	lists := []*dto.UserTodoListDTO{
+		{AggregateID: "list-a", UserID: "user123", CreatedAt: base, UpdatedAt: base.Add(3 * time.Hour)},
+		{AggregateID: "list-b", UserID: "user123", CreatedAt: base.Add(time.Hour), UpdatedAt: base.Add(time.Hour)},
+		{AggregateID: "list-c", UserID: "user123", CreatedAt: base.Add(2 * time.Hour), UpdatedAt: base.Add(2 * time.Hour)},
+		{AggregateID: "list-d", UserID: "other", CreatedAt: base, UpdatedAt: base},
+	}
+
+	tests := map[string]struct {
+		query     dto.UserTodoListQuery
+		wantIDs   []string
+		wantTotal int
+	}{
+		"sorted by creation ascending": {
+			query:     dto.UserTodoListQuery{UserID: "user123", SortBy: dto.SortByCreatedAt},
+			wantIDs:   []string{"list-a", "list-b", "list-c"},
+			wantTotal: 3,
+		},
+		"sorted by update descending": {
+			query:     dto.UserTodoListQuery{UserID: "user123", SortBy: dto.SortByUpdatedAt, Descending: true},
+			wantIDs:   []string{"list-a", "list-c", "list-b"},
+			wantTotal: 3,
+		},
+		"paginated": {
+			query:     dto.UserTodoListQuery{UserID: "user123", SortBy: dto.SortByCreatedAt, Limit: 1, Offset: 1},
+			wantIDs:   []string{"list-b"},
+			wantTotal: 3,
+		},
+		"offset past the end": {
+			query:     dto.UserTodoListQuery{UserID: "user123", SortBy: dto.SortByCreatedAt, Limit: 10, Offset: 10},
+			wantIDs:   []string{},
+			wantTotal: 3,
+		},
+		"unknown user": {
+			query:     dto.UserTodoListQuery{UserID: "nobody"},
+			wantIDs:   []string{},
+			wantTotal: 0,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			repo := userlists.NewInMemoryUserTodoListRepository()
+			for _, l := range lists {
+				require.NoError(t, repo.Upsert(context.Background(), l))
+			}
+
+			// Act
+			page, err := repo.ListByUserID(context.Background(), tt.query)
+
+			// Assert
+			require.NoError(t, err)
+			require.Equal(t, tt.wantTotal, page.Total)
+			gotIDs := make([]string, 0, len(page.TodoLists))
+			for _, l := range page.TodoLists {
+				gotIDs = append(gotIDs, l.AggregateID)
+			}
+			require.Equal(t, tt.wantIDs, gotIDs)
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package userlists
+
+import (
+	"context"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/gateway"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/readmodelstore"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/readmodelstore/dto"
+)
+
+type UserTodoListsProjectorImpl struct {
+	store readmodelstore.UserTodoListStore
+	seen  map[string]struct{}
+}
+
+func NewUserTodoListsProjector(store readmodelstore.UserTodoListStore) gateway.Projector {
+	return &UserTodoListsProjectorImpl{
+		store: store,
+		seen:  make(map[string]struct{}),
+	}
+}
+
+func (p *UserTodoListsProjectorImpl) Handle(ctx context.Context, e event.Event) error {
+	eventID := e.GetEventID().String()
+	if _, ok := p.seen[eventID]; ok {
+		return nil
+	}
+	p.seen[eventID] = struct{}{}
+
+	switch evt := e.(type) {
+	case event.TodoListCreatedEvent:
+		return p.store.Upsert(ctx, &dto.UserTodoListDTO{
+			AggregateID:   evt.AggregateID.String(),
+			UserID:        evt.UserID.String(),
+			Collaborators: []dto.CollaboratorViewDTO{},
+			ItemCount:     0,
+			Version:       evt.Version,
+			CreatedAt:     evt.Timestamp,
+			UpdatedAt:     evt.Timestamp,
+		})
+	case event.TodoListRenamedEvent, event.TodoDueDateSetEvent, event.TodoBecameOverdueEvent,
+		event.TodoPriorityChangedEvent, event.TodoItemsReorderedEvent, event.TodoTaggedEvent, event.TodoUntaggedEvent,
+		event.TodoCompletedEvent, event.TodoReopenedEvent,
+		event.ChecklistItemAddedEvent, event.ChecklistItemCompletedEvent, event.ChecklistItemReopenedEvent, event.ChecklistItemRemovedEvent,
+		event.RecurringTodoScheduledEvent, event.RecurringTodoCancelledEvent, event.CommandUndoneEvent:
+		// These are not part of the summary, but still move the list's
+		// version and update time.
+		return p.update(ctx, e, func(*dto.UserTodoListDTO) {})
+	case event.TodoAddedEvent, event.TodoMovedInEvent:
+		return p.update(ctx, e, func(view *dto.UserTodoListDTO) {
+			view.ItemCount++
+		})
+	case event.TodoRemovedEvent, event.TodoMovedOutEvent:
+		return p.update(ctx, e, func(view *dto.UserTodoListDTO) {
+			view.ItemCount--
+		})
+	case event.CollaboratorInvitedEvent:
+		return p.update(ctx, e, func(view *dto.UserTodoListDTO) {
+			view.Collaborators = dto.WithCollaborator(view.Collaborators, evt.CollaboratorID.String(), evt.Role.String())
+		})
+	case event.CollaboratorRoleChangedEvent:
+		return p.update(ctx, e, func(view *dto.UserTodoListDTO) {
+			view.Collaborators = dto.WithCollaborator(view.Collaborators, evt.CollaboratorID.String(), evt.Role.String())
+		})
+	case event.CollaboratorRemovedEvent:
+		return p.update(ctx, e, func(view *dto.UserTodoListDTO) {
+			view.Collaborators = dto.WithoutCollaborator(view.Collaborators, evt.CollaboratorID.String())
+		})
+	case event.TodoListArchivedEvent:
+		return p.update(ctx, e, func(view *dto.UserTodoListDTO) {
+			view.Archived = true
+		})
+	case event.TodoListRestoredEvent:
+		return p.update(ctx, e, func(view *dto.UserTodoListDTO) {
+			view.Archived = false
+		})
+	case event.TodoListDeletedEvent:
+		return p.store.Delete(ctx, evt.AggregateID.String())
+	default:
+		return nil
+	}
+}
+
+func (p *UserTodoListsProjectorImpl) update(ctx context.Context, e event.Event, mutate func(*dto.UserTodoListDTO)) error {
+	current, err := p.store.Get(ctx, e.GetAggregateID().String())
+	if err != nil {
+		if errors.IsCode(err, errors.NotFound) {
+			return nil
+		}
+		return err
+	}
+
+	mutate(current)
+	current.Version = e.GetVersion()
+	current.UpdatedAt = e.GetTimestamp()
+	return p.store.Upsert(ctx, current)
+}
+
+func (p *UserTodoListsProjectorImpl) Start(ctx context.Context, bus gateway.EventSubscriber) error {
+	bus.Subscribe(p.Handle)
+	return nil
+}
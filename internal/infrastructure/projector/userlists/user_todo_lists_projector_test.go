@@ -0,0 +1,189 @@
+package userlists_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/projector/userlists"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/readmodelstore/dto"
+)
+
+func TestUserTodoListsProjectorImpl_Handle(t *testing.T) {
+	aggregateID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440000")
+	createdAt := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	addedAt := createdAt.Add(time.Hour)
+
+	tests := map[string]struct {
+		events        []event.Event
+		wantItemCount int
+		wantVersion   int
+		wantUpdatedAt time.Time
+	}{
+		"created list has no items": {
+			events: []event.Event{
+				event.TodoListCreatedEvent{AggregateID: aggregateID, UserID: value.UserID("user123"), EventID: uuid.New(), Timestamp: createdAt, Version: 1},
+			},
+			wantItemCount: 0,
+			wantVersion:   1,
+			wantUpdatedAt: createdAt,
+		},
+		"added todo bumps count and update time": {
+			events: []event.Event{
+				event.TodoListCreatedEvent{AggregateID: aggregateID, UserID: value.UserID("user123"), EventID: uuid.New(), Timestamp: createdAt, Version: 1},
+				event.TodoAddedEvent{AggregateID: aggregateID, UserID: value.UserID("user123"), TodoText: value.TodoText("Buy milk"), EventID: uuid.New(), Timestamp: addedAt, Version: 2},
+			},
+			wantItemCount: 1,
+			wantVersion:   2,
+			wantUpdatedAt: addedAt,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			repo := userlists.NewInMemoryUserTodoListRepository()
+			projector := userlists.NewUserTodoListsProjector(repo)
+
+			// Act
+			for _, e := range tt.events {
+				require.NoError(t, projector.Handle(context.Background(), e))
+			}
+
+			// Assert
+			saved, err := repo.Get(context.Background(), aggregateID.String())
+			require.NoError(t, err)
+			require.Equal(t, "user123", saved.UserID)
+			require.Equal(t, tt.wantItemCount, saved.ItemCount)
+			require.Equal(t, tt.wantVersion, saved.Version)
+			require.Equal(t, createdAt, saved.CreatedAt)
+			require.Equal(t, tt.wantUpdatedAt, saved.UpdatedAt)
+		})
+	}
+}
+
+func TestUserTodoListsProjectorImpl_Handle_Collaborators(t *testing.T) {
+	aggregateID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440000")
+	createdAt := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	created := event.TodoListCreatedEvent{AggregateID: aggregateID, UserID: value.UserID("user123"), EventID: uuid.New(), Timestamp: createdAt, Version: 1}
+	invited := event.CollaboratorInvitedEvent{AggregateID: aggregateID, UserID: value.UserID("user123"), CollaboratorID: value.UserID("alice"), Role: value.RoleViewer, EventID: uuid.New(), Timestamp: createdAt, Version: 2}
+
+	tests := map[string]struct {
+		events     []event.Event
+		wantShared int
+		wantRole   string
+	}{
+		"invited collaborator sees the list": {
+			events:     []event.Event{created, invited},
+			wantShared: 1,
+			wantRole:   "viewer",
+		},
+		"role change is reflected": {
+			events: []event.Event{
+				created,
+				invited,
+				event.CollaboratorRoleChangedEvent{AggregateID: aggregateID, UserID: value.UserID("user123"), CollaboratorID: value.UserID("alice"), Role: value.RoleEditor, EventID: uuid.New(), Timestamp: createdAt, Version: 3},
+			},
+			wantShared: 1,
+			wantRole:   "editor",
+		},
+		"removed collaborator no longer sees the list": {
+			events: []event.Event{
+				created,
+				invited,
+				event.CollaboratorRemovedEvent{AggregateID: aggregateID, UserID: value.UserID("user123"), CollaboratorID: value.UserID("alice"), EventID: uuid.New(), Timestamp: createdAt, Version: 3},
+			},
+			wantShared: 0,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+			repo := userlists.NewInMemoryUserTodoListRepository()
+			projector := userlists.NewUserTodoListsProjector(repo)
+
+			// Act
+			for _, e := range tt.events {
+				require.NoError(t, projector.Handle(ctx, e))
+			}
+
+			// Assert
+			page, err := repo.ListSharedWithUserID(ctx, dto.UserTodoListQuery{UserID: "alice", SortBy: dto.SortByCreatedAt, Limit: 10})
+			require.NoError(t, err)
+			require.Equal(t, tt.wantShared, page.Total)
+			require.Len(t, page.TodoLists, tt.wantShared)
+			if tt.wantShared > 0 {
+				require.Equal(t, tt.wantRole, page.TodoLists[0].RoleOf("alice"))
+			}
+
+			owned, err := repo.ListByUserID(ctx, dto.UserTodoListQuery{UserID: "alice", SortBy: dto.SortByCreatedAt, Limit: 10})
+			require.NoError(t, err)
+			require.Zero(t, owned.Total)
+		})
+	}
+}
+
+func TestUserTodoListsProjectorImpl_Handle_Lifecycle(t *testing.T) {
+	aggregateID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440000")
+	createdAt := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	created := event.TodoListCreatedEvent{AggregateID: aggregateID, UserID: value.UserID("user123"), EventID: uuid.New(), Timestamp: createdAt, Version: 1}
+	archived := event.TodoListArchivedEvent{AggregateID: aggregateID, UserID: value.UserID("user123"), EventID: uuid.New(), Timestamp: createdAt, Version: 2}
+
+	tests := map[string]struct {
+		events          []event.Event
+		wantListed      int
+		wantWithArchive int
+	}{
+		"archived list is hidden by default": {
+			events:          []event.Event{created, archived},
+			wantListed:      0,
+			wantWithArchive: 1,
+		},
+		"restored list is listed again": {
+			events: []event.Event{
+				created,
+				archived,
+				event.TodoListRestoredEvent{AggregateID: aggregateID, UserID: value.UserID("user123"), EventID: uuid.New(), Timestamp: createdAt, Version: 3},
+			},
+			wantListed:      1,
+			wantWithArchive: 1,
+		},
+		"deleted list is dropped": {
+			events: []event.Event{
+				created,
+				event.TodoListDeletedEvent{AggregateID: aggregateID, UserID: value.UserID("user123"), EventID: uuid.New(), Timestamp: createdAt, Version: 2},
+			},
+			wantListed:      0,
+			wantWithArchive: 0,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+			repo := userlists.NewInMemoryUserTodoListRepository()
+			projector := userlists.NewUserTodoListsProjector(repo)
+
+			// Act
+			for _, e := range tt.events {
+				require.NoError(t, projector.Handle(ctx, e))
+			}
+
+			// Assert
+			page, err := repo.ListByUserID(ctx, dto.UserTodoListQuery{UserID: "user123", SortBy: dto.SortByCreatedAt, Limit: 10})
+			require.NoError(t, err)
+			require.Equal(t, tt.wantListed, page.Total)
+
+			page, err = repo.ListByUserID(ctx, dto.UserTodoListQuery{UserID: "user123", SortBy: dto.SortByCreatedAt, IncludeArchived: true, Limit: 10})
+			require.NoError(t, err)
+			require.Equal(t, tt.wantWithArchive, page.Total)
+		})
+	}
+}
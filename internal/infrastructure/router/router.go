@@ -2,31 +2,109 @@ package router
 
 import (
 	"github.com/gorilla/mux"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/graphqlserver"
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/handler/command"
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/handler/query"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/openapi"
 )
 
 type Router struct {
-	createCommandHandler *command.TodoListCreateCommandHandler
-	addCommandHandler    *command.TodoAddItemCommandHandler
-	queryHandler         *query.TodoListQueryHandler
+	authMiddleware        mux.MiddlewareFunc
+	validationMiddleware  mux.MiddlewareFunc
+	createCommandHandler  *command.TodoListCreateCommandHandler
+	renameCommandHandler  *command.TodoListRenameCommandHandler
+	lifecycleHandler      *command.TodoListLifecycleCommandHandler
+	undoHandler           *command.TodoListUndoCommandHandler
+	addCommandHandler     *command.TodoAddItemCommandHandler
+	batchHandler          *command.TodoBatchCommandHandler
+	setDueDateHandler     *command.TodoSetDueDateCommandHandler
+	orderingHandler       *command.TodoItemOrderingCommandHandler
+	moveHandler           *command.TodoItemMoveCommandHandler
+	tagHandler            *command.TodoItemTagCommandHandler
+	completionHandler     *command.TodoItemCompletionCommandHandler
+	checklistHandler      *command.TodoChecklistCommandHandler
+	recurringHandler      *command.TodoRecurringCommandHandler
+	collaboratorHandler   *command.TodoListCollaboratorCommandHandler
+	webhookHandler        *command.WebhookSubscribeCommandHandler
+	scheduledHandler      *command.ScheduledCommandHandler
+	queryHandler          *query.TodoListQueryHandler
+	userListsHandler      *query.UserTodoListsQueryHandler
+	userTagsHandler       *query.UserTagsQueryHandler
+	scheduledQueryHandler *query.ScheduledCommandsQueryHandler
+	graphqlHandler        *graphqlserver.Handler
 }
 
-func NewRouter(createCommandHandler *command.TodoListCreateCommandHandler, addCommandHandler *command.TodoAddItemCommandHandler, queryHandler *query.TodoListQueryHandler) *Router {
+func NewRouter(authMiddleware mux.MiddlewareFunc, validationMiddleware mux.MiddlewareFunc, createCommandHandler *command.TodoListCreateCommandHandler, renameCommandHandler *command.TodoListRenameCommandHandler, lifecycleHandler *command.TodoListLifecycleCommandHandler, undoHandler *command.TodoListUndoCommandHandler, addCommandHandler *command.TodoAddItemCommandHandler, batchHandler *command.TodoBatchCommandHandler, setDueDateHandler *command.TodoSetDueDateCommandHandler, orderingHandler *command.TodoItemOrderingCommandHandler, moveHandler *command.TodoItemMoveCommandHandler, tagHandler *command.TodoItemTagCommandHandler, completionHandler *command.TodoItemCompletionCommandHandler, checklistHandler *command.TodoChecklistCommandHandler, recurringHandler *command.TodoRecurringCommandHandler, collaboratorHandler *command.TodoListCollaboratorCommandHandler, webhookHandler *command.WebhookSubscribeCommandHandler, scheduledHandler *command.ScheduledCommandHandler, queryHandler *query.TodoListQueryHandler, userListsHandler *query.UserTodoListsQueryHandler, userTagsHandler *query.UserTagsQueryHandler, scheduledQueryHandler *query.ScheduledCommandsQueryHandler, graphqlHandler *graphqlserver.Handler) *Router {
 	return &Router{
-		createCommandHandler: createCommandHandler,
-		addCommandHandler:    addCommandHandler,
-		queryHandler:         queryHandler,
+		authMiddleware:        authMiddleware,
+		validationMiddleware:  validationMiddleware,
+		createCommandHandler:  createCommandHandler,
+		renameCommandHandler:  renameCommandHandler,
+		lifecycleHandler:      lifecycleHandler,
+		undoHandler:           undoHandler,
+		addCommandHandler:     addCommandHandler,
+		batchHandler:          batchHandler,
+		setDueDateHandler:     setDueDateHandler,
+		orderingHandler:       orderingHandler,
+		moveHandler:           moveHandler,
+		tagHandler:            tagHandler,
+		completionHandler:     completionHandler,
+		checklistHandler:      checklistHandler,
+		recurringHandler:      recurringHandler,
+		collaboratorHandler:   collaboratorHandler,
+		webhookHandler:        webhookHandler,
+		scheduledHandler:      scheduledHandler,
+		queryHandler:          queryHandler,
+		userListsHandler:      userListsHandler,
+		userTagsHandler:       userTagsHandler,
+		scheduledQueryHandler: scheduledQueryHandler,
+		graphqlHandler:        graphqlHandler,
 	}
 }
 
 func (r *Router) SetupRoutes() *mux.Router {
-	router := mux.NewRouter()
+	root := mux.NewRouter()
+	// The document describes the API to clients that have no token yet.
+	root.HandleFunc("/openapi.json", openapi.ServeSpec).Methods("GET")
+
+	router := root.NewRoute().Subrouter()
+	router.Use(r.authMiddleware, r.validationMiddleware, command.IdempotencyKeyMiddleware)
 
 	router.HandleFunc("/todo-lists", r.createCommandHandler.CreateTodoList).Methods("POST")
+	router.HandleFunc("/todo-lists/{aggregate_id}", r.renameCommandHandler.RenameTodoList).Methods("PATCH")
+	router.HandleFunc("/todo-lists/{aggregate_id}", r.lifecycleHandler.Delete).Methods("DELETE")
+	router.HandleFunc("/todo-lists/{aggregate_id}/archive", r.lifecycleHandler.Archive).Methods("POST")
+	router.HandleFunc("/todo-lists/{aggregate_id}/restore", r.lifecycleHandler.Restore).Methods("POST")
+	router.HandleFunc("/todo-lists/{aggregate_id}/undo", r.undoHandler.Undo).Methods("POST")
 	router.HandleFunc("/todo-lists/{aggregate_id}/items", r.addCommandHandler.AddTodo).Methods("POST")
+	router.HandleFunc("/todo-lists/{aggregate_id}/items/{todo_id}/due-date", r.setDueDateHandler.SetDueDate).Methods("PUT")
+	router.HandleFunc("/todo-lists/{aggregate_id}/items/{todo_id}/priority", r.orderingHandler.SetPriority).Methods("PUT")
+	router.HandleFunc("/todo-lists/{aggregate_id}/items/order", r.orderingHandler.Reorder).Methods("PUT")
+	router.HandleFunc("/todo-lists/{aggregate_id}/items/{todo_id}/move", r.moveHandler.Move).Methods("POST")
+	router.HandleFunc("/todo-lists/{aggregate_id}/items/{todo_id}/tags", r.tagHandler.Tag).Methods("POST")
+	router.HandleFunc("/todo-lists/{aggregate_id}/items/{todo_id}/tags/{tag}", r.tagHandler.Untag).Methods("DELETE")
+	router.HandleFunc("/todo-lists/{aggregate_id}/items/{todo_id}/complete", r.completionHandler.Complete).Methods("POST")
+	router.HandleFunc("/todo-lists/{aggregate_id}/items/{todo_id}/reopen", r.completionHandler.Reopen).Methods("POST")
+	router.HandleFunc("/todo-lists/{aggregate_id}/items/{todo_id}/checklist", r.checklistHandler.Add).Methods("POST")
+	router.HandleFunc("/todo-lists/{aggregate_id}/items/{todo_id}/checklist/{checklist_id}/complete", r.checklistHandler.Complete).Methods("POST")
+	router.HandleFunc("/todo-lists/{aggregate_id}/items/{todo_id}/checklist/{checklist_id}", r.checklistHandler.Remove).Methods("DELETE")
+	router.HandleFunc("/todo-lists/{aggregate_id}/recurring-todos", r.recurringHandler.Schedule).Methods("POST")
+	router.HandleFunc("/todo-lists/{aggregate_id}/recurring-todos/{recurrence_id}", r.recurringHandler.Cancel).Methods("DELETE")
+	router.HandleFunc("/todo-lists/{aggregate_id}/collaborators", r.collaboratorHandler.Invite).Methods("POST")
+	router.HandleFunc("/todo-lists/{aggregate_id}/collaborators/{user_id}", r.collaboratorHandler.ChangeRole).Methods("PUT")
+	router.HandleFunc("/todo-lists/{aggregate_id}/collaborators/{user_id}", r.collaboratorHandler.Remove).Methods("DELETE")
+	router.HandleFunc("/commands:batch", r.batchHandler.Batch).Methods("POST")
+	router.HandleFunc("/webhooks", r.webhookHandler.Subscribe).Methods("POST")
+	router.HandleFunc("/scheduled-commands", r.scheduledHandler.Schedule).Methods("POST")
+	router.HandleFunc("/scheduled-commands/{scheduled_command_id}", r.scheduledHandler.Cancel).Methods("DELETE")
 
 	router.HandleFunc("/todo-lists/{aggregate_id}/items", r.queryHandler.Query).Methods("GET")
+	router.HandleFunc("/users/{user_id}/todo-lists", r.userListsHandler.Query).Methods("GET")
+	router.HandleFunc("/shared-todo-lists", r.userListsHandler.QueryShared).Methods("GET")
+	router.HandleFunc("/users/{user_id}/tags", r.userTagsHandler.Query).Methods("GET")
+	router.HandleFunc("/scheduled-commands", r.scheduledQueryHandler.Query).Methods("GET")
+
+	router.HandleFunc("/graphql", r.graphqlHandler.Serve).Methods("POST")
 
-	return router
+	return root
 }
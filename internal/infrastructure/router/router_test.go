@@ -0,0 +1,118 @@
+package router_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/openapi"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/router"
+)
+
+type operation struct {
+	path   string
+	method string
+}
+
+// routes lists the operations the router serves. Walking the routes does not
+// call the handlers, so a router without them will do.
+func routes(t *testing.T) map[operation]bool {
+	t.Helper()
+
+	served := map[operation]bool{}
+	err := (&router.Router{}).SetupRoutes().Walk(func(route *mux.Route, _ *mux.Router, _ []*mux.Route) error {
+		// The authenticated subrouter only groups the routes below it.
+		if route.GetHandler() == nil {
+			return nil
+		}
+		path, err := route.GetPathTemplate()
+		if err != nil {
+			return err
+		}
+		methods, err := route.GetMethods()
+		if err != nil {
+			return err
+		}
+		for _, method := range methods {
+			served[operation{path: path, method: method}] = true
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	return served
+}
+
+func TestSetupRoutes_EveryRouteIsInTheOpenAPIDocument(t *testing.T) {
+	// Arrange
+	doc, err := openapi.Load(context.Background())
+	require.NoError(t, err)
+
+	// Act
+	served := routes(t)
+
+	// Assert
+	require.NotEmpty(t, served)
+	for op := range served {
+		pathItem := doc.Paths.Value(op.path)
+		require.NotNil(t, pathItem, "%s %s has no path in openapi.json", op.method, op.path)
+		require.NotNil(t, pathItem.GetOperation(op.method), "%s %s has no operation in openapi.json", op.method, op.path)
+	}
+}
+
+func TestSetupRoutes_EveryOpenAPIOperationIsRouted(t *testing.T) {
+	// Arrange
+	doc, err := openapi.Load(context.Background())
+	require.NoError(t, err)
+
+	// Act
+	served := routes(t)
+
+	// Assert
+	for path, pathItem := range doc.Paths.Map() {
+		for method := range pathItem.Operations() {
+			require.True(t, served[operation{path: path, method: method}], "%s %s in openapi.json has no route", method, path)
+		}
+	}
+}
+
+func TestSetupRoutes_OpenAPIDocumentNeedsNoToken(t *testing.T) {
+	tests := map[string]struct {
+		method     string
+		target     string
+		wantStatus int
+	}{
+		"serves the document without a token": {
+			method:     http.MethodGet,
+			target:     "/openapi.json",
+			wantStatus: http.StatusOK,
+		},
+		"authenticates every other route": {
+			method:     http.MethodGet,
+			target:     "/shared-todo-lists",
+			wantStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			rejectAll := func(http.Handler) http.Handler {
+				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusUnauthorized)
+				})
+			}
+			passThrough := func(next http.Handler) http.Handler { return next }
+			routes := router.NewRouter(rejectAll, passThrough, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil).SetupRoutes()
+			rec := httptest.NewRecorder()
+
+			// Act
+			routes.ServeHTTP(rec, httptest.NewRequest(tt.method, tt.target, nil))
+
+			// Assert
+			require.Equal(t, tt.wantStatus, rec.Code)
+		})
+	}
+}
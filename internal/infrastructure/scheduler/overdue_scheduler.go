@@ -0,0 +1,59 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/config"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/readmodelstore"
+)
+
+// OverdueScheduler periodically looks for todos past their due date and asks
+// their lists to record TodoBecameOverdueEvent, which then reaches projectors
+// and webhooks through the event bus like any other event.
+type OverdueScheduler struct {
+	finder      readmodelstore.OverdueTodoFinder
+	markOverdue command.TodoMarkOverdueCommandInterface
+	interval    time.Duration
+}
+
+func NewOverdueScheduler(finder readmodelstore.OverdueTodoFinder, markOverdue command.TodoMarkOverdueCommandInterface, cfg config.SchedulerConfig) *OverdueScheduler {
+	return &OverdueScheduler{
+		finder:      finder,
+		markOverdue: markOverdue,
+		interval:    cfg.OverdueCheckInterval,
+	}
+}
+
+// Start runs a check immediately and then every interval until ctx is done.
+func (s *OverdueScheduler) Start(ctx context.Context) {
+	go runEvery(ctx, s.interval, func(ctx context.Context) error {
+		return s.RunOnce(ctx, time.Now())
+	}, "overdue check")
+}
+
+// RunOnce checks every candidate list as of now. A failure on one list does
+// not stop the others; all failures are returned together.
+func (s *OverdueScheduler) RunOnce(ctx context.Context, now time.Time) error {
+	ids, err := s.finder.ListIDsWithOverdueTodos(ctx, value.DueDateOf(now).String())
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, id := range ids {
+		err := s.markOverdue.Execute(ctx, &input.MarkOverdueTodosInput{
+			AggregateID: id,
+			Now:         now,
+		})
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
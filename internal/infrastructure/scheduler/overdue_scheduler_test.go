@@ -0,0 +1,96 @@
+package scheduler_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/config"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/projector/todo"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/scheduler"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/readmodelstore/dto"
+)
+
+type recordingMarkOverdue struct {
+	calls []*input.MarkOverdueTodosInput
+	err   error
+}
+
+func (r *recordingMarkOverdue) Execute(ctx context.Context, in *input.MarkOverdueTodosInput) error {
+	r.calls = append(r.calls, in)
+	return r.err
+}
+
+func TestOverdueScheduler_RunOnce(t *testing.T) {
+	now := time.Date(2025, 3, 31, 9, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		views     []*dto.TodoListViewDTO
+		markErr   error
+		wantCalls []string
+		wantErr   bool
+	}{
+		"checks lists with unreported past due todos": {
+			views: []*dto.TodoListViewDTO{
+				{AggregateID: "list-a", Items: []dto.TodoItemViewDTO{{ID: "1", DueDate: "2025-03-30"}}},
+				{AggregateID: "list-b", Items: []dto.TodoItemViewDTO{{ID: "2", DueDate: "2025-03-31"}}},
+				{AggregateID: "list-c", Items: []dto.TodoItemViewDTO{{ID: "3"}}},
+			},
+			wantCalls: []string{"list-a"},
+		},
+		"skips todos already reported": {
+			views: []*dto.TodoListViewDTO{
+				{AggregateID: "list-a", Items: []dto.TodoItemViewDTO{{ID: "1", DueDate: "2025-03-30", Overdue: true}}},
+			},
+			wantCalls: []string{},
+		},
+		"skips archived and deleted lists": {
+			views: []*dto.TodoListViewDTO{
+				{AggregateID: "list-a", Archived: true, Items: []dto.TodoItemViewDTO{{ID: "1", DueDate: "2025-03-30"}}},
+				{AggregateID: "list-b", Deleted: true, Items: []dto.TodoItemViewDTO{{ID: "2", DueDate: "2025-03-30"}}},
+			},
+			wantCalls: []string{},
+		},
+		"keeps going after a failure": {
+			views: []*dto.TodoListViewDTO{
+				{AggregateID: "list-a", Items: []dto.TodoItemViewDTO{{ID: "1", DueDate: "2025-03-30"}}},
+				{AggregateID: "list-b", Items: []dto.TodoItemViewDTO{{ID: "2", DueDate: "2025-03-29"}}},
+			},
+			markErr:   errors.New("boom"),
+			wantCalls: []string{"list-a", "list-b"},
+			wantErr:   true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+			repo := todo.NewInMemoryTodoListViewRepository()
+			for _, v := range tt.views {
+				require.NoError(t, repo.Upsert(ctx, v.AggregateID, v))
+			}
+			markOverdue := &recordingMarkOverdue{err: tt.markErr}
+			s := scheduler.NewOverdueScheduler(repo, markOverdue, config.SchedulerConfig{OverdueCheckInterval: time.Minute})
+
+			// Act
+			err := s.RunOnce(ctx, now)
+
+			// Assert
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			got := make([]string, 0, len(markOverdue.calls))
+			for _, call := range markOverdue.calls {
+				require.Equal(t, now, call.Now)
+				got = append(got, call.AggregateID)
+			}
+			require.Equal(t, tt.wantCalls, got)
+		})
+	}
+}
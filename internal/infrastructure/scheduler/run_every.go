@@ -0,0 +1,26 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// runEvery runs check immediately and then every interval until ctx is done,
+// logging its failures under name.
+func runEvery(ctx context.Context, interval time.Duration, check func(context.Context) error, name string) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := check(ctx); err != nil {
+			log.Printf("%s failed: %v", name, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
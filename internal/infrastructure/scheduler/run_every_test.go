@@ -0,0 +1,55 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunEvery(t *testing.T) {
+	tests := map[string]struct {
+		failures  int
+		wantCalls int
+	}{
+		"checks until ctx is done": {
+			wantCalls: 3,
+		},
+		"keeps checking after a failure": {
+			failures:  2,
+			wantCalls: 3,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			calls := 0
+			// A tick may win over the cancellation once; that check sees ctx
+			// done and is not counted.
+			check := func(ctx context.Context) error {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				calls++
+				if calls == tt.wantCalls {
+					cancel()
+				}
+				if calls <= tt.failures {
+					return errors.New("store unavailable")
+				}
+				return nil
+			}
+
+			// Act
+			runEvery(ctx, time.Millisecond, check, "test check")
+
+			// Assert
+			require.Equal(t, tt.wantCalls, calls)
+		})
+	}
+}
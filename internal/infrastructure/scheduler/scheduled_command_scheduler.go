@@ -0,0 +1,28 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/config"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command"
+)
+
+// ScheduledCommandScheduler periodically runs the scheduled commands that
+// came due.
+type ScheduledCommandScheduler struct {
+	runner   command.ScheduledCommandRunnerInterface
+	interval time.Duration
+}
+
+func NewScheduledCommandScheduler(runner command.ScheduledCommandRunnerInterface, cfg config.SchedulerConfig) *ScheduledCommandScheduler {
+	return &ScheduledCommandScheduler{
+		runner:   runner,
+		interval: cfg.ScheduledCommandCheckInterval,
+	}
+}
+
+// Start runs a check immediately and then every interval until ctx is done.
+func (s *ScheduledCommandScheduler) Start(ctx context.Context) {
+	go runEvery(ctx, s.interval, s.runner.RunDue, "scheduled command check")
+}
@@ -0,0 +1,30 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/config"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/processmanager"
+)
+
+// WakeUpScheduler periodically hands the process manager the wake-ups that
+// came due and has it retry the deliveries that failed.
+type WakeUpScheduler struct {
+	manager  *processmanager.Manager
+	interval time.Duration
+}
+
+func NewWakeUpScheduler(manager *processmanager.Manager, cfg config.SchedulerConfig) *WakeUpScheduler {
+	return &WakeUpScheduler{
+		manager:  manager,
+		interval: cfg.WakeUpCheckInterval,
+	}
+}
+
+// Start runs a check immediately and then every interval until ctx is done.
+func (s *WakeUpScheduler) Start(ctx context.Context) {
+	go runEvery(ctx, s.interval, func(ctx context.Context) error {
+		return s.manager.RunOnce(ctx, time.Now())
+	}, "wake-up check")
+}
@@ -0,0 +1,36 @@
+package view
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/presenter/viewmodel"
+)
+
+// GraphQLCommandResultView keeps a command result for a GraphQL resolver.
+type GraphQLCommandResultView struct {
+	vm  *viewmodel.CommandResultViewModel
+	err error
+}
+
+func NewGraphQLCommandResultView() *GraphQLCommandResultView {
+	return &GraphQLCommandResultView{}
+}
+
+var _ presenter.CommandView = (*GraphQLCommandResultView)(nil)
+
+func (v *GraphQLCommandResultView) Render(ctx context.Context, vm *viewmodel.CommandResultViewModel, status int, err error) error {
+	if status >= http.StatusBadRequest {
+		v.err = newGraphQLError(status, err)
+		return nil
+	}
+	v.vm = vm
+	return nil
+}
+
+// Result returns the rendered view model, or the error to resolve the field
+// with.
+func (v *GraphQLCommandResultView) Result() (*viewmodel.CommandResultViewModel, error) {
+	return v.vm, v.err
+}
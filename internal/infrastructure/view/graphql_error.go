@@ -0,0 +1,56 @@
+package view
+
+import (
+	"errors"
+	"net/http"
+)
+
+// graphQLError is a resolver error carrying the HTTP status the presenter
+// chose as an extension code, so that GraphQL clients can tell failures
+// apart as HTTP clients do.
+type graphQLError struct {
+	err    error
+	status int
+}
+
+func newGraphQLError(status int, err error) error {
+	if err == nil {
+		err = errors.New(http.StatusText(status))
+	}
+	return &graphQLError{err: err, status: status}
+}
+
+func (e *graphQLError) Error() string {
+	return e.err.Error()
+}
+
+func (e *graphQLError) Unwrap() error {
+	return e.err
+}
+
+func (e *graphQLError) Extensions() map[string]any {
+	return map[string]any{
+		"code":   graphQLErrorCode(e.status),
+		"status": e.status,
+	}
+}
+
+func graphQLErrorCode(status int) string {
+	switch status {
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return "BAD_USER_INPUT"
+	case http.StatusUnauthorized:
+		return "UNAUTHENTICATED"
+	case http.StatusForbidden:
+		return "FORBIDDEN"
+	case http.StatusNotFound:
+		return "NOT_FOUND"
+	case http.StatusConflict:
+		return "CONFLICT"
+	case http.StatusGone:
+		return "GONE"
+	case http.StatusServiceUnavailable:
+		return "UNAVAILABLE"
+	}
+	return "INTERNAL_SERVER_ERROR"
+}
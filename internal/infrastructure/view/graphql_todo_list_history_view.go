@@ -0,0 +1,36 @@
+package view
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/presenter/viewmodel"
+)
+
+// GraphQLTodoListHistoryView keeps a todo list's history for a GraphQL resolver.
+type GraphQLTodoListHistoryView struct {
+	vm  *viewmodel.TodoListHistoryVM
+	err error
+}
+
+func NewGraphQLTodoListHistoryView() *GraphQLTodoListHistoryView {
+	return &GraphQLTodoListHistoryView{}
+}
+
+var _ presenter.TodoListHistoryView = (*GraphQLTodoListHistoryView)(nil)
+
+func (v *GraphQLTodoListHistoryView) Render(ctx context.Context, vm *viewmodel.TodoListHistoryVM, status int, err error) error {
+	if status >= http.StatusBadRequest {
+		v.err = newGraphQLError(status, err)
+		return nil
+	}
+	v.vm = vm
+	return nil
+}
+
+// Result returns the rendered view model, or the error to resolve the field
+// with.
+func (v *GraphQLTodoListHistoryView) Result() (*viewmodel.TodoListHistoryVM, error) {
+	return v.vm, v.err
+}
@@ -0,0 +1,41 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/presenter/viewmodel"
+)
+
+var errTodoListStale = errors.New("todo list has not reached the requested version yet")
+
+// GraphQLTodoListView keeps a rendered todo list for a GraphQL resolver.
+type GraphQLTodoListView struct {
+	vm  *viewmodel.TodoListVM
+	err error
+}
+
+func NewGraphQLTodoListView() *GraphQLTodoListView {
+	return &GraphQLTodoListView{}
+}
+
+var _ presenter.TodoListView = (*GraphQLTodoListView)(nil)
+
+func (v *GraphQLTodoListView) Render(ctx context.Context, vm *viewmodel.TodoListVM, status int, err error) error {
+	if status == http.StatusServiceUnavailable && err == nil {
+		err = errTodoListStale
+	}
+	if status >= http.StatusBadRequest {
+		v.err = newGraphQLError(status, err)
+		return nil
+	}
+	v.vm = vm
+	return nil
+}
+
+// Result returns the list, or the error to resolve the field with.
+func (v *GraphQLTodoListView) Result() (*viewmodel.TodoListVM, error) {
+	return v.vm, v.err
+}
@@ -0,0 +1,36 @@
+package view
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/presenter/viewmodel"
+)
+
+// GraphQLUserTodoListsView keeps a user's todo lists for a GraphQL resolver.
+type GraphQLUserTodoListsView struct {
+	vm  *viewmodel.UserTodoListsVM
+	err error
+}
+
+func NewGraphQLUserTodoListsView() *GraphQLUserTodoListsView {
+	return &GraphQLUserTodoListsView{}
+}
+
+var _ presenter.UserTodoListsView = (*GraphQLUserTodoListsView)(nil)
+
+func (v *GraphQLUserTodoListsView) Render(ctx context.Context, vm *viewmodel.UserTodoListsVM, status int, err error) error {
+	if status >= http.StatusBadRequest {
+		v.err = newGraphQLError(status, err)
+		return nil
+	}
+	v.vm = vm
+	return nil
+}
+
+// Result returns the rendered view model, or the error to resolve the field
+// with.
+func (v *GraphQLUserTodoListsView) Result() (*viewmodel.UserTodoListsVM, error) {
+	return v.vm, v.err
+}
@@ -0,0 +1,34 @@
+package view
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/presenter/viewmodel"
+)
+
+type HTTPBatchCommandResultView struct {
+	writer http.ResponseWriter
+}
+
+func NewHTTPBatchCommandResultView(w http.ResponseWriter) *HTTPBatchCommandResultView {
+	return &HTTPBatchCommandResultView{
+		writer: w,
+	}
+}
+
+func (v *HTTPBatchCommandResultView) Render(ctx context.Context, vms []viewmodel.CommandResultViewModel, status int, err error) error {
+	v.writer.Header().Set("Content-Type", "application/json")
+	v.writer.WriteHeader(status)
+
+	if err != nil {
+		errorResponse := map[string]any{
+			"status":  "error",
+			"message": err.Error(),
+		}
+		return json.NewEncoder(v.writer).Encode(errorResponse)
+	}
+
+	return json.NewEncoder(v.writer).Encode(vms)
+}
@@ -0,0 +1,33 @@
+package view
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/presenter/viewmodel"
+)
+
+type HTTPUserTodoListsView struct {
+	writer http.ResponseWriter
+}
+
+func NewHTTPUserTodoListsView(w http.ResponseWriter) presenter.UserTodoListsView {
+	return &HTTPUserTodoListsView{writer: w}
+}
+
+func (v *HTTPUserTodoListsView) Render(ctx context.Context, vm *viewmodel.UserTodoListsVM, status int, err error) error {
+	v.writer.Header().Set("Content-Type", "application/json")
+	v.writer.WriteHeader(status)
+
+	if err != nil {
+		errorResponse := map[string]any{
+			"status":  "error",
+			"message": err.Error(),
+		}
+		return json.NewEncoder(v.writer).Encode(errorResponse)
+	}
+
+	return json.NewEncoder(v.writer).Encode(vm)
+}
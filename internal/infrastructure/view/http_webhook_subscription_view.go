@@ -0,0 +1,33 @@
+package view
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/presenter/viewmodel"
+)
+
+type HTTPWebhookSubscriptionView struct {
+	writer http.ResponseWriter
+}
+
+func NewHTTPWebhookSubscriptionView(w http.ResponseWriter) presenter.WebhookSubscriptionView {
+	return &HTTPWebhookSubscriptionView{writer: w}
+}
+
+func (v *HTTPWebhookSubscriptionView) Render(ctx context.Context, vm *viewmodel.WebhookSubscriptionVM, status int, err error) error {
+	v.writer.Header().Set("Content-Type", "application/json")
+	v.writer.WriteHeader(status)
+
+	if err != nil {
+		errorResponse := map[string]any{
+			"status":  "error",
+			"message": err.Error(),
+		}
+		return json.NewEncoder(v.writer).Encode(errorResponse)
+	}
+
+	return json.NewEncoder(v.writer).Encode(vm)
+}
@@ -0,0 +1,225 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/config"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/gateway"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/readmodelstore"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/webhookstore"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/webhookstore/dto"
+)
+
+const (
+	SignatureHeader = "X-Webhook-Signature"
+	EventTypeHeader = "X-Webhook-Event"
+	DeliveryHeader  = "X-Webhook-Delivery"
+)
+
+type payload struct {
+	EventID     string      `json:"event_id"`
+	EventType   string      `json:"event_type"`
+	AggregateID string      `json:"aggregate_id"`
+	Version     int         `json:"version"`
+	OccurredAt  string      `json:"occurred_at"`
+	Data        event.Event `json:"data"`
+}
+
+type WebhookDispatcherImpl struct {
+	subscriptions webhookstore.WebhookSubscriptionStore
+	deliveries    webhookstore.WebhookDeliveryLog
+	todoLists     readmodelstore.TodoListStore
+	client        *http.Client
+	maxAttempts   int
+	retryBackoff  time.Duration
+	wg            sync.WaitGroup
+}
+
+func NewWebhookDispatcher(
+	subscriptions webhookstore.WebhookSubscriptionStore,
+	deliveries webhookstore.WebhookDeliveryLog,
+	todoLists readmodelstore.TodoListStore,
+	cfg config.WebhookConfig,
+) gateway.WebhookDispatcher {
+	maxAttempts := cfg.MaxAttempts
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+
+	return &WebhookDispatcherImpl{
+		subscriptions: subscriptions,
+		deliveries:    deliveries,
+		todoLists:     todoLists,
+		client:        newClient(cfg),
+		maxAttempts:   maxAttempts,
+		retryBackoff:  cfg.RetryBackoff,
+	}
+}
+
+// Handle resolves the owner of the event's todo list and delivers the event
+// to every matching subscription in the background, so slow receivers never
+// hold up the command that produced the event.
+func (d *WebhookDispatcherImpl) Handle(ctx context.Context, e event.Event) error {
+	aggID := e.GetAggregateID().String()
+
+	view, err := d.todoLists.Get(ctx, aggID)
+	if err != nil {
+		if errors.IsCode(err, errors.NotFound) {
+			return nil
+		}
+		return err
+	}
+
+	subscriptions, err := d.subscriptions.FindByUserID(ctx, view.UserID)
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(payload{
+		EventID:     e.GetEventID().String(),
+		EventType:   e.GetEventType(),
+		AggregateID: aggID,
+		Version:     e.GetVersion(),
+		OccurredAt:  e.GetTimestamp().Format(time.RFC3339),
+		Data:        e,
+	})
+	if err != nil {
+		return err
+	}
+
+	deliveryCtx := context.WithoutCancel(ctx)
+	for _, sub := range subscriptions {
+		if !sub.Matches(aggID, e.GetEventType()) {
+			continue
+		}
+
+		d.wg.Add(1)
+		go func(sub *dto.WebhookSubscriptionDTO) {
+			defer d.wg.Done()
+			d.deliver(deliveryCtx, sub, e, body)
+		}(sub)
+	}
+
+	return nil
+}
+
+// newClient returns the client deliveries are posted with. Unless private
+// targets are allowed, it refuses to connect to addresses that are not
+// public. The check runs on the address actually dialed, so a name that
+// resolves to an internal address, or is rebound to one after the
+// subscription was made, is refused too. Deliveries never go through a
+// proxy, which would hide that address.
+func newClient(cfg config.WebhookConfig) *http.Client {
+	dialer := &net.Dialer{}
+	if !cfg.AllowPrivateTargets {
+		dialer.Control = publicAddressOnly
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{Timeout: cfg.Timeout, Transport: transport}
+}
+
+func publicAddressOnly(network, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return err
+	}
+	if !value.IsPublicAddr(addrPort.Addr()) {
+		return fmt.Errorf("webhook target %s is not a public address", addrPort.Addr())
+	}
+	return nil
+}
+
+func (d *WebhookDispatcherImpl) Start(ctx context.Context, bus gateway.EventSubscriber) error {
+	bus.Subscribe(d.Handle)
+	return nil
+}
+
+// Wait blocks until every in-flight delivery has finished or given up.
+func (d *WebhookDispatcherImpl) Wait() {
+	d.wg.Wait()
+}
+
+func (d *WebhookDispatcherImpl) deliver(ctx context.Context, sub *dto.WebhookSubscriptionDTO, e event.Event, body []byte) {
+	deliveryID := uuid.New().String()
+	backoff := d.retryBackoff
+
+	for attempt := 1; attempt <= d.maxAttempts; attempt++ {
+		statusCode, err := d.post(ctx, sub, e, deliveryID, body)
+
+		record := &dto.WebhookDeliveryDTO{
+			ID:             deliveryID,
+			SubscriptionID: sub.ID,
+			EventID:        e.GetEventID().String(),
+			EventType:      e.GetEventType(),
+			Attempt:        attempt,
+			StatusCode:     statusCode,
+			Succeeded:      err == nil,
+			AttemptedAt:    time.Now(),
+		}
+		if err != nil {
+			record.Error = err.Error()
+		}
+		_ = d.deliveries.Append(ctx, record)
+
+		if err == nil || attempt == d.maxAttempts {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+func (d *WebhookDispatcherImpl) post(ctx context.Context, sub *dto.WebhookSubscriptionDTO, e event.Event, deliveryID string, body []byte) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.TargetURL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventTypeHeader, e.GetEventType())
+	req.Header.Set(DeliveryHeader, deliveryID)
+	req.Header.Set(SignatureHeader, Sign(sub.Secret, body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// Sign returns the signature header value for body, an HMAC-SHA256 keyed
+// with the subscription secret. Receivers recompute it to verify a delivery.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,175 @@
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/config"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/projector/todo"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/webhook"
+	readmodeldto "github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/readmodelstore/dto"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/webhookstore/dto"
+)
+
+const testSecret = "0123456789abcdef"
+
+type receivedRequest struct {
+	header http.Header
+	body   []byte
+}
+
+type receiver struct {
+	mu       sync.Mutex
+	requests []receivedRequest
+	failures int
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.requests = append(r.requests, receivedRequest{header: req.Header.Clone(), body: body})
+
+	if len(r.requests) <= r.failures {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func TestWebhookDispatcherImpl_Handle(t *testing.T) {
+	aggregateID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440000")
+
+	tests := map[string]struct {
+		subscriptionAggID string
+		eventTypes        []string
+		failures          int
+		blockPrivate      bool
+		wantRequests      int
+		wantSucceeded     bool
+	}{
+		"delivers to user subscription": {
+			wantRequests:  1,
+			wantSucceeded: true,
+		},
+		"delivers to list subscription with matching event type": {
+			subscriptionAggID: aggregateID.String(),
+			eventTypes:        []string{"TodoAddedEvent"},
+			wantRequests:      1,
+			wantSucceeded:     true,
+		},
+		"skips subscription for other event types": {
+			eventTypes:   []string{"TodoListCreatedEvent"},
+			wantRequests: 0,
+		},
+		"skips subscription for other lists": {
+			subscriptionAggID: uuid.New().String(),
+			wantRequests:      0,
+		},
+		"retries until receiver succeeds": {
+			failures:      2,
+			wantRequests:  3,
+			wantSucceeded: true,
+		},
+		"gives up after max attempts": {
+			failures:      5,
+			wantRequests:  3,
+			wantSucceeded: false,
+		},
+		"refuses to connect to a non-public address": {
+			blockPrivate: true,
+			wantRequests: 0,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			recv := &receiver{failures: tt.failures}
+			server := httptest.NewServer(recv)
+			defer server.Close()
+
+			ctx := context.Background()
+			todoLists := todo.NewInMemoryTodoListViewRepository()
+			require.NoError(t, todoLists.Upsert(ctx, aggregateID.String(), &readmodeldto.TodoListViewDTO{
+				AggregateID: aggregateID.String(),
+				UserID:      "user123",
+			}))
+
+			subscriptions := webhook.NewInMemoryWebhookSubscriptionRepository()
+			sub := &dto.WebhookSubscriptionDTO{
+				ID:          uuid.New().String(),
+				UserID:      "user123",
+				AggregateID: tt.subscriptionAggID,
+				TargetURL:   server.URL,
+				EventTypes:  tt.eventTypes,
+				Secret:      testSecret,
+			}
+			require.NoError(t, subscriptions.Save(ctx, sub))
+
+			deliveries := webhook.NewInMemoryWebhookDeliveryLog()
+			dispatcher := webhook.NewWebhookDispatcher(subscriptions, deliveries, todoLists, config.WebhookConfig{
+				MaxAttempts:  3,
+				RetryBackoff: time.Millisecond,
+				Timeout:      time.Second,
+				// The receiver listens on the loopback interface.
+				AllowPrivateTargets: !tt.blockPrivate,
+			}).(*webhook.WebhookDispatcherImpl)
+
+			evt := event.TodoAddedEvent{
+				AggregateID: aggregateID,
+				UserID:      value.UserID("user123"),
+				TodoText:    value.TodoText("Buy groceries"),
+				EventID:     uuid.New(),
+				Timestamp:   time.Now(),
+				Version:     2,
+			}
+
+			// Act
+			err := dispatcher.Handle(ctx, evt)
+			dispatcher.Wait()
+
+			// Assert
+			require.NoError(t, err)
+			require.Len(t, recv.requests, tt.wantRequests)
+
+			log, err := deliveries.ListBySubscriptionID(ctx, sub.ID)
+			require.NoError(t, err)
+			if tt.blockPrivate {
+				require.Len(t, log, 3)
+				for _, attempt := range log {
+					require.False(t, attempt.Succeeded)
+					require.Contains(t, attempt.Error, "not a public address")
+				}
+				return
+			}
+			require.Len(t, log, tt.wantRequests)
+			if tt.wantRequests == 0 {
+				return
+			}
+
+			last := recv.requests[len(recv.requests)-1]
+			require.Equal(t, webhook.Sign(testSecret, last.body), last.header.Get(webhook.SignatureHeader))
+			require.Equal(t, "TodoAddedEvent", last.header.Get(webhook.EventTypeHeader))
+
+			var body map[string]any
+			require.NoError(t, json.Unmarshal(last.body, &body))
+			require.Equal(t, evt.EventID.String(), body["event_id"])
+			require.Equal(t, aggregateID.String(), body["aggregate_id"])
+
+			require.Equal(t, tt.wantSucceeded, log[len(log)-1].Succeeded)
+			require.Equal(t, tt.wantRequests, log[len(log)-1].Attempt)
+		})
+	}
+}
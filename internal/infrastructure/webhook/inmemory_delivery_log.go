@@ -0,0 +1,49 @@
+package webhook
+
+import (
+	"context"
+	"sync"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/webhookstore/dto"
+)
+
+// maxDeliveriesPerSubscription is how many of the latest attempts the
+// in-memory log keeps for each subscription.
+const maxDeliveriesPerSubscription = 100
+
+// InMemoryWebhookDeliveryLog keeps the latest delivery attempts of each
+// subscription; older ones are dropped.
+type InMemoryWebhookDeliveryLog struct {
+	mu         sync.RWMutex
+	deliveries map[string][]dto.WebhookDeliveryDTO
+}
+
+func NewInMemoryWebhookDeliveryLog() *InMemoryWebhookDeliveryLog {
+	return &InMemoryWebhookDeliveryLog{
+		deliveries: make(map[string][]dto.WebhookDeliveryDTO),
+	}
+}
+
+func (l *InMemoryWebhookDeliveryLog) Append(ctx context.Context, delivery *dto.WebhookDeliveryDTO) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	deliveries := append(l.deliveries[delivery.SubscriptionID], *delivery)
+	if len(deliveries) > maxDeliveriesPerSubscription {
+		deliveries = append([]dto.WebhookDeliveryDTO(nil), deliveries[len(deliveries)-maxDeliveriesPerSubscription:]...)
+	}
+	l.deliveries[delivery.SubscriptionID] = deliveries
+	return nil
+}
+
+func (l *InMemoryWebhookDeliveryLog) ListBySubscriptionID(ctx context.Context, subscriptionID string) ([]*dto.WebhookDeliveryDTO, error) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	deliveries := make([]*dto.WebhookDeliveryDTO, 0, len(l.deliveries[subscriptionID]))
+	for _, d := range l.deliveries[subscriptionID] {
+		delivery := d
+		deliveries = append(deliveries, &delivery)
+	}
+	return deliveries, nil
+}
@@ -0,0 +1,32 @@
+package webhook_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/webhook"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/webhookstore/dto"
+)
+
+func TestInMemoryWebhookDeliveryLog_KeepsTheLatestAttempts(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	log := webhook.NewInMemoryWebhookDeliveryLog()
+	for attempt := 1; attempt <= 150; attempt++ {
+		require.NoError(t, log.Append(ctx, &dto.WebhookDeliveryDTO{SubscriptionID: "busy", Attempt: attempt}))
+	}
+	require.NoError(t, log.Append(ctx, &dto.WebhookDeliveryDTO{SubscriptionID: "quiet", Attempt: 1}))
+
+	// Act
+	busy, err := log.ListBySubscriptionID(ctx, "busy")
+	require.NoError(t, err)
+	quiet, err := log.ListBySubscriptionID(ctx, "quiet")
+	require.NoError(t, err)
+
+	// Assert
+	require.Len(t, busy, 100)
+	require.Equal(t, 51, busy[0].Attempt)
+	require.Equal(t, 150, busy[99].Attempt)
+	require.Len(t, quiet, 1)
+}
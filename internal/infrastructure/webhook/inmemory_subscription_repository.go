@@ -0,0 +1,49 @@
+package webhook
+
+import (
+	"context"
+	"sync"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/webhookstore/dto"
+)
+
+type InMemoryWebhookSubscriptionRepository struct {
+	mu   sync.RWMutex
+	data map[string]*dto.WebhookSubscriptionDTO
+}
+
+func NewInMemoryWebhookSubscriptionRepository() *InMemoryWebhookSubscriptionRepository {
+	return &InMemoryWebhookSubscriptionRepository{
+		data: make(map[string]*dto.WebhookSubscriptionDTO),
+	}
+}
+
+func (r *InMemoryWebhookSubscriptionRepository) Save(ctx context.Context, subscription *dto.WebhookSubscriptionDTO) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.data[subscription.ID] = cloneSubscription(subscription)
+	return nil
+}
+
+func (r *InMemoryWebhookSubscriptionRepository) FindByUserID(ctx context.Context, userID string) ([]*dto.WebhookSubscriptionDTO, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	subscriptions := make([]*dto.WebhookSubscriptionDTO, 0)
+	for _, sub := range r.data {
+		if sub.UserID == userID {
+			subscriptions = append(subscriptions, cloneSubscription(sub))
+		}
+	}
+	return subscriptions, nil
+}
+
+func cloneSubscription(sub *dto.WebhookSubscriptionDTO) *dto.WebhookSubscriptionDTO {
+	eventTypes := make([]string, len(sub.EventTypes))
+	copy(eventTypes, sub.EventTypes)
+
+	cloned := *sub
+	cloned.EventTypes = eventTypes
+	return &cloned
+}
@@ -0,0 +1,8 @@
+package input
+
+type AddChecklistItemInput struct {
+	AggregateID string
+	UserID      string
+	TodoID      string
+	Text        string
+}
@@ -4,4 +4,11 @@ type AddTodoInput struct {
 	AggregateID string
 	UserID      string
 	Todo        string
+	// DueDate is an optional YYYY-MM-DD date.
+	DueDate string
+	// Priority is low, medium or high; it defaults to medium.
+	Priority string
+	// RecurrenceID is set when the todo is an occurrence of a recurring
+	// todo; it is left empty for todos added by users.
+	RecurrenceID string
 }
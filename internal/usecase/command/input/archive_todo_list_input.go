@@ -0,0 +1,6 @@
+package input
+
+type ArchiveTodoListInput struct {
+	AggregateID string
+	UserID      string
+}
@@ -0,0 +1,30 @@
+package input
+
+const (
+	BatchCommandCreateTodoList = "create_todo_list"
+	BatchCommandAddTodo        = "add_todo"
+)
+
+type BatchCommandsInput struct {
+	UserID string
+	// Atomic runs every command in one transaction, so that either all of
+	// them are stored or none is.
+	Atomic   bool
+	Commands []BatchCommandInput
+}
+
+// BatchCommandInput is one command of a batch. Type is create_todo_list or
+// add_todo; the other fields are those of CreateTodoListInput and
+// AddTodoInput.
+type BatchCommandInput struct {
+	Type string
+	// AggregateID is the list add_todo adds to. ListIndex may name the index
+	// of a create_todo_list earlier in the batch instead.
+	AggregateID string
+	ListIndex   *int
+	Title       string
+	Description string
+	Todo        string
+	DueDate     string
+	Priority    string
+}
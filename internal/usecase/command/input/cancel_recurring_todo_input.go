@@ -0,0 +1,7 @@
+package input
+
+type CancelRecurringTodoInput struct {
+	AggregateID  string
+	UserID       string
+	RecurrenceID string
+}
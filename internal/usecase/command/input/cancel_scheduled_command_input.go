@@ -0,0 +1,6 @@
+package input
+
+type CancelScheduledCommandInput struct {
+	UserID             string
+	ScheduledCommandID string
+}
@@ -0,0 +1,8 @@
+package input
+
+type ChangeCollaboratorRoleInput struct {
+	AggregateID    string
+	UserID         string
+	CollaboratorID string
+	Role           string
+}
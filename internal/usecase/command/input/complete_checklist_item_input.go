@@ -0,0 +1,8 @@
+package input
+
+type CompleteChecklistItemInput struct {
+	AggregateID     string
+	UserID          string
+	TodoID          string
+	ChecklistItemID string
+}
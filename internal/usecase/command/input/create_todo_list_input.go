@@ -1,5 +1,7 @@
 package input
 
 type CreateTodoListInput struct {
-	UserID string
+	UserID      string
+	Title       string
+	Description string
 }
@@ -0,0 +1,6 @@
+package input
+
+type DeleteTodoListInput struct {
+	AggregateID string
+	UserID      string
+}
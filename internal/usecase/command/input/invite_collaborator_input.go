@@ -0,0 +1,8 @@
+package input
+
+type InviteCollaboratorInput struct {
+	AggregateID    string
+	UserID         string
+	CollaboratorID string
+	Role           string
+}
@@ -0,0 +1,8 @@
+package input
+
+import "time"
+
+type MarkOverdueTodosInput struct {
+	AggregateID string
+	Now         time.Time
+}
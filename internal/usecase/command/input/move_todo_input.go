@@ -0,0 +1,8 @@
+package input
+
+type MoveTodoInput struct {
+	AggregateID       string
+	UserID            string
+	TodoID            string
+	TargetAggregateID string
+}
@@ -0,0 +1,8 @@
+package input
+
+type RemoveChecklistItemInput struct {
+	AggregateID     string
+	UserID          string
+	TodoID          string
+	ChecklistItemID string
+}
@@ -0,0 +1,7 @@
+package input
+
+type RemoveCollaboratorInput struct {
+	AggregateID    string
+	UserID         string
+	CollaboratorID string
+}
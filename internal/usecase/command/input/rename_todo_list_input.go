@@ -0,0 +1,10 @@
+package input
+
+// RenameTodoListInput carries the fields of a partial update; a nil field is
+// left unchanged.
+type RenameTodoListInput struct {
+	AggregateID string
+	UserID      string
+	Title       *string
+	Description *string
+}
@@ -0,0 +1,8 @@
+package input
+
+type ReorderTodoItemsInput struct {
+	AggregateID string
+	UserID      string
+	// TodoIDs is the new order and must name every todo on the list once.
+	TodoIDs []string
+}
@@ -0,0 +1,6 @@
+package input
+
+type RestoreTodoListInput struct {
+	AggregateID string
+	UserID      string
+}
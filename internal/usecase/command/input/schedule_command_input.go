@@ -0,0 +1,15 @@
+package input
+
+// ScheduleCommandInput schedules a create_todo_list or add_todo command, with
+// the fields of BatchCommandInput, to run at RunAt, an RFC 3339 time.
+type ScheduleCommandInput struct {
+	UserID      string
+	Type        string
+	AggregateID string
+	Title       string
+	Description string
+	Todo        string
+	DueDate     string
+	Priority    string
+	RunAt       string
+}
@@ -0,0 +1,14 @@
+package input
+
+type ScheduleRecurringTodoInput struct {
+	AggregateID string
+	UserID      string
+	Todo        string
+	// Rule is daily, weekly, monthly or an RRULE such as
+	// "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR".
+	Rule string
+	// StartDate is an optional YYYY-MM-DD date; it defaults to today.
+	StartDate string
+	// Priority is low, medium or high; it defaults to medium.
+	Priority string
+}
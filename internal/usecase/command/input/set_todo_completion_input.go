@@ -0,0 +1,8 @@
+package input
+
+type SetTodoCompletionInput struct {
+	AggregateID string
+	UserID      string
+	TodoID      string
+	Completed   bool
+}
@@ -0,0 +1,9 @@
+package input
+
+type SetTodoDueDateInput struct {
+	AggregateID string
+	UserID      string
+	TodoID      string
+	// DueDate is a YYYY-MM-DD date; an empty value clears the due date.
+	DueDate string
+}
@@ -0,0 +1,8 @@
+package input
+
+type SetTodoPriorityInput struct {
+	AggregateID string
+	UserID      string
+	TodoID      string
+	Priority    string
+}
@@ -0,0 +1,9 @@
+package input
+
+type SubscribeWebhookInput struct {
+	UserID      string
+	AggregateID string
+	TargetURL   string
+	EventTypes  []string
+	Secret      string
+}
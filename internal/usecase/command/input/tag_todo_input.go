@@ -0,0 +1,8 @@
+package input
+
+type TagTodoInput struct {
+	AggregateID string
+	UserID      string
+	TodoID      string
+	Tag         string
+}
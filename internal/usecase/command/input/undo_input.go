@@ -0,0 +1,6 @@
+package input
+
+type UndoInput struct {
+	AggregateID string
+	UserID      string
+}
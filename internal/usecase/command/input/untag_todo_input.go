@@ -0,0 +1,8 @@
+package input
+
+type UntagTodoInput struct {
+	AggregateID string
+	UserID      string
+	TodoID      string
+	Tag         string
+}
@@ -0,0 +1,12 @@
+package output
+
+import "github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+
+// BatchCommandOutput is the outcome of one command of a batch: the aggregate
+// it changed, or the error it failed with.
+type BatchCommandOutput struct {
+	AggregateID string
+	Version     int
+	Events      []event.Event
+	Err         error
+}
@@ -0,0 +1,22 @@
+package output
+
+import "time"
+
+type ScheduledCommandOutput struct {
+	ID                string
+	UserID            string
+	Type              string
+	AggregateID       string
+	Title             string
+	Description       string
+	Todo              string
+	DueDate           string
+	Priority          string
+	RunAt             time.Time
+	Status            string
+	Attempts          int
+	LastError         string
+	ResultAggregateID string
+	ResultVersion     int
+	CreatedAt         time.Time
+}
@@ -0,0 +1,12 @@
+package output
+
+import "time"
+
+type SubscribeWebhookOutput struct {
+	SubscriptionID string
+	UserID         string
+	AggregateID    string
+	TargetURL      string
+	EventTypes     []string
+	CreatedAt      time.Time
+}
@@ -0,0 +1,66 @@
+package command
+
+import (
+	"context"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/gateway"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/schedulestore"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/schedulestore/dto"
+)
+
+var (
+	ErrScheduledCommandForeign    = errors.Forbidden.New("cannot cancel another user's scheduled command")
+	ErrScheduledCommandNotPending = errors.InvalidParameter.New("only pending scheduled commands can be cancelled")
+)
+
+type ScheduledCommandCancelCommandInterface interface {
+	Execute(ctx context.Context, input *input.CancelScheduledCommandInput, out presenter.ScheduledCommandPresenter) error
+}
+
+type ScheduledCommandCancelCommand struct {
+	store schedulestore.ScheduledCommandStore
+	tx    repository.Transaction
+	clock gateway.Clock
+}
+
+func NewScheduledCommandCancelCommand(store schedulestore.ScheduledCommandStore, tx repository.Transaction, clock gateway.Clock) ScheduledCommandCancelCommandInterface {
+	return &ScheduledCommandCancelCommand{
+		store: store,
+		tx:    tx,
+		clock: clock,
+	}
+}
+
+func (u *ScheduledCommandCancelCommand) Execute(ctx context.Context, input *input.CancelScheduledCommandInput, out presenter.ScheduledCommandPresenter) error {
+	var cmd *dto.ScheduledCommandDTO
+	err := u.tx.RWTx(ctx, func(ctx context.Context) error {
+		var err error
+		cmd, err = u.store.Get(ctx, input.ScheduledCommandID)
+		if err != nil {
+			return err
+		}
+		if cmd.UserID != input.UserID {
+			return ErrScheduledCommandForeign
+		}
+		if cmd.Status != dto.ScheduledCommandPending {
+			return ErrScheduledCommandNotPending
+		}
+
+		cmd.Status = dto.ScheduledCommandCancelled
+		cmd.UpdatedAt = u.clock.Now()
+		return u.store.Update(ctx, cmd, dto.ScheduledCommandPending)
+	})
+	if err != nil {
+		// The runner picked the command up since it was read.
+		if errors.IsCode(err, errors.OptimisticLock) {
+			err = ErrScheduledCommandNotPending
+		}
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentCancelled(ctx, scheduledCommandOutput(cmd))
+}
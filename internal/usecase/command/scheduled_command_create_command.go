@@ -0,0 +1,141 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/output"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/gateway"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/schedulestore"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/schedulestore/dto"
+)
+
+var ErrScheduledCommandInPast = errors.InvalidParameter.New("run_at must be in the future")
+
+type ScheduledCommandCreateCommandInterface interface {
+	Execute(ctx context.Context, input *input.ScheduleCommandInput, out presenter.ScheduledCommandPresenter) error
+}
+
+type ScheduledCommandCreateCommand struct {
+	store schedulestore.ScheduledCommandStore
+	tx    repository.Transaction
+	clock gateway.Clock
+}
+
+func NewScheduledCommandCreateCommand(store schedulestore.ScheduledCommandStore, tx repository.Transaction, clock gateway.Clock) ScheduledCommandCreateCommandInterface {
+	return &ScheduledCommandCreateCommand{
+		store: store,
+		tx:    tx,
+		clock: clock,
+	}
+}
+
+func (u *ScheduledCommandCreateCommand) Execute(ctx context.Context, input *input.ScheduleCommandInput, out presenter.ScheduledCommandPresenter) error {
+	cmd, err := u.newScheduledCommand(input)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	err = u.tx.RWTx(ctx, func(ctx context.Context) error {
+		return u.store.Save(ctx, cmd)
+	})
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentScheduled(ctx, scheduledCommandOutput(cmd))
+}
+
+// newScheduledCommand checks the command as far as it can be checked before
+// it runs; whether the list accepts it is only known then.
+func (u *ScheduledCommandCreateCommand) newScheduledCommand(in *input.ScheduleCommandInput) (*dto.ScheduledCommandDTO, error) {
+	userID, err := value.NewUserID(in.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := u.clock.Now()
+	runAt, err := time.Parse(time.RFC3339, in.RunAt)
+	if err != nil {
+		return nil, errors.InvalidParameter.Wrap(err, "run_at must be an RFC 3339 time")
+	}
+	if !runAt.After(now) {
+		return nil, ErrScheduledCommandInPast
+	}
+
+	cmd := &dto.ScheduledCommandDTO{
+		ID:        uuid.New().String(),
+		UserID:    userID.String(),
+		Type:      in.Type,
+		RunAt:     runAt.UTC(),
+		Status:    dto.ScheduledCommandPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	switch in.Type {
+	case input.BatchCommandCreateTodoList:
+		if in.Title != "" {
+			if _, err := value.NewTodoListTitle(in.Title); err != nil {
+				return nil, err
+			}
+		}
+		if _, err := value.NewTodoListDescription(in.Description); err != nil {
+			return nil, err
+		}
+		cmd.Title = in.Title
+		cmd.Description = in.Description
+	case input.BatchCommandAddTodo:
+		aggregateUUID, err := uuid.Parse(in.AggregateID)
+		if err != nil {
+			return nil, errors.InvalidParameter.Wrap(err, "aggregate_id must be a valid UUID")
+		}
+		if _, err := value.NewTodoText(in.Todo); err != nil {
+			return nil, err
+		}
+		if in.DueDate != "" {
+			if _, err := value.NewDueDate(in.DueDate); err != nil {
+				return nil, err
+			}
+		}
+		if _, err := value.NewPriority(in.Priority); err != nil {
+			return nil, err
+		}
+		cmd.AggregateID = aggregateUUID.String()
+		cmd.Todo = in.Todo
+		cmd.DueDate = in.DueDate
+		cmd.Priority = in.Priority
+	default:
+		return nil, errors.InvalidParameter.New(fmt.Sprintf("unknown command type %q", in.Type))
+	}
+
+	return cmd, nil
+}
+
+func scheduledCommandOutput(cmd *dto.ScheduledCommandDTO) *output.ScheduledCommandOutput {
+	return &output.ScheduledCommandOutput{
+		ID:                cmd.ID,
+		UserID:            cmd.UserID,
+		Type:              cmd.Type,
+		AggregateID:       cmd.AggregateID,
+		Title:             cmd.Title,
+		Description:       cmd.Description,
+		Todo:              cmd.Todo,
+		DueDate:           cmd.DueDate,
+		Priority:          cmd.Priority,
+		RunAt:             cmd.RunAt,
+		Status:            cmd.Status,
+		Attempts:          cmd.Attempts,
+		LastError:         cmd.LastError,
+		ResultAggregateID: cmd.ResultAggregateID,
+		ResultVersion:     cmd.ResultVersion,
+		CreatedAt:         cmd.CreatedAt,
+	}
+}
@@ -0,0 +1,143 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/commandbus"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/gateway"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/schedulestore"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/schedulestore/dto"
+)
+
+const (
+	// scheduledCommandLease is how long a run may take before the command
+	// counts as interrupted and is run again.
+	scheduledCommandLease = 5 * time.Minute
+	// scheduledCommandBatchSize caps the commands run by one RunDue.
+	scheduledCommandBatchSize = 100
+)
+
+type ScheduledCommandRunnerInterface interface {
+	RunDue(ctx context.Context) error
+}
+
+// ScheduledCommandRunner runs the scheduled commands that came due through
+// the use cases that run them on request. A command that failed for a reason
+// a later run may not hit again is retried until maxAttempts runs failed;
+// one the list rejected fails at once.
+type ScheduledCommandRunner struct {
+	store         schedulestore.ScheduledCommandStore
+	tx            repository.Transaction
+	clock         gateway.Clock
+	createCommand TodoListCreateCommandInterface
+	addCommand    TodoAddItemCommandInterface
+	maxAttempts   int
+}
+
+func NewScheduledCommandRunner(store schedulestore.ScheduledCommandStore, tx repository.Transaction, clock gateway.Clock, createCommand TodoListCreateCommandInterface, addCommand TodoAddItemCommandInterface, maxAttempts int) ScheduledCommandRunnerInterface {
+	return &ScheduledCommandRunner{
+		store:         store,
+		tx:            tx,
+		clock:         clock,
+		createCommand: createCommand,
+		addCommand:    addCommand,
+		maxAttempts:   maxAttempts,
+	}
+}
+
+// RunDue runs the commands due now. A failure to record the outcome of one
+// command does not stop the others; the first such failure is returned.
+func (u *ScheduledCommandRunner) RunDue(ctx context.Context) error {
+	now := u.clock.Now()
+
+	var due []*dto.ScheduledCommandDTO
+	err := u.tx.RWTx(ctx, func(ctx context.Context) error {
+		var err error
+		due, err = u.store.ListDue(ctx, now, now.Add(-scheduledCommandLease), scheduledCommandBatchSize)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	var firstErr error
+	for _, cmd := range due {
+		if err := u.run(ctx, cmd); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+func (u *ScheduledCommandRunner) run(ctx context.Context, cmd *dto.ScheduledCommandDTO) error {
+	from := cmd.Status
+	cmd.Status = dto.ScheduledCommandRunning
+	cmd.UpdatedAt = u.clock.Now()
+	err := u.tx.RWTx(ctx, func(ctx context.Context) error {
+		return u.store.Update(ctx, cmd, from)
+	})
+	if err != nil {
+		// It was cancelled or picked up by another runner meanwhile.
+		if errors.IsCode(err, errors.OptimisticLock) {
+			return nil
+		}
+		return err
+	}
+
+	// The key makes a run repeated after an interruption replay the first
+	// one's result instead of running the command twice.
+	runCtx := commandbus.WithIdempotencyKey(ctx, cmd.UserID, "scheduled-command/"+cmd.ID)
+	result := runListCommand(runCtx, u.createCommand, u.addCommand, cmd.UserID, input.BatchCommandInput{
+		Type:        cmd.Type,
+		AggregateID: cmd.AggregateID,
+		Title:       cmd.Title,
+		Description: cmd.Description,
+		Todo:        cmd.Todo,
+		DueDate:     cmd.DueDate,
+		Priority:    cmd.Priority,
+	})
+
+	switch {
+	case result.Err == nil:
+		cmd.Status = dto.ScheduledCommandSucceeded
+		cmd.LastError = ""
+		cmd.ResultAggregateID = result.AggregateID
+		cmd.ResultVersion = result.Version
+	case isTransient(result.Err) && cmd.Attempts+1 < u.maxAttempts:
+		cmd.Status = dto.ScheduledCommandPending
+		cmd.Attempts++
+		cmd.LastError = result.Err.Error()
+	default:
+		cmd.Status = dto.ScheduledCommandFailed
+		cmd.Attempts++
+		cmd.LastError = result.Err.Error()
+	}
+	cmd.UpdatedAt = u.clock.Now()
+
+	return u.tx.RWTx(ctx, func(ctx context.Context) error {
+		return u.store.Update(ctx, cmd, dto.ScheduledCommandRunning)
+	})
+}
+
+// isTransient reports whether err may not happen again on a later run, as
+// opposed to the command being invalid or rejected by its list.
+func isTransient(err error) bool {
+	for _, code := range []errors.ErrCode{
+		errors.InvalidParameter,
+		errors.UnpermittedOp,
+		errors.Forbidden,
+		errors.Unauthenticated,
+		errors.NotFound,
+		errors.Archived,
+		errors.Deleted,
+	} {
+		if errors.IsCode(err, code) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,322 @@
+package command_test
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/output"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/schedulestore/dto"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+type memoryScheduledCommandStore struct {
+	cmds map[string]dto.ScheduledCommandDTO
+}
+
+func newMemoryScheduledCommandStore() *memoryScheduledCommandStore {
+	return &memoryScheduledCommandStore{cmds: map[string]dto.ScheduledCommandDTO{}}
+}
+
+func (s *memoryScheduledCommandStore) Save(ctx context.Context, cmd *dto.ScheduledCommandDTO) error {
+	s.cmds[cmd.ID] = *cmd
+	return nil
+}
+
+func (s *memoryScheduledCommandStore) Update(ctx context.Context, cmd *dto.ScheduledCommandDTO, from string) error {
+	if s.cmds[cmd.ID].Status != from {
+		return errors.OptimisticLock.New("status changed")
+	}
+	s.cmds[cmd.ID] = *cmd
+	return nil
+}
+
+func (s *memoryScheduledCommandStore) Get(ctx context.Context, id string) (*dto.ScheduledCommandDTO, error) {
+	cmd, ok := s.cmds[id]
+	if !ok {
+		return nil, errors.NotFound.New("scheduled command not found")
+	}
+	return &cmd, nil
+}
+
+func (s *memoryScheduledCommandStore) ListByUserID(ctx context.Context, userID string) ([]*dto.ScheduledCommandDTO, error) {
+	var cmds []*dto.ScheduledCommandDTO
+	for _, cmd := range s.cmds {
+		if cmd.UserID == userID {
+			cmds = append(cmds, &cmd)
+		}
+	}
+	return cmds, nil
+}
+
+func (s *memoryScheduledCommandStore) ListDue(ctx context.Context, now, staleBefore time.Time, limit int) ([]*dto.ScheduledCommandDTO, error) {
+	var cmds []*dto.ScheduledCommandDTO
+	for _, cmd := range s.cmds {
+		pendingDue := cmd.Status == dto.ScheduledCommandPending && !cmd.RunAt.After(now)
+		stale := cmd.Status == dto.ScheduledCommandRunning && cmd.UpdatedAt.Before(staleBefore)
+		if pendingDue || stale {
+			cmds = append(cmds, &cmd)
+		}
+	}
+	sort.Slice(cmds, func(i, j int) bool { return cmds[i].RunAt.Before(cmds[j].RunAt) })
+	if len(cmds) > limit {
+		cmds = cmds[:limit]
+	}
+	return cmds, nil
+}
+
+// failingAddTodo fails every run with err.
+type failingAddTodo struct {
+	err  error
+	runs int
+}
+
+func (f *failingAddTodo) Execute(ctx context.Context, in *input.AddTodoInput, out presenter.CommandResultPresenter) error {
+	f.runs++
+	return out.PresentError(ctx, f.err)
+}
+
+type recordingScheduledCommandPresenter struct {
+	out *output.ScheduledCommandOutput
+	err error
+}
+
+func (p *recordingScheduledCommandPresenter) PresentScheduled(ctx context.Context, out *output.ScheduledCommandOutput) error {
+	p.out = out
+	return nil
+}
+
+func (p *recordingScheduledCommandPresenter) PresentCancelled(ctx context.Context, out *output.ScheduledCommandOutput) error {
+	p.out = out
+	return nil
+}
+
+func (p *recordingScheduledCommandPresenter) PresentError(ctx context.Context, err error) error {
+	p.err = err
+	return nil
+}
+
+var scheduledNow = time.Date(2025, 12, 15, 9, 0, 0, 0, time.UTC)
+
+func TestScheduledCommandCreateCommand_Execute(t *testing.T) {
+	userID := uuid.New().String()
+	listID := uuid.New().String()
+
+	tests := map[string]struct {
+		in       input.ScheduleCommandInput
+		wantErr  string
+		wantCode errors.ErrCode
+	}{
+		"schedules a todo to be added later": {
+			in: input.ScheduleCommandInput{Type: input.BatchCommandAddTodo, AggregateID: listID, Todo: "Pay rent", RunAt: "2025-12-31T09:00:00Z"},
+		},
+		"schedules a list to be created later": {
+			in: input.ScheduleCommandInput{Type: input.BatchCommandCreateTodoList, Title: "January", RunAt: "2026-01-01T00:00:00+09:00"},
+		},
+		"rejects a run time in the past": {
+			in:       input.ScheduleCommandInput{Type: input.BatchCommandAddTodo, AggregateID: listID, Todo: "Pay rent", RunAt: "2025-12-15T08:59:59Z"},
+			wantErr:  command.ErrScheduledCommandInPast.Error(),
+			wantCode: errors.InvalidParameter,
+		},
+		"rejects a malformed run time": {
+			in:       input.ScheduleCommandInput{Type: input.BatchCommandAddTodo, AggregateID: listID, Todo: "Pay rent", RunAt: "tomorrow"},
+			wantCode: errors.InvalidParameter,
+		},
+		"rejects a todo that could never be added": {
+			in:       input.ScheduleCommandInput{Type: input.BatchCommandAddTodo, AggregateID: listID, RunAt: "2025-12-31T09:00:00Z"},
+			wantCode: errors.InvalidParameter,
+		},
+		"rejects an unknown command type": {
+			in:       input.ScheduleCommandInput{Type: "rename_todo_list", RunAt: "2025-12-31T09:00:00Z"},
+			wantErr:  `unknown command type "rename_todo_list"`,
+			wantCode: errors.InvalidParameter,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			store := newMemoryScheduledCommandStore()
+			usecase := command.NewScheduledCommandCreateCommand(store, &fakeTransaction{}, &fakeClock{now: scheduledNow})
+			p := &recordingScheduledCommandPresenter{}
+			in := tt.in
+			in.UserID = userID
+
+			// Act
+			err := usecase.Execute(context.Background(), &in, p)
+
+			// Assert
+			require.NoError(t, err)
+			if tt.wantCode != "" {
+				require.Error(t, p.err)
+				require.True(t, errors.IsCode(p.err, tt.wantCode))
+				if tt.wantErr != "" {
+					require.EqualError(t, p.err, tt.wantErr)
+				}
+				require.Empty(t, store.cmds)
+				return
+			}
+			require.NoError(t, p.err)
+			require.Equal(t, dto.ScheduledCommandPending, p.out.Status)
+			require.Len(t, store.cmds, 1)
+			require.Equal(t, userID, store.cmds[p.out.ID].UserID)
+		})
+	}
+}
+
+func TestScheduledCommandCancelCommand_Execute(t *testing.T) {
+	owner := uuid.New().String()
+
+	tests := map[string]struct {
+		status     string
+		userID     string
+		id         string
+		wantCode   errors.ErrCode
+		wantStatus string
+	}{
+		"cancels a pending command": {
+			status:     dto.ScheduledCommandPending,
+			userID:     owner,
+			id:         "cmd-1",
+			wantStatus: dto.ScheduledCommandCancelled,
+		},
+		"refuses to cancel another user's command": {
+			status:     dto.ScheduledCommandPending,
+			userID:     uuid.New().String(),
+			id:         "cmd-1",
+			wantCode:   errors.Forbidden,
+			wantStatus: dto.ScheduledCommandPending,
+		},
+		"refuses to cancel a command that already ran": {
+			status:     dto.ScheduledCommandSucceeded,
+			userID:     owner,
+			id:         "cmd-1",
+			wantCode:   errors.InvalidParameter,
+			wantStatus: dto.ScheduledCommandSucceeded,
+		},
+		"reports an unknown command": {
+			status:     dto.ScheduledCommandPending,
+			userID:     owner,
+			id:         "cmd-2",
+			wantCode:   errors.NotFound,
+			wantStatus: dto.ScheduledCommandPending,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			store := newMemoryScheduledCommandStore()
+			store.cmds["cmd-1"] = dto.ScheduledCommandDTO{ID: "cmd-1", UserID: owner, Status: tt.status}
+			usecase := command.NewScheduledCommandCancelCommand(store, &fakeTransaction{}, &fakeClock{now: scheduledNow})
+			p := &recordingScheduledCommandPresenter{}
+
+			// Act
+			err := usecase.Execute(context.Background(), &input.CancelScheduledCommandInput{UserID: tt.userID, ScheduledCommandID: tt.id}, p)
+
+			// Assert
+			require.NoError(t, err)
+			if tt.wantCode != "" {
+				require.True(t, errors.IsCode(p.err, tt.wantCode))
+			} else {
+				require.NoError(t, p.err)
+			}
+			require.Equal(t, tt.wantStatus, store.cmds["cmd-1"].Status)
+		})
+	}
+}
+
+func TestScheduledCommandRunner_RunDue(t *testing.T) {
+	tests := map[string]struct {
+		cmd          dto.ScheduledCommandDTO
+		addErr       error
+		runs         int
+		wantStatus   string
+		wantAttempts int
+		wantResult   string
+	}{
+		"runs a due command once": {
+			cmd:        dto.ScheduledCommandDTO{Type: input.BatchCommandAddTodo, AggregateID: "list-1", Todo: "Pay rent", RunAt: scheduledNow, Status: dto.ScheduledCommandPending},
+			runs:       2,
+			wantStatus: dto.ScheduledCommandSucceeded,
+			wantResult: "list-1",
+		},
+		"leaves a command that is not due": {
+			cmd:        dto.ScheduledCommandDTO{Type: input.BatchCommandAddTodo, AggregateID: "list-1", Todo: "Pay rent", RunAt: scheduledNow.Add(time.Second), Status: dto.ScheduledCommandPending},
+			runs:       1,
+			wantStatus: dto.ScheduledCommandPending,
+		},
+		"leaves a cancelled command": {
+			cmd:        dto.ScheduledCommandDTO{Type: input.BatchCommandAddTodo, AggregateID: "list-1", Todo: "Pay rent", RunAt: scheduledNow, Status: dto.ScheduledCommandCancelled},
+			runs:       1,
+			wantStatus: dto.ScheduledCommandCancelled,
+		},
+		"resumes a run that was interrupted": {
+			cmd:        dto.ScheduledCommandDTO{Type: input.BatchCommandAddTodo, AggregateID: "list-1", Todo: "Pay rent", RunAt: scheduledNow, Status: dto.ScheduledCommandRunning, UpdatedAt: scheduledNow.Add(-time.Hour)},
+			runs:       1,
+			wantStatus: dto.ScheduledCommandSucceeded,
+			wantResult: "list-1",
+		},
+		"fails a command the list rejects at once": {
+			cmd:          dto.ScheduledCommandDTO{Type: input.BatchCommandAddTodo, AggregateID: "list-1", Todo: "Pay rent", RunAt: scheduledNow, Status: dto.ScheduledCommandPending},
+			addErr:       errors.Archived.New("todo list is archived"),
+			runs:         2,
+			wantStatus:   dto.ScheduledCommandFailed,
+			wantAttempts: 1,
+		},
+		"retries a transient failure until attempts run out": {
+			cmd:          dto.ScheduledCommandDTO{Type: input.BatchCommandAddTodo, AggregateID: "list-1", Todo: "Pay rent", RunAt: scheduledNow, Status: dto.ScheduledCommandPending},
+			addErr:       errors.RepositoryError.New("connection refused"),
+			runs:         5,
+			wantStatus:   dto.ScheduledCommandFailed,
+			wantAttempts: 3,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			store := newMemoryScheduledCommandStore()
+			cmd := tt.cmd
+			cmd.ID = "cmd-1"
+			cmd.UserID = uuid.New().String()
+			store.cmds[cmd.ID] = cmd
+
+			addTodo := &fakeAddTodo{}
+			var runner command.ScheduledCommandRunnerInterface
+			if tt.addErr != nil {
+				runner = command.NewScheduledCommandRunner(store, &fakeTransaction{}, &fakeClock{now: scheduledNow}, &fakeCreateTodoList{}, &failingAddTodo{err: tt.addErr}, 3)
+			} else {
+				runner = command.NewScheduledCommandRunner(store, &fakeTransaction{}, &fakeClock{now: scheduledNow}, &fakeCreateTodoList{}, addTodo, 3)
+			}
+
+			// Act
+			for i := 0; i < tt.runs; i++ {
+				require.NoError(t, runner.RunDue(context.Background()))
+			}
+
+			// Assert
+			got := store.cmds[cmd.ID]
+			require.Equal(t, tt.wantStatus, got.Status)
+			require.Equal(t, tt.wantAttempts, got.Attempts)
+			require.Equal(t, tt.wantResult, got.ResultAggregateID)
+			if tt.wantResult != "" {
+				require.Equal(t, []string{tt.wantResult}, addTodo.lists)
+			}
+		})
+	}
+}
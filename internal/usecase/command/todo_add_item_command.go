@@ -2,17 +2,13 @@ package command
 
 import (
 	"context"
-	"time"
 
 	"github.com/google/uuid"
-	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/aggregate"
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/command"
-	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
-	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/repository"
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/input"
-	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/gateway"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/commandbus"
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/presenter"
 )
 
@@ -21,94 +17,63 @@ type TodoAddItemCommandInterface interface {
 }
 
 type TodoAddItemCommand struct {
-	tx         repository.Transaction
-	eventStore repository.EventStore
-	eventBus   gateway.EventPublisher
+	commandBus commandbus.Dispatcher
 }
 
-func NewTodoAddItemCommand(tx repository.Transaction, eventStore repository.EventStore, eventBus gateway.EventPublisher) TodoAddItemCommandInterface {
+func NewTodoAddItemCommand(commandBus commandbus.Dispatcher) TodoAddItemCommandInterface {
 	return &TodoAddItemCommand{
-		tx:         tx,
-		eventStore: eventStore,
-		eventBus:   eventBus,
+		commandBus: commandBus,
 	}
 }
 
 func (u *TodoAddItemCommand) Execute(ctx context.Context, input *input.AddTodoInput, out presenter.CommandResultPresenter) error {
-	maxRetries := 3
-	var err error
-	var aggregateID string
-	var version int
-	var events []event.Event
-
-	for attempt := range maxRetries {
-		err = u.tx.RWTx(ctx, func(ctx context.Context) error {
-			todoText, err := value.NewTodoText(input.Todo)
-			if err != nil {
-				return err
-			}
-
-			aggregateUUID, err := uuid.Parse(input.AggregateID)
-			if err != nil {
-				return err
-			}
-
-			loadedEvents, err := u.eventStore.LoadEvents(ctx, aggregateUUID)
-			if err != nil {
-				return err
-			}
-
-			todoList := aggregate.NewTodoListAggregate()
-			if err := todoList.Hydration(loadedEvents); err != nil {
-				return err
-			}
-
-			userIDVO, err := value.NewUserID(input.UserID)
-			if err != nil {
-				return err
-			}
-
-			cmd := command.AddTodoCommand{
-				AggregateID: aggregateUUID,
-				UserID:      userIDVO,
-				TodoText:    todoText,
-			}
+	todoText, err := value.NewTodoText(input.Todo)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
 
-			if err := todoList.ExecuteAddTodoCommand(cmd); err != nil {
-				return err
-			}
+	var dueDate value.DueDate
+	if input.DueDate != "" {
+		if dueDate, err = value.NewDueDate(input.DueDate); err != nil {
+			return out.PresentError(ctx, err)
+		}
+	}
 
-			if err := u.eventStore.SaveEvents(ctx, todoList.GetAggregateID(), todoList.GetUncommittedEvents()); err != nil {
-				return err
-			}
+	priority, err := value.NewPriority(input.Priority)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
 
-			aggregateID = todoList.GetAggregateID().String()
-			version = todoList.GetVersion()
-			events = todoList.GetUncommittedEvents()
+	aggregateUUID, err := uuid.Parse(input.AggregateID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
 
-			evs := todoList.GetUncommittedEvents()
-			u.tx.AfterCommit(func() error {
-				return u.eventBus.Publish(context.Background(), evs...)
-			})
+	var recurrenceID uuid.UUID
+	if input.RecurrenceID != "" {
+		if recurrenceID, err = uuid.Parse(input.RecurrenceID); err != nil {
+			return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "recurrence_id must be a valid UUID"))
+		}
+	}
 
-			todoList.MarkEventsAsCommitted()
+	userIDVO, err := value.NewUserID(input.UserID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
 
-			return nil
-		})
-		if err != nil {
-			if errors.IsCode(err, errors.OptimisticLock) && attempt < maxRetries-1 {
-				waitTime := time.Duration(attempt+1) * 10 * time.Millisecond
-				time.Sleep(waitTime)
-				continue
-			}
-			break
-		}
-		break
+	cmd := command.AddTodoCommand{
+		AggregateID:  aggregateUUID,
+		UserID:       userIDVO,
+		TodoText:     todoText,
+		DueDate:      dueDate,
+		Priority:     priority,
+		RecurrenceID: recurrenceID,
 	}
 
+	result, err := u.commandBus.Dispatch(ctx, cmd)
 	if err != nil {
 		return out.PresentError(ctx, err)
 	}
 
-	return out.PresentSuccess(ctx, aggregateID, version, events)
+	return out.PresentSuccess(ctx, result.AggregateID, result.Version, result.Events)
 }
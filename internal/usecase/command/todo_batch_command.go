@@ -0,0 +1,160 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/output"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/commandbus"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/presenter"
+)
+
+// MaxBatchCommands caps the commands of one batch, so that an atomic batch
+// does not hold its transaction open for long.
+const MaxBatchCommands = 500
+
+type TodoBatchCommandInterface interface {
+	Execute(ctx context.Context, input *input.BatchCommandsInput, out presenter.BatchCommandResultPresenter) error
+}
+
+// TodoBatchCommand runs the commands of a batch through the use cases that run
+// them one at a time, so that each is validated and handled the same way.
+type TodoBatchCommand struct {
+	tx            repository.Transaction
+	createCommand TodoListCreateCommandInterface
+	addCommand    TodoAddItemCommandInterface
+}
+
+func NewTodoBatchCommand(tx repository.Transaction, createCommand TodoListCreateCommandInterface, addCommand TodoAddItemCommandInterface) TodoBatchCommandInterface {
+	return &TodoBatchCommand{
+		tx:            tx,
+		createCommand: createCommand,
+		addCommand:    addCommand,
+	}
+}
+
+func (u *TodoBatchCommand) Execute(ctx context.Context, input *input.BatchCommandsInput, out presenter.BatchCommandResultPresenter) error {
+	if len(input.Commands) == 0 {
+		return out.PresentError(ctx, errors.InvalidParameter.New("commands must not be empty"))
+	}
+	if len(input.Commands) > MaxBatchCommands {
+		return out.PresentError(ctx, errors.InvalidParameter.New(fmt.Sprintf("a batch holds at most %d commands", MaxBatchCommands)))
+	}
+
+	if !input.Atomic {
+		return out.PresentResults(ctx, u.run(ctx, input))
+	}
+
+	// The commands join this transaction, so the first failure rolls back
+	// those before it and their events are published only after it commits.
+	var results []output.BatchCommandOutput
+	err := u.tx.RWTx(ctx, func(ctx context.Context) error {
+		results = u.run(ctx, input)
+		for i, result := range results {
+			if result.Err != nil {
+				return fmt.Errorf("commands[%d]: %w", i, result.Err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentResults(ctx, results)
+}
+
+// run runs the commands in order; an atomic batch stops at the first failure.
+func (u *TodoBatchCommand) run(ctx context.Context, in *input.BatchCommandsInput) []output.BatchCommandOutput {
+	results := make([]output.BatchCommandOutput, 0, len(in.Commands))
+	for i, cmd := range in.Commands {
+		// Each command gets an idempotency key of its own, so that a batch
+		// sent again replays the commands that succeeded and retries the
+		// others.
+		result := u.runOne(commandbus.ScopeIdempotencyKey(ctx, strconv.Itoa(i)), in, cmd, results)
+		results = append(results, result)
+		if result.Err != nil && in.Atomic {
+			break
+		}
+	}
+	return results
+}
+
+func (u *TodoBatchCommand) runOne(ctx context.Context, in *input.BatchCommandsInput, cmd input.BatchCommandInput, earlier []output.BatchCommandOutput) output.BatchCommandOutput {
+	if cmd.Type == input.BatchCommandAddTodo && cmd.ListIndex != nil {
+		if cmd.AggregateID != "" {
+			return output.BatchCommandOutput{Err: errors.InvalidParameter.New("aggregate_id and list_index cannot both be set")}
+		}
+		aggregateID, err := listCreatedAt(in.Commands, earlier, *cmd.ListIndex)
+		if err != nil {
+			return output.BatchCommandOutput{Err: err}
+		}
+		cmd.AggregateID = aggregateID
+	}
+
+	return runListCommand(ctx, u.createCommand, u.addCommand, in.UserID, cmd)
+}
+
+// runListCommand runs a create_todo_list or add_todo command through the use
+// case that runs it on its own.
+func runListCommand(ctx context.Context, createCommand TodoListCreateCommandInterface, addCommand TodoAddItemCommandInterface, userID string, cmd input.BatchCommandInput) output.BatchCommandOutput {
+	out := &commandResultRecorder{}
+
+	var err error
+	switch cmd.Type {
+	case input.BatchCommandCreateTodoList:
+		err = createCommand.Execute(ctx, &input.CreateTodoListInput{
+			UserID:      userID,
+			Title:       cmd.Title,
+			Description: cmd.Description,
+		}, out)
+	case input.BatchCommandAddTodo:
+		err = addCommand.Execute(ctx, &input.AddTodoInput{
+			AggregateID: cmd.AggregateID,
+			UserID:      userID,
+			Todo:        cmd.Todo,
+			DueDate:     cmd.DueDate,
+			Priority:    cmd.Priority,
+		}, out)
+	default:
+		err = errors.InvalidParameter.New(fmt.Sprintf("unknown command type %q", cmd.Type))
+	}
+	if err != nil {
+		return output.BatchCommandOutput{Err: err}
+	}
+
+	return out.result
+}
+
+// listCreatedAt returns the ID of the list created by the create_todo_list
+// command at index, which must come before the command referring to it.
+func listCreatedAt(commands []input.BatchCommandInput, earlier []output.BatchCommandOutput, index int) (string, error) {
+	if index < 0 || index >= len(earlier) || commands[index].Type != input.BatchCommandCreateTodoList {
+		return "", errors.InvalidParameter.New(fmt.Sprintf("list_index %d does not name an earlier create_todo_list command", index))
+	}
+	if earlier[index].Err != nil {
+		return "", errors.InvalidParameter.New(fmt.Sprintf("the list of command %d was not created", index))
+	}
+	return earlier[index].AggregateID, nil
+}
+
+// commandResultRecorder records the outcome of a command run through another
+// use case.
+type commandResultRecorder struct {
+	result output.BatchCommandOutput
+}
+
+func (p *commandResultRecorder) PresentSuccess(ctx context.Context, aggregateID string, version int, events []event.Event) error {
+	p.result = output.BatchCommandOutput{AggregateID: aggregateID, Version: version, Events: events}
+	return nil
+}
+
+func (p *commandResultRecorder) PresentError(ctx context.Context, err error) error {
+	p.result = output.BatchCommandOutput{Err: err}
+	return nil
+}
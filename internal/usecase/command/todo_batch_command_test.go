@@ -0,0 +1,178 @@
+package command_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/output"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/presenter"
+)
+
+type fakeTransaction struct {
+	commits   int
+	rollbacks int
+}
+
+func (f *fakeTransaction) RWTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if err := fn(ctx); err != nil {
+		f.rollbacks++
+		return err
+	}
+	f.commits++
+	return nil
+}
+
+func (f *fakeTransaction) AfterCommit(ctx context.Context, fn func() error) error {
+	return nil
+}
+
+func (f *fakeTransaction) InTx(ctx context.Context) bool {
+	return false
+}
+
+type fakeCreateTodoList struct {
+	created int
+}
+
+func (f *fakeCreateTodoList) Execute(ctx context.Context, in *input.CreateTodoListInput, out presenter.CommandResultPresenter) error {
+	f.created++
+	return out.PresentSuccess(ctx, fmt.Sprintf("list-%d", f.created), 1, nil)
+}
+
+// fakeAddTodo records the lists todos are added to and rejects empty todos,
+// as the real use case does.
+type fakeAddTodo struct {
+	lists []string
+}
+
+func (f *fakeAddTodo) Execute(ctx context.Context, in *input.AddTodoInput, out presenter.CommandResultPresenter) error {
+	if in.Todo == "" {
+		return out.PresentError(ctx, errors.InvalidParameter.New("todo text must not be empty"))
+	}
+	f.lists = append(f.lists, in.AggregateID)
+	return out.PresentSuccess(ctx, in.AggregateID, 2, nil)
+}
+
+type recordingBatchPresenter struct {
+	results []output.BatchCommandOutput
+	err     error
+}
+
+func (p *recordingBatchPresenter) PresentResults(ctx context.Context, results []output.BatchCommandOutput) error {
+	p.results = results
+	return nil
+}
+
+func (p *recordingBatchPresenter) PresentError(ctx context.Context, err error) error {
+	p.err = err
+	return nil
+}
+
+func TestTodoBatchCommand_Execute(t *testing.T) {
+	first := 0
+	second := 1
+
+	tests := map[string]struct {
+		atomic        bool
+		commands      []input.BatchCommandInput
+		wantErr       string
+		wantCode      errors.ErrCode
+		wantResults   []string
+		wantLists     []string
+		wantCommits   int
+		wantRollbacks int
+	}{
+		"adds todos to a list created earlier in the batch": {
+			commands: []input.BatchCommandInput{
+				{Type: input.BatchCommandCreateTodoList, Title: "Groceries"},
+				{Type: input.BatchCommandAddTodo, ListIndex: &first, Todo: "Buy milk"},
+				{Type: input.BatchCommandAddTodo, AggregateID: "existing", Todo: "Walk dog"},
+			},
+			wantResults: []string{"list-1", "list-1", "existing"},
+			wantLists:   []string{"list-1", "existing"},
+		},
+		"reports each failure and runs the other commands": {
+			commands: []input.BatchCommandInput{
+				{Type: input.BatchCommandAddTodo, AggregateID: "existing"},
+				{Type: "rename_todo_list"},
+				{Type: input.BatchCommandAddTodo, ListIndex: &first, Todo: "Buy milk"},
+				{Type: input.BatchCommandAddTodo, AggregateID: "existing", Todo: "Walk dog"},
+			},
+			wantResults: []string{"error", "error", "error", "existing"},
+			wantLists:   []string{"existing"},
+		},
+		"commits an atomic batch in one transaction": {
+			atomic: true,
+			commands: []input.BatchCommandInput{
+				{Type: input.BatchCommandCreateTodoList},
+				{Type: input.BatchCommandAddTodo, ListIndex: &first, Todo: "Buy milk"},
+			},
+			wantResults: []string{"list-1", "list-1"},
+			wantLists:   []string{"list-1"},
+			wantCommits: 1,
+		},
+		"rolls an atomic batch back at the first failure": {
+			atomic: true,
+			commands: []input.BatchCommandInput{
+				{Type: input.BatchCommandCreateTodoList},
+				{Type: input.BatchCommandAddTodo, ListIndex: &second, Todo: "Buy milk"},
+				{Type: input.BatchCommandAddTodo, ListIndex: &first, Todo: "Walk dog"},
+			},
+			wantErr:       "commands[1]: list_index 1 does not name an earlier create_todo_list command",
+			wantCode:      errors.InvalidParameter,
+			wantRollbacks: 1,
+		},
+		"rejects an empty batch": {
+			wantErr:  "commands must not be empty",
+			wantCode: errors.InvalidParameter,
+		},
+		"rejects a batch that is too large": {
+			commands: make([]input.BatchCommandInput, command.MaxBatchCommands+1),
+			wantErr:  fmt.Sprintf("a batch holds at most %d commands", command.MaxBatchCommands),
+			wantCode: errors.InvalidParameter,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			tx := &fakeTransaction{}
+			addTodo := &fakeAddTodo{}
+			usecase := command.NewTodoBatchCommand(tx, &fakeCreateTodoList{}, addTodo)
+			out := &recordingBatchPresenter{}
+
+			// Act
+			err := usecase.Execute(context.Background(), &input.BatchCommandsInput{
+				UserID:   "user123",
+				Atomic:   tt.atomic,
+				Commands: tt.commands,
+			}, out)
+
+			// Assert
+			require.NoError(t, err)
+			if tt.wantErr != "" {
+				require.EqualError(t, out.err, tt.wantErr)
+				require.True(t, errors.IsCode(out.err, tt.wantCode), "got %v", out.err)
+			} else {
+				require.NoError(t, out.err)
+				results := make([]string, 0, len(out.results))
+				for _, result := range out.results {
+					if result.Err != nil {
+						results = append(results, "error")
+						continue
+					}
+					results = append(results, result.AggregateID)
+				}
+				require.Equal(t, tt.wantResults, results)
+			}
+			require.Equal(t, tt.wantLists, addTodo.lists)
+			require.Equal(t, tt.wantCommits, tx.commits)
+			require.Equal(t, tt.wantRollbacks, tx.rollbacks)
+		})
+	}
+}
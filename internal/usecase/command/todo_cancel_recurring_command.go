@@ -0,0 +1,57 @@
+package command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/commandbus"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/presenter"
+)
+
+type TodoCancelRecurringCommandInterface interface {
+	Execute(ctx context.Context, input *input.CancelRecurringTodoInput, out presenter.CommandResultPresenter) error
+}
+
+type TodoCancelRecurringCommand struct {
+	commandBus commandbus.Dispatcher
+}
+
+func NewTodoCancelRecurringCommand(commandBus commandbus.Dispatcher) TodoCancelRecurringCommandInterface {
+	return &TodoCancelRecurringCommand{
+		commandBus: commandBus,
+	}
+}
+
+func (u *TodoCancelRecurringCommand) Execute(ctx context.Context, input *input.CancelRecurringTodoInput, out presenter.CommandResultPresenter) error {
+	aggregateUUID, err := uuid.Parse(input.AggregateID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	userID, err := value.NewUserID(input.UserID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	recurrenceID, err := uuid.Parse(input.RecurrenceID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "recurrence_id must be a valid UUID"))
+	}
+
+	cmd := command.CancelRecurringTodoCommand{
+		AggregateID:  aggregateUUID,
+		UserID:       userID,
+		RecurrenceID: recurrenceID,
+	}
+
+	result, err := u.commandBus.Dispatch(ctx, cmd)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, result.AggregateID, result.Version, result.Events)
+}
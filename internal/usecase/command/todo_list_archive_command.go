@@ -0,0 +1,50 @@
+package command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/commandbus"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/presenter"
+)
+
+type TodoListArchiveCommandInterface interface {
+	Execute(ctx context.Context, input *input.ArchiveTodoListInput, out presenter.CommandResultPresenter) error
+}
+
+type TodoListArchiveCommand struct {
+	commandBus commandbus.Dispatcher
+}
+
+func NewTodoListArchiveCommand(commandBus commandbus.Dispatcher) TodoListArchiveCommandInterface {
+	return &TodoListArchiveCommand{
+		commandBus: commandBus,
+	}
+}
+
+func (u *TodoListArchiveCommand) Execute(ctx context.Context, input *input.ArchiveTodoListInput, out presenter.CommandResultPresenter) error {
+	aggregateUUID, err := uuid.Parse(input.AggregateID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	userID, err := value.NewUserID(input.UserID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	cmd := command.ArchiveTodoListCommand{
+		AggregateID: aggregateUUID,
+		UserID:      userID,
+	}
+
+	result, err := u.commandBus.Dispatch(ctx, cmd)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, result.AggregateID, result.Version, result.Events)
+}
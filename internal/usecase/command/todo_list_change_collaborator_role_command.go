@@ -0,0 +1,62 @@
+package command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/commandbus"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/presenter"
+)
+
+type TodoListChangeCollaboratorRoleCommandInterface interface {
+	Execute(ctx context.Context, input *input.ChangeCollaboratorRoleInput, out presenter.CommandResultPresenter) error
+}
+
+type TodoListChangeCollaboratorRoleCommand struct {
+	commandBus commandbus.Dispatcher
+}
+
+func NewTodoListChangeCollaboratorRoleCommand(commandBus commandbus.Dispatcher) TodoListChangeCollaboratorRoleCommandInterface {
+	return &TodoListChangeCollaboratorRoleCommand{
+		commandBus: commandBus,
+	}
+}
+
+func (u *TodoListChangeCollaboratorRoleCommand) Execute(ctx context.Context, input *input.ChangeCollaboratorRoleInput, out presenter.CommandResultPresenter) error {
+	aggregateUUID, err := uuid.Parse(input.AggregateID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	userID, err := value.NewUserID(input.UserID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	collaboratorID, err := value.NewUserID(input.CollaboratorID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	role, err := value.NewRole(input.Role)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	cmd := command.ChangeCollaboratorRoleCommand{
+		AggregateID:    aggregateUUID,
+		UserID:         userID,
+		CollaboratorID: collaboratorID,
+		Role:           role,
+	}
+
+	result, err := u.commandBus.Dispatch(ctx, cmd)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, result.AggregateID, result.Version, result.Events)
+}
@@ -0,0 +1,170 @@
+package command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/commandbus"
+)
+
+// RegisterTodoListHandlers routes every todo list command to its handler on b.
+// Most handlers load the list, run the command on it and save the events it
+// recorded; transactions, retries and publishing are left to b's middleware.
+func RegisterTodoListHandlers(b *commandbus.Bus, todoLists repository.AggregateRepository[*aggregate.TodoListAggregate]) {
+	commandbus.Register(b, func(ctx context.Context, cmd command.CreateTodoListCommand) (*commandbus.Result, error) {
+		todoList := aggregate.NewTodoListAggregate()
+		if err := todoList.ExecuteCreateTodoListCommand(cmd); err != nil {
+			return nil, err
+		}
+		return saveTodoList(ctx, todoLists, todoList)
+	})
+	commandbus.Register(b, todoListHandler(todoLists,
+		func(cmd command.RenameTodoListCommand) uuid.UUID { return cmd.AggregateID },
+		(*aggregate.TodoListAggregate).ExecuteRenameTodoListCommand))
+	commandbus.Register(b, todoListHandler(todoLists,
+		func(cmd command.AddTodoCommand) uuid.UUID { return cmd.AggregateID },
+		(*aggregate.TodoListAggregate).ExecuteAddTodoCommand))
+	commandbus.Register(b, todoListHandler(todoLists,
+		func(cmd command.ScheduleRecurringTodoCommand) uuid.UUID { return cmd.AggregateID },
+		(*aggregate.TodoListAggregate).ExecuteScheduleRecurringTodoCommand))
+	commandbus.Register(b, todoListHandler(todoLists,
+		func(cmd command.CancelRecurringTodoCommand) uuid.UUID { return cmd.AggregateID },
+		(*aggregate.TodoListAggregate).ExecuteCancelRecurringTodoCommand))
+	commandbus.Register(b, todoListHandler(todoLists,
+		func(cmd command.SetTodoDueDateCommand) uuid.UUID { return cmd.AggregateID },
+		(*aggregate.TodoListAggregate).ExecuteSetTodoDueDateCommand))
+	commandbus.Register(b, todoListHandler(todoLists,
+		func(cmd command.SetTodoPriorityCommand) uuid.UUID { return cmd.AggregateID },
+		(*aggregate.TodoListAggregate).ExecuteSetTodoPriorityCommand))
+	commandbus.Register(b, todoListHandler(todoLists,
+		func(cmd command.TagTodoCommand) uuid.UUID { return cmd.AggregateID },
+		(*aggregate.TodoListAggregate).ExecuteTagTodoCommand))
+	commandbus.Register(b, todoListHandler(todoLists,
+		func(cmd command.UntagTodoCommand) uuid.UUID { return cmd.AggregateID },
+		(*aggregate.TodoListAggregate).ExecuteUntagTodoCommand))
+	commandbus.Register(b, todoListHandler(todoLists,
+		func(cmd command.SetTodoCompletionCommand) uuid.UUID { return cmd.AggregateID },
+		(*aggregate.TodoListAggregate).ExecuteSetTodoCompletionCommand))
+	commandbus.Register(b, todoListHandler(todoLists,
+		func(cmd command.AddChecklistItemCommand) uuid.UUID { return cmd.AggregateID },
+		(*aggregate.TodoListAggregate).ExecuteAddChecklistItemCommand))
+	commandbus.Register(b, todoListHandler(todoLists,
+		func(cmd command.CompleteChecklistItemCommand) uuid.UUID { return cmd.AggregateID },
+		(*aggregate.TodoListAggregate).ExecuteCompleteChecklistItemCommand))
+	commandbus.Register(b, todoListHandler(todoLists,
+		func(cmd command.RemoveChecklistItemCommand) uuid.UUID { return cmd.AggregateID },
+		(*aggregate.TodoListAggregate).ExecuteRemoveChecklistItemCommand))
+	commandbus.Register(b, todoListHandler(todoLists,
+		func(cmd command.ReorderTodoItemsCommand) uuid.UUID { return cmd.AggregateID },
+		(*aggregate.TodoListAggregate).ExecuteReorderTodoItemsCommand))
+	commandbus.Register(b, todoListHandler(todoLists,
+		func(cmd command.MarkOverdueTodosCommand) uuid.UUID { return cmd.AggregateID },
+		(*aggregate.TodoListAggregate).ExecuteMarkOverdueTodosCommand))
+	commandbus.Register(b, todoListHandler(todoLists,
+		func(cmd command.InviteCollaboratorCommand) uuid.UUID { return cmd.AggregateID },
+		(*aggregate.TodoListAggregate).ExecuteInviteCollaboratorCommand))
+	commandbus.Register(b, todoListHandler(todoLists,
+		func(cmd command.ChangeCollaboratorRoleCommand) uuid.UUID { return cmd.AggregateID },
+		(*aggregate.TodoListAggregate).ExecuteChangeCollaboratorRoleCommand))
+	commandbus.Register(b, todoListHandler(todoLists,
+		func(cmd command.RemoveCollaboratorCommand) uuid.UUID { return cmd.AggregateID },
+		(*aggregate.TodoListAggregate).ExecuteRemoveCollaboratorCommand))
+	commandbus.Register(b, todoListHandler(todoLists,
+		func(cmd command.ArchiveTodoListCommand) uuid.UUID { return cmd.AggregateID },
+		(*aggregate.TodoListAggregate).ExecuteArchiveTodoListCommand))
+	commandbus.Register(b, todoListHandler(todoLists,
+		func(cmd command.RestoreTodoListCommand) uuid.UUID { return cmd.AggregateID },
+		(*aggregate.TodoListAggregate).ExecuteRestoreTodoListCommand))
+	commandbus.Register(b, todoListHandler(todoLists,
+		func(cmd command.DeleteTodoListCommand) uuid.UUID { return cmd.AggregateID },
+		(*aggregate.TodoListAggregate).ExecuteDeleteTodoListCommand))
+	commandbus.Register(b, todoListHandler(todoLists,
+		func(cmd command.UndoCommand) uuid.UUID { return cmd.AggregateID },
+		(*aggregate.TodoListAggregate).ExecuteUndoCommand))
+	commandbus.Register(b, func(ctx context.Context, cmd command.MoveTodoOutCommand) (*commandbus.Result, error) {
+		return moveTodo(ctx, todoLists, cmd)
+	})
+}
+
+// todoListHandler returns a handler that runs execute on the list
+// aggregateID picks from the command.
+func todoListHandler[C any](
+	todoLists repository.AggregateRepository[*aggregate.TodoListAggregate],
+	aggregateID func(C) uuid.UUID,
+	execute func(*aggregate.TodoListAggregate, C) error,
+) func(context.Context, C) (*commandbus.Result, error) {
+	return func(ctx context.Context, cmd C) (*commandbus.Result, error) {
+		todoList, err := todoLists.Load(ctx, aggregateID(cmd))
+		if err != nil {
+			return nil, err
+		}
+
+		if err := execute(todoList, cmd); err != nil {
+			return nil, err
+		}
+
+		return saveTodoList(ctx, todoLists, todoList)
+	}
+}
+
+// moveTodo moves a todo from one list to another. Both lists are saved in
+// the command's transaction, so a failure on either side, including the
+// target's todo limit, leaves both lists as they were.
+func moveTodo(ctx context.Context, todoLists repository.AggregateRepository[*aggregate.TodoListAggregate], cmd command.MoveTodoOutCommand) (*commandbus.Result, error) {
+	source, err := todoLists.Load(ctx, cmd.AggregateID)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := todoLists.Load(ctx, cmd.TargetAggregateID)
+	if err != nil {
+		return nil, err
+	}
+
+	todo := source.FindItem(cmd.TodoID)
+	if todo == nil {
+		return nil, aggregate.ErrTodoNotFound
+	}
+
+	if err := source.ExecuteMoveTodoOutCommand(cmd); err != nil {
+		return nil, err
+	}
+
+	if err := target.ExecuteMoveTodoInCommand(command.MoveTodoInCommand{
+		AggregateID:       cmd.TargetAggregateID,
+		UserID:            cmd.UserID,
+		SourceAggregateID: cmd.AggregateID,
+		Todo:              todo,
+	}); err != nil {
+		return nil, err
+	}
+
+	moved, err := saveTodoList(ctx, todoLists, source)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := saveTodoList(ctx, todoLists, target)
+	if err != nil {
+		return nil, err
+	}
+	result.Events = append(moved.Events, result.Events...)
+
+	return result, nil
+}
+
+func saveTodoList(ctx context.Context, todoLists repository.AggregateRepository[*aggregate.TodoListAggregate], todoList *aggregate.TodoListAggregate) (*commandbus.Result, error) {
+	events := todoList.GetUncommittedEvents()
+	if err := todoLists.Save(ctx, todoList); err != nil {
+		return nil, err
+	}
+
+	return &commandbus.Result{
+		AggregateID: todoList.GetAggregateID().String(),
+		Version:     todoList.GetVersion(),
+		Events:      events,
+	}, nil
+}
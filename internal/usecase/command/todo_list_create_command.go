@@ -3,13 +3,10 @@ package command
 import (
 	"context"
 
-	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/aggregate"
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/command"
-	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
-	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/repository"
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/input"
-	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/gateway"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/commandbus"
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/presenter"
 )
 
@@ -18,59 +15,37 @@ type TodoListCreateCommandInterface interface {
 }
 
 type TodoListCreateCommand struct {
-	tx         repository.Transaction
-	eventStore repository.EventStore
-	eventBus   gateway.EventPublisher
+	commandBus commandbus.Dispatcher
 }
 
-func NewTodoListCreateCommand(tx repository.Transaction, eventStore repository.EventStore, eventBus gateway.EventPublisher) TodoListCreateCommandInterface {
+func NewTodoListCreateCommand(commandBus commandbus.Dispatcher) TodoListCreateCommandInterface {
 	return &TodoListCreateCommand{
-		tx:         tx,
-		eventStore: eventStore,
-		eventBus:   eventBus,
+		commandBus: commandBus,
 	}
 }
 
 func (u *TodoListCreateCommand) Execute(ctx context.Context, input *input.CreateTodoListInput, out presenter.CommandResultPresenter) error {
-	var aggregateID string
-	var version int
-	var events []event.Event
-
-	err := u.tx.RWTx(ctx, func(ctx context.Context) error {
-		userID, err := value.NewUserID(input.UserID)
-		if err != nil {
-			return err
-		}
-
-		cmd := command.CreateTodoListCommand{
-			UserID: userID,
-		}
-
-		todoList := aggregate.NewTodoListAggregate()
-		if err := todoList.ExecuteCreateTodoListCommand(cmd); err != nil {
-			return err
-		}
+	userID, err := value.NewUserID(input.UserID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
 
-		if err := u.eventStore.SaveEvents(ctx, todoList.GetAggregateID(), todoList.GetUncommittedEvents()); err != nil {
-			return err
+	cmd := command.CreateTodoListCommand{
+		UserID: userID,
+	}
+	if input.Title != "" {
+		if cmd.Title, err = value.NewTodoListTitle(input.Title); err != nil {
+			return out.PresentError(ctx, err)
 		}
+	}
+	if cmd.Description, err = value.NewTodoListDescription(input.Description); err != nil {
+		return out.PresentError(ctx, err)
+	}
 
-		aggregateID = todoList.GetAggregateID().String()
-		version = todoList.GetVersion()
-		events = todoList.GetUncommittedEvents()
-
-		evs := todoList.GetUncommittedEvents()
-		u.tx.AfterCommit(func() error {
-			return u.eventBus.Publish(context.Background(), evs...)
-		})
-
-		todoList.MarkEventsAsCommitted()
-
-		return nil
-	})
+	result, err := u.commandBus.Dispatch(ctx, cmd)
 	if err != nil {
 		return out.PresentError(ctx, err)
 	}
 
-	return out.PresentSuccess(ctx, aggregateID, version, events)
+	return out.PresentSuccess(ctx, result.AggregateID, result.Version, result.Events)
 }
@@ -0,0 +1,50 @@
+package command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/commandbus"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/presenter"
+)
+
+type TodoListDeleteCommandInterface interface {
+	Execute(ctx context.Context, input *input.DeleteTodoListInput, out presenter.CommandResultPresenter) error
+}
+
+type TodoListDeleteCommand struct {
+	commandBus commandbus.Dispatcher
+}
+
+func NewTodoListDeleteCommand(commandBus commandbus.Dispatcher) TodoListDeleteCommandInterface {
+	return &TodoListDeleteCommand{
+		commandBus: commandBus,
+	}
+}
+
+func (u *TodoListDeleteCommand) Execute(ctx context.Context, input *input.DeleteTodoListInput, out presenter.CommandResultPresenter) error {
+	aggregateUUID, err := uuid.Parse(input.AggregateID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	userID, err := value.NewUserID(input.UserID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	cmd := command.DeleteTodoListCommand{
+		AggregateID: aggregateUUID,
+		UserID:      userID,
+	}
+
+	result, err := u.commandBus.Dispatch(ctx, cmd)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, result.AggregateID, result.Version, result.Events)
+}
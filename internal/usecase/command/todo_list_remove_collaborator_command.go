@@ -0,0 +1,56 @@
+package command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/commandbus"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/presenter"
+)
+
+type TodoListRemoveCollaboratorCommandInterface interface {
+	Execute(ctx context.Context, input *input.RemoveCollaboratorInput, out presenter.CommandResultPresenter) error
+}
+
+type TodoListRemoveCollaboratorCommand struct {
+	commandBus commandbus.Dispatcher
+}
+
+func NewTodoListRemoveCollaboratorCommand(commandBus commandbus.Dispatcher) TodoListRemoveCollaboratorCommandInterface {
+	return &TodoListRemoveCollaboratorCommand{
+		commandBus: commandBus,
+	}
+}
+
+func (u *TodoListRemoveCollaboratorCommand) Execute(ctx context.Context, input *input.RemoveCollaboratorInput, out presenter.CommandResultPresenter) error {
+	aggregateUUID, err := uuid.Parse(input.AggregateID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	userID, err := value.NewUserID(input.UserID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	collaboratorID, err := value.NewUserID(input.CollaboratorID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	cmd := command.RemoveCollaboratorCommand{
+		AggregateID:    aggregateUUID,
+		UserID:         userID,
+		CollaboratorID: collaboratorID,
+	}
+
+	result, err := u.commandBus.Dispatch(ctx, cmd)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, result.AggregateID, result.Version, result.Events)
+}
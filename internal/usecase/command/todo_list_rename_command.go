@@ -0,0 +1,71 @@
+package command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/commandbus"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/presenter"
+)
+
+var ErrRenameFieldsMissing = errors.InvalidParameter.New("title or description is required")
+
+type TodoListRenameCommandInterface interface {
+	Execute(ctx context.Context, input *input.RenameTodoListInput, out presenter.CommandResultPresenter) error
+}
+
+type TodoListRenameCommand struct {
+	commandBus commandbus.Dispatcher
+}
+
+func NewTodoListRenameCommand(commandBus commandbus.Dispatcher) TodoListRenameCommandInterface {
+	return &TodoListRenameCommand{
+		commandBus: commandBus,
+	}
+}
+
+func (u *TodoListRenameCommand) Execute(ctx context.Context, input *input.RenameTodoListInput, out presenter.CommandResultPresenter) error {
+	if input.Title == nil && input.Description == nil {
+		return out.PresentError(ctx, ErrRenameFieldsMissing)
+	}
+
+	aggregateUUID, err := uuid.Parse(input.AggregateID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	userID, err := value.NewUserID(input.UserID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	cmd := command.RenameTodoListCommand{
+		AggregateID: aggregateUUID,
+		UserID:      userID,
+	}
+	if input.Title != nil {
+		title, err := value.NewTodoListTitle(*input.Title)
+		if err != nil {
+			return out.PresentError(ctx, err)
+		}
+		cmd.Title = &title
+	}
+	if input.Description != nil {
+		description, err := value.NewTodoListDescription(*input.Description)
+		if err != nil {
+			return out.PresentError(ctx, err)
+		}
+		cmd.Description = &description
+	}
+
+	result, err := u.commandBus.Dispatch(ctx, cmd)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, result.AggregateID, result.Version, result.Events)
+}
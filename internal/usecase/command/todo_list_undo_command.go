@@ -0,0 +1,57 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/commandbus"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/presenter"
+)
+
+type TodoListUndoCommandInterface interface {
+	Execute(ctx context.Context, input *input.UndoInput, out presenter.CommandResultPresenter) error
+}
+
+type TodoListUndoCommand struct {
+	commandBus commandbus.Dispatcher
+	window     time.Duration
+}
+
+// NewTodoListUndoCommand returns a use case that undoes commands issued at
+// most window ago.
+func NewTodoListUndoCommand(commandBus commandbus.Dispatcher, window time.Duration) TodoListUndoCommandInterface {
+	return &TodoListUndoCommand{
+		commandBus: commandBus,
+		window:     window,
+	}
+}
+
+func (u *TodoListUndoCommand) Execute(ctx context.Context, input *input.UndoInput, out presenter.CommandResultPresenter) error {
+	aggregateUUID, err := uuid.Parse(input.AggregateID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	userID, err := value.NewUserID(input.UserID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	cmd := command.UndoCommand{
+		AggregateID: aggregateUUID,
+		UserID:      userID,
+		Now:         time.Now(),
+		Window:      u.window,
+	}
+
+	result, err := u.commandBus.Dispatch(ctx, cmd)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, result.AggregateID, result.Version, result.Events)
+}
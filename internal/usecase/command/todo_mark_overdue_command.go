@@ -0,0 +1,41 @@
+package command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/commandbus"
+)
+
+// TodoMarkOverdueCommandInterface is driven by the overdue scheduler rather
+// than a user request, so it reports failures to its caller instead of a
+// presenter.
+type TodoMarkOverdueCommandInterface interface {
+	Execute(ctx context.Context, input *input.MarkOverdueTodosInput) error
+}
+
+type TodoMarkOverdueCommand struct {
+	commandBus commandbus.Dispatcher
+}
+
+func NewTodoMarkOverdueCommand(commandBus commandbus.Dispatcher) TodoMarkOverdueCommandInterface {
+	return &TodoMarkOverdueCommand{
+		commandBus: commandBus,
+	}
+}
+
+func (u *TodoMarkOverdueCommand) Execute(ctx context.Context, input *input.MarkOverdueTodosInput) error {
+	aggregateUUID, err := uuid.Parse(input.AggregateID)
+	if err != nil {
+		return errors.InvalidParameter.Wrap(err, "aggregate_id must be a valid UUID")
+	}
+
+	_, err = u.commandBus.Dispatch(ctx, command.MarkOverdueTodosCommand{
+		AggregateID: aggregateUUID,
+		Now:         input.Now,
+	})
+	return err
+}
@@ -0,0 +1,63 @@
+package command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/commandbus"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/presenter"
+)
+
+type TodoMoveItemCommandInterface interface {
+	Execute(ctx context.Context, input *input.MoveTodoInput, out presenter.CommandResultPresenter) error
+}
+
+type TodoMoveItemCommand struct {
+	commandBus commandbus.Dispatcher
+}
+
+func NewTodoMoveItemCommand(commandBus commandbus.Dispatcher) TodoMoveItemCommandInterface {
+	return &TodoMoveItemCommand{
+		commandBus: commandBus,
+	}
+}
+
+func (u *TodoMoveItemCommand) Execute(ctx context.Context, input *input.MoveTodoInput, out presenter.CommandResultPresenter) error {
+	aggregateUUID, err := uuid.Parse(input.AggregateID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	userID, err := value.NewUserID(input.UserID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	todoID, err := uuid.Parse(input.TodoID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "todo_id must be a valid UUID"))
+	}
+
+	targetUUID, err := uuid.Parse(input.TargetAggregateID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "target_list_id must be a valid UUID"))
+	}
+
+	cmd := command.MoveTodoOutCommand{
+		AggregateID:       aggregateUUID,
+		UserID:            userID,
+		TodoID:            todoID,
+		TargetAggregateID: targetUUID,
+	}
+
+	result, err := u.commandBus.Dispatch(ctx, cmd)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, result.AggregateID, result.Version, result.Events)
+}
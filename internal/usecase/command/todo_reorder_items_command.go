@@ -0,0 +1,61 @@
+package command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/commandbus"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/presenter"
+)
+
+type TodoReorderItemsCommandInterface interface {
+	Execute(ctx context.Context, input *input.ReorderTodoItemsInput, out presenter.CommandResultPresenter) error
+}
+
+type TodoReorderItemsCommand struct {
+	commandBus commandbus.Dispatcher
+}
+
+func NewTodoReorderItemsCommand(commandBus commandbus.Dispatcher) TodoReorderItemsCommandInterface {
+	return &TodoReorderItemsCommand{
+		commandBus: commandBus,
+	}
+}
+
+func (u *TodoReorderItemsCommand) Execute(ctx context.Context, input *input.ReorderTodoItemsInput, out presenter.CommandResultPresenter) error {
+	aggregateUUID, err := uuid.Parse(input.AggregateID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	userID, err := value.NewUserID(input.UserID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	todoIDs := make([]uuid.UUID, 0, len(input.TodoIDs))
+	for _, id := range input.TodoIDs {
+		todoID, err := uuid.Parse(id)
+		if err != nil {
+			return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "todo_ids must be valid UUIDs"))
+		}
+		todoIDs = append(todoIDs, todoID)
+	}
+
+	cmd := command.ReorderTodoItemsCommand{
+		AggregateID: aggregateUUID,
+		UserID:      userID,
+		TodoIDs:     todoIDs,
+	}
+
+	result, err := u.commandBus.Dispatch(ctx, cmd)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, result.AggregateID, result.Version, result.Events)
+}
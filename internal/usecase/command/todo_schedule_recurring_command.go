@@ -0,0 +1,77 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/commandbus"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/presenter"
+)
+
+type TodoScheduleRecurringCommandInterface interface {
+	Execute(ctx context.Context, input *input.ScheduleRecurringTodoInput, out presenter.CommandResultPresenter) error
+}
+
+type TodoScheduleRecurringCommand struct {
+	commandBus commandbus.Dispatcher
+}
+
+func NewTodoScheduleRecurringCommand(commandBus commandbus.Dispatcher) TodoScheduleRecurringCommandInterface {
+	return &TodoScheduleRecurringCommand{
+		commandBus: commandBus,
+	}
+}
+
+func (u *TodoScheduleRecurringCommand) Execute(ctx context.Context, input *input.ScheduleRecurringTodoInput, out presenter.CommandResultPresenter) error {
+	aggregateUUID, err := uuid.Parse(input.AggregateID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	userID, err := value.NewUserID(input.UserID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	todoText, err := value.NewTodoText(input.Todo)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	rule, err := value.NewRecurrenceRule(input.Rule)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	startDate := value.DueDateOf(time.Now())
+	if input.StartDate != "" {
+		if startDate, err = value.NewDueDate(input.StartDate); err != nil {
+			return out.PresentError(ctx, err)
+		}
+	}
+
+	priority, err := value.NewPriority(input.Priority)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	cmd := command.ScheduleRecurringTodoCommand{
+		AggregateID: aggregateUUID,
+		UserID:      userID,
+		TodoText:    todoText,
+		Rule:        rule,
+		StartDate:   startDate,
+		Priority:    priority,
+	}
+
+	result, err := u.commandBus.Dispatch(ctx, cmd)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, result.AggregateID, result.Version, result.Events)
+}
@@ -0,0 +1,65 @@
+package command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/commandbus"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/presenter"
+)
+
+type TodoSetDueDateCommandInterface interface {
+	Execute(ctx context.Context, input *input.SetTodoDueDateInput, out presenter.CommandResultPresenter) error
+}
+
+type TodoSetDueDateCommand struct {
+	commandBus commandbus.Dispatcher
+}
+
+func NewTodoSetDueDateCommand(commandBus commandbus.Dispatcher) TodoSetDueDateCommandInterface {
+	return &TodoSetDueDateCommand{
+		commandBus: commandBus,
+	}
+}
+
+func (u *TodoSetDueDateCommand) Execute(ctx context.Context, input *input.SetTodoDueDateInput, out presenter.CommandResultPresenter) error {
+	aggregateUUID, err := uuid.Parse(input.AggregateID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	userID, err := value.NewUserID(input.UserID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	todoID, err := uuid.Parse(input.TodoID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "todo_id must be a valid UUID"))
+	}
+
+	var dueDate value.DueDate
+	if input.DueDate != "" {
+		if dueDate, err = value.NewDueDate(input.DueDate); err != nil {
+			return out.PresentError(ctx, err)
+		}
+	}
+
+	cmd := command.SetTodoDueDateCommand{
+		AggregateID: aggregateUUID,
+		UserID:      userID,
+		TodoID:      todoID,
+		DueDate:     dueDate,
+	}
+
+	result, err := u.commandBus.Dispatch(ctx, cmd)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, result.AggregateID, result.Version, result.Events)
+}
@@ -0,0 +1,63 @@
+package command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/commandbus"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/presenter"
+)
+
+type TodoSetPriorityCommandInterface interface {
+	Execute(ctx context.Context, input *input.SetTodoPriorityInput, out presenter.CommandResultPresenter) error
+}
+
+type TodoSetPriorityCommand struct {
+	commandBus commandbus.Dispatcher
+}
+
+func NewTodoSetPriorityCommand(commandBus commandbus.Dispatcher) TodoSetPriorityCommandInterface {
+	return &TodoSetPriorityCommand{
+		commandBus: commandBus,
+	}
+}
+
+func (u *TodoSetPriorityCommand) Execute(ctx context.Context, input *input.SetTodoPriorityInput, out presenter.CommandResultPresenter) error {
+	aggregateUUID, err := uuid.Parse(input.AggregateID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	userID, err := value.NewUserID(input.UserID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	todoID, err := uuid.Parse(input.TodoID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "todo_id must be a valid UUID"))
+	}
+
+	priority, err := value.NewPriority(input.Priority)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	cmd := command.SetTodoPriorityCommand{
+		AggregateID: aggregateUUID,
+		UserID:      userID,
+		TodoID:      todoID,
+		Priority:    priority,
+	}
+
+	result, err := u.commandBus.Dispatch(ctx, cmd)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, result.AggregateID, result.Version, result.Events)
+}
@@ -0,0 +1,63 @@
+package command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/commandbus"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/presenter"
+)
+
+type TodoTagCommandInterface interface {
+	Execute(ctx context.Context, input *input.TagTodoInput, out presenter.CommandResultPresenter) error
+}
+
+type TodoTagCommand struct {
+	commandBus commandbus.Dispatcher
+}
+
+func NewTodoTagCommand(commandBus commandbus.Dispatcher) TodoTagCommandInterface {
+	return &TodoTagCommand{
+		commandBus: commandBus,
+	}
+}
+
+func (u *TodoTagCommand) Execute(ctx context.Context, input *input.TagTodoInput, out presenter.CommandResultPresenter) error {
+	aggregateUUID, err := uuid.Parse(input.AggregateID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	userID, err := value.NewUserID(input.UserID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	todoID, err := uuid.Parse(input.TodoID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "todo_id must be a valid UUID"))
+	}
+
+	tag, err := value.NewTag(input.Tag)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	cmd := command.TagTodoCommand{
+		AggregateID: aggregateUUID,
+		UserID:      userID,
+		TodoID:      todoID,
+		Tag:         tag,
+	}
+
+	result, err := u.commandBus.Dispatch(ctx, cmd)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, result.AggregateID, result.Version, result.Events)
+}
@@ -0,0 +1,63 @@
+package command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/commandbus"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/presenter"
+)
+
+type TodoUntagCommandInterface interface {
+	Execute(ctx context.Context, input *input.UntagTodoInput, out presenter.CommandResultPresenter) error
+}
+
+type TodoUntagCommand struct {
+	commandBus commandbus.Dispatcher
+}
+
+func NewTodoUntagCommand(commandBus commandbus.Dispatcher) TodoUntagCommandInterface {
+	return &TodoUntagCommand{
+		commandBus: commandBus,
+	}
+}
+
+func (u *TodoUntagCommand) Execute(ctx context.Context, input *input.UntagTodoInput, out presenter.CommandResultPresenter) error {
+	aggregateUUID, err := uuid.Parse(input.AggregateID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	userID, err := value.NewUserID(input.UserID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	todoID, err := uuid.Parse(input.TodoID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "todo_id must be a valid UUID"))
+	}
+
+	tag, err := value.NewTag(input.Tag)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	cmd := command.UntagTodoCommand{
+		AggregateID: aggregateUUID,
+		UserID:      userID,
+		TodoID:      todoID,
+		Tag:         tag,
+	}
+
+	result, err := u.commandBus.Dispatch(ctx, cmd)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, result.AggregateID, result.Version, result.Events)
+}
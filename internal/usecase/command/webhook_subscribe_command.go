@@ -0,0 +1,115 @@
+package command
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/output"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/readmodelstore"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/webhookstore"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/webhookstore/dto"
+)
+
+var (
+	ErrWebhookEventTypeEmpty = errors.InvalidParameter.New("event_types cannot contain empty values")
+	ErrWebhookForeignList    = errors.UnpermittedOp.New("cannot subscribe to another user's todo list")
+	ErrWebhookDeletedList    = errors.Deleted.New("cannot subscribe to a deleted todo list")
+)
+
+type WebhookSubscribeCommandInterface interface {
+	Execute(ctx context.Context, input *input.SubscribeWebhookInput, out presenter.WebhookSubscriptionPresenter) error
+}
+
+type WebhookSubscribeCommand struct {
+	subscriptions webhookstore.WebhookSubscriptionStore
+	todoLists     readmodelstore.TodoListStore
+}
+
+func NewWebhookSubscribeCommand(subscriptions webhookstore.WebhookSubscriptionStore, todoLists readmodelstore.TodoListStore) WebhookSubscribeCommandInterface {
+	return &WebhookSubscribeCommand{
+		subscriptions: subscriptions,
+		todoLists:     todoLists,
+	}
+}
+
+func (u *WebhookSubscribeCommand) Execute(ctx context.Context, input *input.SubscribeWebhookInput, out presenter.WebhookSubscriptionPresenter) error {
+	sub, err := u.newSubscription(ctx, input)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	if err := u.subscriptions.Save(ctx, sub); err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, &output.SubscribeWebhookOutput{
+		SubscriptionID: sub.ID,
+		UserID:         sub.UserID,
+		AggregateID:    sub.AggregateID,
+		TargetURL:      sub.TargetURL,
+		EventTypes:     sub.EventTypes,
+		CreatedAt:      sub.CreatedAt,
+	})
+}
+
+func (u *WebhookSubscribeCommand) newSubscription(ctx context.Context, input *input.SubscribeWebhookInput) (*dto.WebhookSubscriptionDTO, error) {
+	userID, err := value.NewUserID(input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	targetURL, err := value.NewWebhookURL(input.TargetURL)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := value.NewWebhookSecret(input.Secret)
+	if err != nil {
+		return nil, err
+	}
+
+	eventTypes := make([]string, 0, len(input.EventTypes))
+	for _, t := range input.EventTypes {
+		trimmed := strings.TrimSpace(t)
+		if trimmed == "" {
+			return nil, ErrWebhookEventTypeEmpty
+		}
+		eventTypes = append(eventTypes, trimmed)
+	}
+
+	var aggregateID string
+	if input.AggregateID != "" {
+		aggregateUUID, err := uuid.Parse(input.AggregateID)
+		if err != nil {
+			return nil, errors.InvalidParameter.Wrap(err, "aggregate_id must be a valid UUID")
+		}
+
+		view, err := u.todoLists.Get(ctx, aggregateUUID.String())
+		if err != nil {
+			return nil, err
+		}
+		if view.UserID != userID.String() {
+			return nil, ErrWebhookForeignList
+		}
+		if view.Deleted {
+			return nil, ErrWebhookDeletedList
+		}
+		aggregateID = aggregateUUID.String()
+	}
+
+	return &dto.WebhookSubscriptionDTO{
+		ID:          uuid.New().String(),
+		UserID:      userID.String(),
+		AggregateID: aggregateID,
+		TargetURL:   targetURL.String(),
+		EventTypes:  eventTypes,
+		Secret:      secret.String(),
+		CreatedAt:   time.Now(),
+	}, nil
+}
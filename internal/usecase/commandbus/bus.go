@@ -0,0 +1,73 @@
+package commandbus
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+)
+
+// Result is what a handled command reports back: the aggregate it changed,
+// the aggregate's version afterwards and the events the command recorded.
+type Result struct {
+	AggregateID string
+	Version     int
+	Events      []event.Event
+	// Replayed is set when the result is the stored result of an earlier
+	// command with the same idempotency key; its events were recorded then.
+	Replayed bool
+}
+
+// HandlerFunc handles one domain command. Handlers registered on a Bus are
+// wrapped by its middleware, so they only run the command itself.
+type HandlerFunc func(ctx context.Context, cmd any) (*Result, error)
+
+// Middleware wraps a HandlerFunc, typically to run code around every command.
+type Middleware func(next HandlerFunc) HandlerFunc
+
+type Dispatcher interface {
+	Dispatch(ctx context.Context, cmd any) (*Result, error)
+}
+
+// Bus routes domain command structs to the handler registered for their type.
+type Bus struct {
+	handlers   map[reflect.Type]HandlerFunc
+	middleware []Middleware
+}
+
+// NewBus returns a Bus running every command through middleware, the first
+// one being the outermost.
+func NewBus(middleware ...Middleware) *Bus {
+	return &Bus{
+		handlers:   make(map[reflect.Type]HandlerFunc),
+		middleware: middleware,
+	}
+}
+
+// Register routes commands of type C to handler. A later registration for the
+// same type replaces the earlier one.
+func Register[C any](b *Bus, handler func(ctx context.Context, cmd C) (*Result, error)) {
+	next := func(ctx context.Context, cmd any) (*Result, error) {
+		return handler(ctx, cmd.(C))
+	}
+	for i := len(b.middleware) - 1; i >= 0; i-- {
+		next = b.middleware[i](next)
+	}
+	b.handlers[reflect.TypeFor[C]()] = next
+}
+
+func (b *Bus) Dispatch(ctx context.Context, cmd any) (*Result, error) {
+	handler, ok := b.handlers[reflect.TypeOf(cmd)]
+	if !ok {
+		return nil, errors.Unknown.New(fmt.Sprintf("no handler registered for %T", cmd))
+	}
+	return handler(ctx, cmd)
+}
+
+// CommandName returns the name middleware report cmd under, such as
+// "AddTodoCommand".
+func CommandName(cmd any) string {
+	return reflect.TypeOf(cmd).Name()
+}
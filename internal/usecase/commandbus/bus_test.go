@@ -0,0 +1,434 @@
+package commandbus_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/commandbus"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/idempotencystore/dto"
+)
+
+type pingCommand struct {
+	invalid bool
+}
+
+func (c pingCommand) Validate() error {
+	if c.invalid {
+		return errors.InvalidParameter.New("invalid ping")
+	}
+	return nil
+}
+
+type pongCommand struct{}
+
+type echoCommand struct {
+	Text string
+}
+
+// stampedCommand carries the time it was sent at, which retries of one
+// request do not share.
+type stampedCommand struct {
+	Text   string
+	SentAt time.Time
+}
+
+func (c stampedCommand) IdempotencyPayload() any {
+	return c.Text
+}
+
+type fakeTxKey struct{}
+
+// fakeTransaction runs callbacks registered with AfterCommit only when fn
+// succeeds, and joins a transaction already in ctx, like the database
+// transaction.
+type fakeTransaction struct {
+	commits int
+}
+
+func (f *fakeTransaction) RWTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if f.InTx(ctx) {
+		return fn(ctx)
+	}
+
+	var afterCommit []func() error
+	if err := fn(context.WithValue(ctx, fakeTxKey{}, &afterCommit)); err != nil {
+		return err
+	}
+	f.commits++
+	for _, cb := range afterCommit {
+		if err := cb(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *fakeTransaction) AfterCommit(ctx context.Context, fn func() error) error {
+	afterCommit, ok := ctx.Value(fakeTxKey{}).(*[]func() error)
+	if !ok {
+		return errors.Unknown.New("transaction not found in context")
+	}
+	*afterCommit = append(*afterCommit, fn)
+	return nil
+}
+
+func (f *fakeTransaction) InTx(ctx context.Context) bool {
+	return ctx.Value(fakeTxKey{}) != nil
+}
+
+type recordingPublisher struct {
+	published []event.Event
+}
+
+func (r *recordingPublisher) Publish(ctx context.Context, events ...event.Event) error {
+	r.published = append(r.published, events...)
+	return nil
+}
+
+type recordingMetrics struct {
+	conflicts int
+}
+
+func (r *recordingMetrics) ObserveCommand(command string, duration time.Duration, err error) {}
+
+func (r *recordingMetrics) ObserveConflict(command string) {
+	r.conflicts++
+}
+
+func TestBus_Dispatch(t *testing.T) {
+	tests := map[string]struct {
+		cmd           any
+		failures      int
+		joined        bool
+		wantErr       errors.ErrCode
+		wantCalls     int
+		wantCommits   int
+		wantEvents    int
+		wantAggregate string
+		wantConflicts int
+	}{
+		"routes a command to the handler of its type": {
+			cmd:           pingCommand{},
+			wantCalls:     1,
+			wantCommits:   1,
+			wantEvents:    1,
+			wantAggregate: "ping",
+		},
+		"retries commands that lost an optimistic-lock race": {
+			cmd:           pingCommand{},
+			failures:      2,
+			wantCalls:     3,
+			wantCommits:   1,
+			wantEvents:    1,
+			wantAggregate: "ping",
+			wantConflicts: 2,
+		},
+		"gives up after the last attempt": {
+			cmd:           pingCommand{},
+			failures:      3,
+			wantErr:       errors.OptimisticLock,
+			wantCalls:     3,
+			wantConflicts: 3,
+		},
+		"does not retry a command joining a transaction": {
+			cmd:           pingCommand{},
+			failures:      1,
+			joined:        true,
+			wantErr:       errors.OptimisticLock,
+			wantCalls:     1,
+			wantConflicts: 1,
+		},
+		"rejects invalid commands before their handler": {
+			cmd:     pingCommand{invalid: true},
+			wantErr: errors.InvalidParameter,
+		},
+		"rejects commands without a handler": {
+			cmd:     pongCommand{},
+			wantErr: errors.Unknown,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			tx := &fakeTransaction{}
+			publisher := &recordingPublisher{}
+			metrics := &recordingMetrics{}
+			bus := commandbus.NewBus(
+				commandbus.Validation(),
+				commandbus.Retry(commandbus.RetryPolicy{MaxAttempts: 3, BaseBackoff: time.Millisecond}, tx, metrics),
+				commandbus.Transaction(tx, publisher),
+			)
+			calls := 0
+			commandbus.Register(bus, func(ctx context.Context, cmd pingCommand) (*commandbus.Result, error) {
+				calls++
+				if calls <= tt.failures {
+					return nil, errors.OptimisticLock.New("version conflict")
+				}
+				return &commandbus.Result{AggregateID: "ping", Version: 1, Events: []event.Event{event.TodoListCreatedEvent{}}}, nil
+			})
+
+			// Act
+			var result *commandbus.Result
+			var err error
+			if tt.joined {
+				_ = tx.RWTx(context.Background(), func(ctx context.Context) error {
+					result, err = bus.Dispatch(ctx, tt.cmd)
+					return err
+				})
+			} else {
+				result, err = bus.Dispatch(context.Background(), tt.cmd)
+			}
+
+			// Assert
+			if tt.wantErr != "" {
+				require.True(t, errors.IsCode(err, tt.wantErr), "got %v", err)
+				require.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.wantAggregate, result.AggregateID)
+			}
+			require.Equal(t, tt.wantCalls, calls)
+			require.Equal(t, tt.wantCommits, tx.commits)
+			require.Len(t, publisher.published, tt.wantEvents)
+			require.Equal(t, tt.wantConflicts, metrics.conflicts)
+		})
+	}
+}
+
+func TestRetry_StopsWaitingWhenContextIsDone(t *testing.T) {
+	// Arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	bus := commandbus.NewBus(commandbus.Retry(commandbus.RetryPolicy{MaxAttempts: 3, BaseBackoff: time.Hour}, &fakeTransaction{}, &recordingMetrics{}))
+	calls := 0
+	commandbus.Register(bus, func(ctx context.Context, cmd pingCommand) (*commandbus.Result, error) {
+		calls++
+		cancel()
+		return nil, errors.OptimisticLock.New("version conflict")
+	})
+
+	// Act
+	_, err := bus.Dispatch(ctx, pingCommand{})
+
+	// Assert
+	require.True(t, errors.IsCode(err, errors.OptimisticLock), "got %v", err)
+	require.Equal(t, 1, calls)
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	tests := map[string]struct {
+		policy  commandbus.RetryPolicy
+		retry   int
+		wantMin time.Duration
+		wantMax time.Duration
+	}{
+		"waits the base backoff before the first retry": {
+			policy:  commandbus.RetryPolicy{BaseBackoff: 10 * time.Millisecond, MaxBackoff: time.Second},
+			retry:   1,
+			wantMin: 10 * time.Millisecond,
+			wantMax: 10 * time.Millisecond,
+		},
+		"doubles the backoff with every retry": {
+			policy:  commandbus.RetryPolicy{BaseBackoff: 10 * time.Millisecond, MaxBackoff: time.Second},
+			retry:   3,
+			wantMin: 40 * time.Millisecond,
+			wantMax: 40 * time.Millisecond,
+		},
+		"doubles the backoff without a cap when there is no maximum": {
+			policy:  commandbus.RetryPolicy{BaseBackoff: 10 * time.Millisecond, MaxBackoff: 0},
+			retry:   5,
+			wantMin: 160 * time.Millisecond,
+			wantMax: 160 * time.Millisecond,
+		},
+		"caps the backoff": {
+			policy:  commandbus.RetryPolicy{BaseBackoff: 10 * time.Millisecond, MaxBackoff: 25 * time.Millisecond},
+			retry:   10,
+			wantMin: 25 * time.Millisecond,
+			wantMax: 25 * time.Millisecond,
+		},
+		"takes up to the jitter fraction off": {
+			policy:  commandbus.RetryPolicy{BaseBackoff: 10 * time.Millisecond, MaxBackoff: time.Second, Jitter: 0.5},
+			retry:   2,
+			wantMin: 10 * time.Millisecond,
+			wantMax: 20 * time.Millisecond,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Act
+			backoff := tt.policy.Backoff(tt.retry)
+
+			// Assert
+			require.GreaterOrEqual(t, backoff, tt.wantMin)
+			require.LessOrEqual(t, backoff, tt.wantMax)
+		})
+	}
+}
+
+func TestBus_MiddlewareOrder(t *testing.T) {
+	// Arrange
+	var trace []string
+	record := func(name string) commandbus.Middleware {
+		return func(next commandbus.HandlerFunc) commandbus.HandlerFunc {
+			return func(ctx context.Context, cmd any) (*commandbus.Result, error) {
+				trace = append(trace, name+" before")
+				result, err := next(ctx, cmd)
+				trace = append(trace, name+" after")
+				return result, err
+			}
+		}
+	}
+	bus := commandbus.NewBus(record("outer"), record("inner"))
+	commandbus.Register(bus, func(ctx context.Context, cmd pongCommand) (*commandbus.Result, error) {
+		trace = append(trace, "handler")
+		return &commandbus.Result{}, nil
+	})
+
+	// Act
+	_, err := bus.Dispatch(context.Background(), pongCommand{})
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, []string{"outer before", "inner before", "handler", "inner after", "outer after"}, trace)
+}
+
+type memoryIdempotencyStore struct {
+	results map[string]*dto.IdempotentResultDTO
+}
+
+func (m *memoryIdempotencyStore) Find(ctx context.Context, userID, key string, now time.Time) (*dto.IdempotentResultDTO, error) {
+	result, ok := m.results[userID+"/"+key]
+	if !ok || !result.ExpiresAt.After(now) {
+		return nil, errors.NotFound.New("idempotency key not found")
+	}
+	return result, nil
+}
+
+func (m *memoryIdempotencyStore) Save(ctx context.Context, result *dto.IdempotentResultDTO) error {
+	m.results[result.UserID+"/"+result.Key] = result
+	return nil
+}
+
+func TestBus_Idempotency(t *testing.T) {
+	tests := map[string]struct {
+		first, second   context.Context
+		firstCmd        any
+		secondCmd       any
+		ttl             time.Duration
+		wantErr         error
+		wantCalls       int
+		wantPublished   int
+		wantSecondReply bool
+	}{
+		"answers a repeated key with the stored result": {
+			first:           commandbus.WithIdempotencyKey(context.Background(), "user123", "key-1"),
+			second:          commandbus.WithIdempotencyKey(context.Background(), "user123", "key-1"),
+			secondCmd:       pingCommand{},
+			ttl:             time.Hour,
+			wantCalls:       1,
+			wantPublished:   1,
+			wantSecondReply: true,
+		},
+		"keys are scoped to the user": {
+			first:         commandbus.WithIdempotencyKey(context.Background(), "user123", "key-1"),
+			second:        commandbus.WithIdempotencyKey(context.Background(), "user456", "key-1"),
+			secondCmd:     pingCommand{},
+			ttl:           time.Hour,
+			wantCalls:     2,
+			wantPublished: 2,
+		},
+		"runs the command again once the key expired": {
+			first:         commandbus.WithIdempotencyKey(context.Background(), "user123", "key-1"),
+			second:        commandbus.WithIdempotencyKey(context.Background(), "user123", "key-1"),
+			secondCmd:     pingCommand{},
+			ttl:           -time.Second,
+			wantCalls:     2,
+			wantPublished: 2,
+		},
+		"rejects a key reused for another command": {
+			first:         commandbus.WithIdempotencyKey(context.Background(), "user123", "key-1"),
+			second:        commandbus.WithIdempotencyKey(context.Background(), "user123", "key-1"),
+			secondCmd:     pongCommand{},
+			ttl:           time.Hour,
+			wantErr:       commandbus.ErrIdempotencyKeyReused,
+			wantCalls:     1,
+			wantPublished: 1,
+		},
+		"rejects a key reused for the same command with another payload": {
+			first:         commandbus.WithIdempotencyKey(context.Background(), "user123", "key-1"),
+			second:        commandbus.WithIdempotencyKey(context.Background(), "user123", "key-1"),
+			firstCmd:      echoCommand{Text: "Buy milk"},
+			secondCmd:     echoCommand{Text: "Buy eggs"},
+			ttl:           time.Hour,
+			wantErr:       commandbus.ErrIdempotencyKeyReused,
+			wantCalls:     1,
+			wantPublished: 1,
+		},
+		"compares only the payload a command names": {
+			first:           commandbus.WithIdempotencyKey(context.Background(), "user123", "key-1"),
+			second:          commandbus.WithIdempotencyKey(context.Background(), "user123", "key-1"),
+			firstCmd:        stampedCommand{Text: "Buy milk", SentAt: time.Now()},
+			secondCmd:       stampedCommand{Text: "Buy milk", SentAt: time.Now().Add(time.Second)},
+			ttl:             time.Hour,
+			wantCalls:       1,
+			wantPublished:   1,
+			wantSecondReply: true,
+		},
+		"runs every command sent without a key": {
+			first:         context.Background(),
+			second:        context.Background(),
+			secondCmd:     pingCommand{},
+			ttl:           time.Hour,
+			wantCalls:     2,
+			wantPublished: 2,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			tx := &fakeTransaction{}
+			publisher := &recordingPublisher{}
+			bus := commandbus.NewBus(
+				commandbus.Transaction(tx, publisher),
+				commandbus.Idempotency(&memoryIdempotencyStore{results: map[string]*dto.IdempotentResultDTO{}}, tt.ttl),
+			)
+			calls := 0
+			handler := func(ctx context.Context, cmd any) (*commandbus.Result, error) {
+				calls++
+				return &commandbus.Result{AggregateID: "ping", Version: calls, Events: []event.Event{event.TodoListCreatedEvent{}}}, nil
+			}
+			commandbus.Register(bus, func(ctx context.Context, cmd pingCommand) (*commandbus.Result, error) { return handler(ctx, cmd) })
+			commandbus.Register(bus, func(ctx context.Context, cmd pongCommand) (*commandbus.Result, error) { return handler(ctx, cmd) })
+			commandbus.Register(bus, func(ctx context.Context, cmd echoCommand) (*commandbus.Result, error) { return handler(ctx, cmd) })
+			commandbus.Register(bus, func(ctx context.Context, cmd stampedCommand) (*commandbus.Result, error) { return handler(ctx, cmd) })
+			firstCmd := tt.firstCmd
+			if firstCmd == nil {
+				firstCmd = pingCommand{}
+			}
+			first, err := bus.Dispatch(tt.first, firstCmd)
+			require.NoError(t, err)
+
+			// Act
+			second, err := bus.Dispatch(tt.second, tt.secondCmd)
+
+			// Assert
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.wantSecondReply, second.Replayed)
+				if tt.wantSecondReply {
+					require.Equal(t, first.Version, second.Version)
+				}
+			}
+			require.Equal(t, tt.wantCalls, calls)
+			require.Len(t, publisher.published, tt.wantPublished)
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package commandbus
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/idempotencystore"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/idempotencystore/dto"
+)
+
+var ErrIdempotencyKeyReused = errors.InvalidParameter.New("the idempotency key was already used for a different command")
+
+// IdempotencyPayloader is implemented by commands carrying values, such as the
+// time they were sent at, that differ between retries of one request.
+// Idempotency compares the payload they return instead of the whole command.
+type IdempotencyPayloader interface {
+	IdempotencyPayload() any
+}
+
+type idempotencyKeyContextKey struct{}
+
+type idempotencyKey struct {
+	userID string
+	key    string
+}
+
+// WithIdempotencyKey returns a copy of ctx in which commands are deduplicated
+// by key. Keys are scoped to the user who sent them.
+func WithIdempotencyKey(ctx context.Context, userID, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyContextKey{}, idempotencyKey{userID: userID, key: key})
+}
+
+// ScopeIdempotencyKey returns a copy of ctx in which the idempotency key, if
+// there is one, is narrowed to scope, so that each of several commands sent in
+// one request gets a key of its own.
+func ScopeIdempotencyKey(ctx context.Context, scope string) context.Context {
+	key, ok := ctx.Value(idempotencyKeyContextKey{}).(idempotencyKey)
+	if !ok {
+		return ctx
+	}
+	return WithIdempotencyKey(ctx, key.userID, key.key+"/"+scope)
+}
+
+// Idempotency stores the result of each command dispatched with an
+// idempotency key, and answers a command sent again with the same key with
+// the stored result instead of running it. A key sent again with another
+// command, or the same command with another payload, is rejected with
+// ErrIdempotencyKeyReused. It must run inside Transaction so that the key is
+// stored together with the command's events. Stored results expire after ttl.
+func Idempotency(store idempotencystore.IdempotencyStore, ttl time.Duration) Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, cmd any) (*Result, error) {
+			key, ok := ctx.Value(idempotencyKeyContextKey{}).(idempotencyKey)
+			if !ok {
+				return next(ctx, cmd)
+			}
+
+			hash, err := payloadHash(cmd)
+			if err != nil {
+				return nil, err
+			}
+
+			now := time.Now()
+			stored, err := store.Find(ctx, key.userID, key.key, now)
+			switch {
+			case err == nil:
+				if stored.Command != CommandName(cmd) || stored.PayloadHash != hash {
+					return nil, ErrIdempotencyKeyReused
+				}
+				return &Result{
+					AggregateID: stored.AggregateID,
+					Version:     stored.Version,
+					Events:      stored.Events,
+					Replayed:    true,
+				}, nil
+			case !errors.IsCode(err, errors.NotFound):
+				return nil, err
+			}
+
+			result, err := next(ctx, cmd)
+			if err != nil {
+				return nil, err
+			}
+
+			// A concurrent request with the same key makes Save fail with an
+			// optimistic-lock error, so Retry runs the command again and it is
+			// answered with the other request's result.
+			if err := store.Save(ctx, &dto.IdempotentResultDTO{
+				UserID:      key.userID,
+				Key:         key.key,
+				Command:     CommandName(cmd),
+				PayloadHash: hash,
+				AggregateID: result.AggregateID,
+				Version:     result.Version,
+				Events:      result.Events,
+				CreatedAt:   now,
+				ExpiresAt:   now.Add(ttl),
+			}); err != nil {
+				return nil, err
+			}
+
+			return result, nil
+		}
+	}
+}
+
+// payloadHash returns the SHA-256 of cmd's JSON encoding, or of the payload it
+// names if it is an IdempotencyPayloader.
+func payloadHash(cmd any) (string, error) {
+	payload := cmd
+	if p, ok := cmd.(IdempotencyPayloader); ok {
+		payload = p.IdempotencyPayload()
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
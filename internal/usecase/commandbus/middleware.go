@@ -0,0 +1,89 @@
+package commandbus
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/gateway"
+)
+
+// Validator is implemented by commands that can check themselves before they
+// reach their handler.
+type Validator interface {
+	Validate() error
+}
+
+// Transaction runs each command in a read-write transaction and publishes the
+// events it recorded once the transaction has committed. Replayed results are
+// not published again.
+func Transaction(tx repository.Transaction, eventBus gateway.EventPublisher) Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, cmd any) (*Result, error) {
+			var result *Result
+			err := tx.RWTx(ctx, func(ctx context.Context) error {
+				var err error
+				result, err = next(ctx, cmd)
+				if err != nil {
+					return err
+				}
+
+				if evs := result.Events; len(evs) > 0 && !result.Replayed {
+					return tx.AfterCommit(ctx, func() error {
+						return eventBus.Publish(context.Background(), evs...)
+					})
+				}
+
+				return nil
+			})
+			if err != nil {
+				return nil, err
+			}
+			return result, nil
+		}
+	}
+}
+
+// Validation rejects commands whose Validate method fails before they reach
+// their handler. Commands that do not implement Validator pass through.
+func Validation() Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, cmd any) (*Result, error) {
+			if v, ok := cmd.(Validator); ok {
+				if err := v.Validate(); err != nil {
+					return nil, err
+				}
+			}
+			return next(ctx, cmd)
+		}
+	}
+}
+
+// Logging logs every command with its outcome and how long it took.
+func Logging() Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, cmd any) (*Result, error) {
+			start := time.Now()
+			result, err := next(ctx, cmd)
+			if err != nil {
+				log.Printf("command %s failed after %s: %v", CommandName(cmd), time.Since(start), err)
+			} else {
+				log.Printf("command %s handled in %s", CommandName(cmd), time.Since(start))
+			}
+			return result, err
+		}
+	}
+}
+
+// Metrics reports every command, its duration and its error to metrics.
+func Metrics(metrics gateway.CommandMetrics) Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, cmd any) (*Result, error) {
+			start := time.Now()
+			result, err := next(ctx, cmd)
+			metrics.ObserveCommand(CommandName(cmd), time.Since(start), err)
+			return result, err
+		}
+	}
+}
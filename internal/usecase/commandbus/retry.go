@@ -0,0 +1,77 @@
+package commandbus
+
+import (
+	"context"
+	"math"
+	"math/rand/v2"
+	"time"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/gateway"
+)
+
+// RetryPolicy says how often and how patiently a command that lost an
+// optimistic-lock race is run again.
+type RetryPolicy struct {
+	// MaxAttempts is how many times a command runs in all, the first run
+	// included. Values below 1 mean a single run.
+	MaxAttempts int
+	// BaseBackoff is the wait before the first retry; it doubles with every
+	// further retry up to MaxBackoff, or without a cap when MaxBackoff is 0.
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+	// Jitter is the fraction of each wait, between 0 and 1, that is taken off
+	// at random so that conflicting commands do not retry in lockstep.
+	Jitter float64
+}
+
+// Backoff returns how long to wait before the given retry, counting from 1.
+func (p RetryPolicy) Backoff(retry int) time.Duration {
+	backoff := p.BaseBackoff
+	for i := 1; i < retry && (p.MaxBackoff <= 0 || backoff < p.MaxBackoff) && backoff <= math.MaxInt64/2; i++ {
+		backoff *= 2
+	}
+	if p.MaxBackoff > 0 && backoff > p.MaxBackoff {
+		backoff = p.MaxBackoff
+	}
+	if p.Jitter > 0 && backoff > 0 {
+		backoff -= time.Duration(rand.Float64() * min(p.Jitter, 1) * float64(backoff))
+	}
+	return backoff
+}
+
+// Retry runs a command again as policy allows when it lost an optimistic-lock
+// race with another command on the same aggregate, and reports every conflict
+// to metrics. It must wrap Transaction so that each attempt starts from a
+// fresh transaction. When ctx is done while waiting, the conflict is returned.
+//
+// Commands joining a transaction already in ctx, such as those of an atomic
+// batch, run once: another attempt would read the same snapshot and conflict
+// again.
+func Retry(policy RetryPolicy, tx repository.Transaction, metrics gateway.CommandMetrics) Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, cmd any) (*Result, error) {
+			joined := tx.InTx(ctx)
+			for retry := 1; ; retry++ {
+				result, err := next(ctx, cmd)
+				if err == nil || !errors.IsCode(err, errors.OptimisticLock) {
+					return result, err
+				}
+
+				metrics.ObserveConflict(CommandName(cmd))
+				if joined || retry >= policy.MaxAttempts {
+					return nil, err
+				}
+
+				timer := time.NewTimer(policy.Backoff(retry))
+				select {
+				case <-ctx.Done():
+					timer.Stop()
+					return nil, err
+				case <-timer.C:
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,9 @@
+package gateway
+
+import "time"
+
+// Clock tells the time, so that use cases acting on schedules can be tested
+// with a fake one.
+type Clock interface {
+	Now() time.Time
+}
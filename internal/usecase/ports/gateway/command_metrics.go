@@ -0,0 +1,12 @@
+package gateway
+
+import "time"
+
+// CommandMetrics records how often commands run, how long they take, how
+// often they fail and how often they conflict with another command.
+type CommandMetrics interface {
+	ObserveCommand(command string, duration time.Duration, err error)
+	// ObserveConflict records one attempt of command that lost an
+	// optimistic-lock race, whether or not it is retried.
+	ObserveConflict(command string)
+}
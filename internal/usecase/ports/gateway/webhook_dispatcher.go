@@ -0,0 +1,12 @@
+package gateway
+
+import (
+	"context"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+)
+
+type WebhookDispatcher interface {
+	Handle(ctx context.Context, e event.Event) error
+	Start(ctx context.Context, bus EventSubscriber) error
+}
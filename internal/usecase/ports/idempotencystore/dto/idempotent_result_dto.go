@@ -0,0 +1,23 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+)
+
+// IdempotentResultDTO is the result of a command kept under the idempotency
+// key the user sent it with.
+type IdempotentResultDTO struct {
+	UserID  string
+	Key     string
+	Command string
+	// PayloadHash identifies the command's payload, so that a key sent again
+	// with other values can be told apart.
+	PayloadHash string
+	AggregateID string
+	Version     int
+	Events      []event.Event
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+}
@@ -0,0 +1,20 @@
+package idempotencystore
+
+import (
+	"context"
+	"time"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/idempotencystore/dto"
+)
+
+// IdempotencyStore keeps command results by idempotency key. It works in the
+// transaction in ctx, so a result is stored if and only if the command's
+// events are.
+type IdempotencyStore interface {
+	// Find returns the result userID stored under key, or a NotFound error if
+	// there is none or it expired before now.
+	Find(ctx context.Context, userID, key string, now time.Time) (*dto.IdempotentResultDTO, error)
+	// Save stores result, replacing an expired one under the same key. It
+	// fails with an OptimisticLock error if the key is in use.
+	Save(ctx context.Context, result *dto.IdempotentResultDTO) error
+}
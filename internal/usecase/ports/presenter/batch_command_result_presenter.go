@@ -0,0 +1,12 @@
+package presenter
+
+import (
+	"context"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/output"
+)
+
+type BatchCommandResultPresenter interface {
+	PresentResults(ctx context.Context, results []output.BatchCommandOutput) error
+	PresentError(ctx context.Context, err error) error
+}
@@ -0,0 +1,13 @@
+package presenter
+
+import (
+	"context"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/output"
+)
+
+type ScheduledCommandPresenter interface {
+	PresentScheduled(ctx context.Context, output *output.ScheduledCommandOutput) error
+	PresentCancelled(ctx context.Context, output *output.ScheduledCommandOutput) error
+	PresentError(ctx context.Context, err error) error
+}
@@ -0,0 +1,12 @@
+package presenter
+
+import (
+	"context"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/query/output"
+)
+
+type TodoListHistoryPresenter interface {
+	Present(ctx context.Context, output *output.GetTodoListHistoryOutput) error
+	PresentError(ctx context.Context, err error) error
+}
@@ -8,6 +8,7 @@ import (
 
 type TodoListPresenter interface {
 	Present(ctx context.Context, output *output.GetTodoListOutput) error
+	PresentStale(ctx context.Context, output *output.GetTodoListOutput) error
 	PresentNotFound(ctx context.Context, err error) error
 	PresentError(ctx context.Context, err error) error
 }
@@ -0,0 +1,12 @@
+package presenter
+
+import (
+	"context"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/query/output"
+)
+
+type UserTagsPresenter interface {
+	Present(ctx context.Context, output *output.ListUserTagsOutput) error
+	PresentError(ctx context.Context, err error) error
+}
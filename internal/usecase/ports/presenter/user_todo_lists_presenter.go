@@ -0,0 +1,12 @@
+package presenter
+
+import (
+	"context"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/query/output"
+)
+
+type UserTodoListsPresenter interface {
+	Present(ctx context.Context, output *output.ListUserTodoListsOutput) error
+	PresentError(ctx context.Context, err error) error
+}
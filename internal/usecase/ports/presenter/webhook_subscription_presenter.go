@@ -0,0 +1,12 @@
+package presenter
+
+import (
+	"context"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/output"
+)
+
+type WebhookSubscriptionPresenter interface {
+	PresentSuccess(ctx context.Context, output *output.SubscribeWebhookOutput) error
+	PresentError(ctx context.Context, err error) error
+}
@@ -0,0 +1,26 @@
+package dto
+
+import "time"
+
+// ProcessStepDTO is one step of a process instance: how it reacted to the
+// event or wake-up named by Trigger. An instance's state is rebuilt by
+// replaying its steps in order.
+type ProcessStepDTO struct {
+	Process       string
+	CorrelationID string
+	Version       int
+	Trigger       string
+	Set           map[string]string
+	Schedule      []WakeUpDTO
+	Cancel        []string
+	Done          bool
+	RecordedAt    time.Time
+}
+
+// WakeUpDTO is a pending wake-up of a process instance.
+type WakeUpDTO struct {
+	Process       string
+	CorrelationID string
+	Name          string
+	At            time.Time
+}
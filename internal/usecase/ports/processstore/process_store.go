@@ -0,0 +1,29 @@
+package processstore
+
+import (
+	"context"
+	"time"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/processstore/dto"
+)
+
+// ProcessStore keeps the steps of process instances and the wake-ups they
+// are waiting for. It works in the transaction in ctx.
+type ProcessStore interface {
+	// Load returns the steps of an instance in order; a new instance has
+	// none.
+	Load(ctx context.Context, process, correlationID string) ([]*dto.ProcessStepDTO, error)
+	// Append stores step and updates the instance's pending wake-ups: those
+	// in Cancel are dropped, those in Schedule replace any with the same
+	// name, and all are dropped when Done is set. It fails with an
+	// OptimisticLock error if the instance already has a step with the same
+	// version.
+	Append(ctx context.Context, step *dto.ProcessStepDTO) error
+	// DueWakeUps returns the pending wake-ups due at now, earliest first.
+	DueWakeUps(ctx context.Context, now time.Time) ([]*dto.WakeUpDTO, error)
+	// Checkpoint returns the position of the last stored event process has
+	// been delivered, or 0 when it has none.
+	Checkpoint(ctx context.Context, process string) (int64, error)
+	// SaveCheckpoint sets the checkpoint of process to position.
+	SaveCheckpoint(ctx context.Context, process string, position int64) error
+}
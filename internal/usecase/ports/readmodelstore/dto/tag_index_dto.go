@@ -0,0 +1,29 @@
+package dto
+
+// TodoListTagsDTO is the tag index of one todo list: for every tag, the IDs
+// of the todos carrying it.
+type TodoListTagsDTO struct {
+	AggregateID   string
+	UserID        string
+	Collaborators []CollaboratorViewDTO
+	TodoIDsByTag  map[string][]string
+}
+
+// IsMember reports whether userID owns the list or collaborates on it.
+func (v *TodoListTagsDTO) IsMember(userID string) bool {
+	if v.UserID == userID {
+		return true
+	}
+	for _, c := range v.Collaborators {
+		if c.UserID == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// TagCountDTO is a tag together with the number of todos carrying it.
+type TagCountDTO struct {
+	Tag       string
+	TodoCount int
+}
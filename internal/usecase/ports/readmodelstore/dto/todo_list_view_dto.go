@@ -5,11 +5,96 @@ import "time"
 type TodoListViewDTO struct {
 	AggregateID string
 	UserID      string
-	Items       []TodoItemViewDTO
-	Version     int
-	UpdatedAt   time.Time
+	Title       string
+	Description string
+	Archived    bool
+	// Deleted marks a tombstone: the list is kept only so queries can tell a
+	// deleted list from one that never existed.
+	Deleted       bool
+	Collaborators []CollaboratorViewDTO
+	Items         []TodoItemViewDTO
+	// RecurringTodos are the templates the scheduler adds todos from.
+	RecurringTodos []RecurringTodoViewDTO
+	Version        int
+	UpdatedAt      time.Time
 }
 
+// IsMember reports whether userID owns the list or collaborates on it.
+func (v *TodoListViewDTO) IsMember(userID string) bool {
+	if v.UserID == userID {
+		return true
+	}
+	for _, c := range v.Collaborators {
+		if c.UserID == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// TodoItemViewDTO is a todo as projected. Items are kept in the list's
+// stored order.
 type TodoItemViewDTO struct {
-	Text string
+	ID       string
+	Text     string
+	DueDate  string
+	Priority string
+	Tags     []string
+	Overdue  bool
+	// Completed is set explicitly or derived from the checklist by the
+	// aggregate; the projection only records it.
+	Completed bool
+	Checklist []ChecklistItemViewDTO
+	// RecurrenceID links an occurrence to its recurring todo.
+	RecurrenceID string
+}
+
+type RecurringTodoViewDTO struct {
+	ID        string
+	Text      string
+	Rule      string
+	StartDate string
+	Priority  string
+	// LastOccurrence is the due date of the latest todo added for it.
+	LastOccurrence string
+}
+
+type ChecklistItemViewDTO struct {
+	ID        string
+	Text      string
+	Completed bool
+}
+
+type CollaboratorViewDTO struct {
+	UserID string
+	Role   string
+}
+
+// WithCollaborator returns a copy of collaborators in which userID holds role,
+// appending the user if it is not listed yet.
+func WithCollaborator(collaborators []CollaboratorViewDTO, userID, role string) []CollaboratorViewDTO {
+	updated := make([]CollaboratorViewDTO, 0, len(collaborators)+1)
+	found := false
+	for _, c := range collaborators {
+		if c.UserID == userID {
+			c.Role = role
+			found = true
+		}
+		updated = append(updated, c)
+	}
+	if !found {
+		updated = append(updated, CollaboratorViewDTO{UserID: userID, Role: role})
+	}
+	return updated
+}
+
+// WithoutCollaborator returns a copy of collaborators without userID.
+func WithoutCollaborator(collaborators []CollaboratorViewDTO, userID string) []CollaboratorViewDTO {
+	updated := make([]CollaboratorViewDTO, 0, len(collaborators))
+	for _, c := range collaborators {
+		if c.UserID != userID {
+			updated = append(updated, c)
+		}
+	}
+	return updated
 }
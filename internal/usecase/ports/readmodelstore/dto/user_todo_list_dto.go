@@ -0,0 +1,46 @@
+package dto
+
+import "time"
+
+const (
+	SortByCreatedAt = "created_at"
+	SortByUpdatedAt = "updated_at"
+)
+
+type UserTodoListDTO struct {
+	AggregateID   string
+	UserID        string
+	Collaborators []CollaboratorViewDTO
+	ItemCount     int
+	Archived      bool
+	Version       int
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// RoleOf returns the role userID holds on the list, or "" if it has none.
+func (v *UserTodoListDTO) RoleOf(userID string) string {
+	if v.UserID == userID {
+		return "owner"
+	}
+	for _, c := range v.Collaborators {
+		if c.UserID == userID {
+			return c.Role
+		}
+	}
+	return ""
+}
+
+type UserTodoListQuery struct {
+	UserID          string
+	SortBy          string
+	Descending      bool
+	IncludeArchived bool
+	Limit           int
+	Offset          int
+}
+
+type UserTodoListPage struct {
+	TodoLists []*UserTodoListDTO
+	Total     int
+}
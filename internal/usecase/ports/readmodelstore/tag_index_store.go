@@ -0,0 +1,16 @@
+package readmodelstore
+
+import (
+	"context"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/readmodelstore/dto"
+)
+
+type TagIndexStore interface {
+	Get(ctx context.Context, aggregateID string) (*dto.TodoListTagsDTO, error)
+	Upsert(ctx context.Context, view *dto.TodoListTagsDTO) error
+	Delete(ctx context.Context, aggregateID string) error
+	// ListByUserID returns the tags used on the lists userID owns or
+	// collaborates on, most used first.
+	ListByUserID(ctx context.Context, userID string) ([]dto.TagCountDTO, error)
+}
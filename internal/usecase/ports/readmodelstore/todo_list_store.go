@@ -10,3 +10,9 @@ type TodoListStore interface {
 	Get(ctx context.Context, aggregateID string) (*dto.TodoListViewDTO, error)
 	Upsert(ctx context.Context, aggregateID string, view *dto.TodoListViewDTO) error
 }
+
+// OverdueTodoFinder lists the todo lists holding todos that were due before
+// today (a YYYY-MM-DD date) and have not been reported as overdue yet.
+type OverdueTodoFinder interface {
+	ListIDsWithOverdueTodos(ctx context.Context, today string) ([]string, error)
+}
@@ -0,0 +1,15 @@
+package readmodelstore
+
+import (
+	"context"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/readmodelstore/dto"
+)
+
+type UserTodoListStore interface {
+	Get(ctx context.Context, aggregateID string) (*dto.UserTodoListDTO, error)
+	Upsert(ctx context.Context, view *dto.UserTodoListDTO) error
+	Delete(ctx context.Context, aggregateID string) error
+	ListByUserID(ctx context.Context, query dto.UserTodoListQuery) (*dto.UserTodoListPage, error)
+	ListSharedWithUserID(ctx context.Context, query dto.UserTodoListQuery) (*dto.UserTodoListPage, error)
+}
@@ -0,0 +1,34 @@
+package dto
+
+import "time"
+
+const (
+	ScheduledCommandPending   = "pending"
+	ScheduledCommandRunning   = "running"
+	ScheduledCommandSucceeded = "succeeded"
+	ScheduledCommandFailed    = "failed"
+	ScheduledCommandCancelled = "cancelled"
+)
+
+// ScheduledCommandDTO is a create_todo_list or add_todo command to be run on
+// behalf of UserID at RunAt. Result fields are set once it succeeded.
+type ScheduledCommandDTO struct {
+	ID          string
+	UserID      string
+	Type        string
+	AggregateID string
+	Title       string
+	Description string
+	Todo        string
+	DueDate     string
+	Priority    string
+	RunAt       time.Time
+	Status      string
+	// Attempts counts the runs that failed; LastError is the latest failure.
+	Attempts          int
+	LastError         string
+	ResultAggregateID string
+	ResultVersion     int
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
@@ -0,0 +1,26 @@
+package schedulestore
+
+import (
+	"context"
+	"time"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/schedulestore/dto"
+)
+
+// ScheduledCommandStore keeps scheduled commands. It works in the transaction
+// in ctx.
+type ScheduledCommandStore interface {
+	Save(ctx context.Context, cmd *dto.ScheduledCommandDTO) error
+	// Update stores cmd's status, attempts, error and result, provided its
+	// stored status is still from. It fails with an OptimisticLock error
+	// otherwise, so that a command is not both cancelled and run.
+	Update(ctx context.Context, cmd *dto.ScheduledCommandDTO, from string) error
+	// Get returns a NotFound error for an unknown id.
+	Get(ctx context.Context, id string) (*dto.ScheduledCommandDTO, error)
+	// ListByUserID returns the user's scheduled commands by run time.
+	ListByUserID(ctx context.Context, userID string) ([]*dto.ScheduledCommandDTO, error)
+	// ListDue returns up to limit pending commands due at now, and running
+	// ones last updated before staleBefore, whose run was interrupted; the
+	// earliest come first.
+	ListDue(ctx context.Context, now, staleBefore time.Time, limit int) ([]*dto.ScheduledCommandDTO, error)
+}
@@ -0,0 +1,45 @@
+package dto
+
+import "time"
+
+type WebhookSubscriptionDTO struct {
+	ID          string
+	UserID      string
+	AggregateID string
+	TargetURL   string
+	EventTypes  []string
+	Secret      string
+	CreatedAt   time.Time
+}
+
+// Matches reports whether the subscription wants the given event.
+// An empty AggregateID subscribes to every list of the user, and an empty
+// EventTypes filter subscribes to every event type.
+func (s *WebhookSubscriptionDTO) Matches(aggregateID, eventType string) bool {
+	if s.AggregateID != "" && s.AggregateID != aggregateID {
+		return false
+	}
+
+	if len(s.EventTypes) == 0 {
+		return true
+	}
+
+	for _, t := range s.EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+type WebhookDeliveryDTO struct {
+	ID             string
+	SubscriptionID string
+	EventID        string
+	EventType      string
+	Attempt        int
+	StatusCode     int
+	Error          string
+	Succeeded      bool
+	AttemptedAt    time.Time
+}
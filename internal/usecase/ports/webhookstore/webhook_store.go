@@ -0,0 +1,17 @@
+package webhookstore
+
+import (
+	"context"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/webhookstore/dto"
+)
+
+type WebhookSubscriptionStore interface {
+	Save(ctx context.Context, subscription *dto.WebhookSubscriptionDTO) error
+	FindByUserID(ctx context.Context, userID string) ([]*dto.WebhookSubscriptionDTO, error)
+}
+
+type WebhookDeliveryLog interface {
+	Append(ctx context.Context, delivery *dto.WebhookDeliveryDTO) error
+	ListBySubscriptionID(ctx context.Context, subscriptionID string) ([]*dto.WebhookDeliveryDTO, error)
+}
@@ -0,0 +1,422 @@
+package processmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/commandbus"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/gateway"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/processstore"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/processstore/dto"
+)
+
+// Manager delivers events and due wake-ups to the instances of its processes,
+// one at a time and in order, and records each instance's steps.
+//
+// Delivery is at least once: events published while the application ran are
+// queued in memory, the events stored after the checkpoint of a process are
+// delivered again by Start, and failed deliveries are retried by RunOnce. Every step records its trigger, so an
+// instance reacts to each event and wake-up only once, and the commands of a
+// step carry idempotency keys derived from its trigger, so a step that failed
+// after some of its commands ran does not run them twice.
+type Manager struct {
+	store       processstore.ProcessStore
+	tx          repository.Transaction
+	eventStore  repository.EventStore
+	processes   []Process
+	maxAttempts int
+
+	// drainMu lets only one goroutine run deliveries, so that the steps of an
+	// instance are recorded in order.
+	drainMu sync.Mutex
+	mu      sync.Mutex
+	queue   []*delivery
+	failed  []*delivery
+	wake    chan struct{}
+
+	// checkpoints are the checkpoints of the processes as last saved, and
+	// head is the position of the last stored event found by the previous
+	// RunOnce, or by Start; see advance.
+	checkpoints map[string]int64
+	head        int64
+	// stalled marks the processes that gave up on an event; their
+	// checkpoints stay put so that the next Start delivers it again.
+	stalled map[string]bool
+}
+
+// delivery is an event or a wake-up on its way to a process instance.
+type delivery struct {
+	process       Process
+	correlationID string
+	event         event.Event
+	wakeUp        *WakeUp
+	attempts      int
+}
+
+func (d *delivery) key() string {
+	return d.process.Name() + "/" + d.correlationID + "/" + d.trigger()
+}
+
+func (d *delivery) trigger() string {
+	if d.wakeUp != nil {
+		return fmt.Sprintf("wake-up:%s@%s", d.wakeUp.Name, d.wakeUp.At.UTC().Format(time.RFC3339Nano))
+	}
+	return "event:" + d.event.GetEventID().String()
+}
+
+// NewManager returns a Manager running processes. A delivery that failed
+// maxAttempts times is given up.
+func NewManager(store processstore.ProcessStore, tx repository.Transaction, eventStore repository.EventStore, maxAttempts int, processes ...Process) *Manager {
+	return &Manager{
+		store:       store,
+		tx:          tx,
+		eventStore:  eventStore,
+		processes:   processes,
+		maxAttempts: maxAttempts,
+		wake:        make(chan struct{}, 1),
+		checkpoints: map[string]int64{},
+		stalled:     map[string]bool{},
+	}
+}
+
+// Handle queues e for the process instances it is for. It never fails, so
+// that a failing process does not fail the command that published e.
+func (m *Manager) Handle(ctx context.Context, e event.Event) error {
+	for _, process := range m.processes {
+		if correlationID, ok := process.Correlate(e); ok {
+			m.enqueue(&delivery{process: process, correlationID: correlationID, event: e})
+		}
+	}
+	return nil
+}
+
+// Start subscribes to bus, queues the events stored after the checkpoint of
+// each process so that its instances catch up on those published while the
+// application was not running, and runs the queued deliveries in the
+// background until ctx is done.
+func (m *Manager) Start(ctx context.Context, bus gateway.EventSubscriber) error {
+	if len(m.processes) == 0 {
+		return nil
+	}
+
+	bus.Subscribe(m.Handle)
+
+	// The head is read in the same transaction as the events, so that it is
+	// the position of the last event queued here.
+	var head int64
+	checkpoints := make(map[string]int64, len(m.processes))
+	backlogs := make([][]event.Event, len(m.processes))
+	err := m.tx.RWTx(ctx, func(ctx context.Context) error {
+		var err error
+		if head, err = m.eventStore.LastPosition(ctx); err != nil {
+			return err
+		}
+		for i, process := range m.processes {
+			checkpoint, err := m.store.Checkpoint(ctx, process.Name())
+			if err != nil {
+				return err
+			}
+			checkpoints[process.Name()] = checkpoint
+			if backlogs[i], err = m.eventStore.GetEventsAfter(ctx, checkpoint); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	m.checkpoints = checkpoints
+	m.head = head
+	m.mu.Unlock()
+
+	for i, process := range m.processes {
+		for _, e := range backlogs[i] {
+			if correlationID, ok := process.Correlate(e); ok {
+				m.enqueue(&delivery{process: process, correlationID: correlationID, event: e})
+			}
+		}
+	}
+
+	go func() {
+		for {
+			m.Drain(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-m.wake:
+			}
+		}
+	}()
+
+	return nil
+}
+
+// RunOnce queues the wake-ups due at now and the deliveries that failed
+// before, runs them, and advances the checkpoints of the processes.
+func (m *Manager) RunOnce(ctx context.Context, now time.Time) error {
+	if len(m.processes) == 0 {
+		return nil
+	}
+
+	var due []*dto.WakeUpDTO
+	var head int64
+	err := m.tx.RWTx(ctx, func(ctx context.Context) error {
+		var err error
+		if due, err = m.store.DueWakeUps(ctx, now); err != nil {
+			return err
+		}
+		head, err = m.eventStore.LastPosition(ctx)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	// A failed wake-up is still due; it is retried with its attempts
+	// counted rather than queued afresh.
+	m.mu.Lock()
+	retried := make(map[string]bool, len(m.failed))
+	for _, d := range m.failed {
+		retried[d.key()] = true
+	}
+	m.queue = append(m.queue, m.failed...)
+	m.failed = nil
+	m.mu.Unlock()
+
+	for _, wakeUp := range due {
+		process := m.process(wakeUp.Process)
+		if process == nil {
+			continue
+		}
+		d := &delivery{
+			process:       process,
+			correlationID: wakeUp.CorrelationID,
+			wakeUp:        &WakeUp{Name: wakeUp.Name, At: wakeUp.At},
+		}
+		if !retried[d.key()] {
+			m.enqueue(d)
+		}
+	}
+
+	m.Drain(ctx)
+	return m.advance(ctx, head)
+}
+
+// advance saves the head found by the previous run as the checkpoint of every
+// process with no event delivery pending, and keeps head for the next run.
+// Events are published as soon as they are committed, so by now every event
+// up to the previous head has been queued, and those of a process with none
+// pending have been delivered.
+func (m *Manager) advance(ctx context.Context, head int64) error {
+	// Holding drainMu keeps a delivery from being under way meanwhile.
+	m.drainMu.Lock()
+	defer m.drainMu.Unlock()
+
+	m.mu.Lock()
+	position := m.head
+	m.head = head
+	pending := make(map[string]bool)
+	for _, deliveries := range [][]*delivery{m.queue, m.failed} {
+		for _, d := range deliveries {
+			if d.event != nil {
+				pending[d.process.Name()] = true
+			}
+		}
+	}
+	var advanced []string
+	for _, process := range m.processes {
+		name := process.Name()
+		if position > m.checkpoints[name] && !pending[name] && !m.stalled[name] {
+			advanced = append(advanced, name)
+		}
+	}
+	m.mu.Unlock()
+
+	if len(advanced) == 0 {
+		return nil
+	}
+
+	err := m.tx.RWTx(ctx, func(ctx context.Context) error {
+		for _, name := range advanced {
+			if err := m.store.SaveCheckpoint(ctx, name, position); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	for _, name := range advanced {
+		m.checkpoints[name] = position
+	}
+	m.mu.Unlock()
+
+	return nil
+}
+
+// Drain runs the queued deliveries, including those queued meanwhile by the
+// events of the commands it issues.
+func (m *Manager) Drain(ctx context.Context) {
+	m.drainMu.Lock()
+	defer m.drainMu.Unlock()
+
+	for {
+		m.mu.Lock()
+		if len(m.queue) == 0 {
+			m.mu.Unlock()
+			return
+		}
+		d := m.queue[0]
+		m.queue = m.queue[1:]
+		m.mu.Unlock()
+
+		if err := m.deliver(ctx, d); err != nil {
+			d.attempts++
+			if d.attempts >= m.maxAttempts {
+				log.Printf("process %s gave up on %s of %s after %d attempts: %v", d.process.Name(), d.trigger(), d.correlationID, d.attempts, err)
+				m.giveUp(ctx, d)
+				continue
+			}
+			log.Printf("process %s failed on %s of %s: %v", d.process.Name(), d.trigger(), d.correlationID, err)
+			m.mu.Lock()
+			m.failed = append(m.failed, d)
+			m.mu.Unlock()
+		}
+	}
+}
+
+// giveUp drops a wake-up that kept failing, so that it is not found due
+// again. An event given up on holds back the checkpoint of its process, so
+// that the next Start delivers it again.
+func (m *Manager) giveUp(ctx context.Context, d *delivery) {
+	if d.wakeUp == nil {
+		m.mu.Lock()
+		m.stalled[d.process.Name()] = true
+		m.mu.Unlock()
+		return
+	}
+
+	err := m.tx.RWTx(ctx, func(ctx context.Context) error {
+		steps, err := m.store.Load(ctx, d.process.Name(), d.correlationID)
+		if err != nil {
+			return err
+		}
+		return m.store.Append(ctx, &dto.ProcessStepDTO{
+			Process:       d.process.Name(),
+			CorrelationID: d.correlationID,
+			Version:       replay(d.correlationID, steps).Version + 1,
+			Trigger:       d.trigger(),
+			Cancel:        []string{d.wakeUp.Name},
+			RecordedAt:    time.Now(),
+		})
+	})
+	if err != nil {
+		log.Printf("process %s failed to drop %s of %s: %v", d.process.Name(), d.trigger(), d.correlationID, err)
+	}
+}
+
+func (m *Manager) enqueue(d *delivery) {
+	m.mu.Lock()
+	m.queue = append(m.queue, d)
+	m.mu.Unlock()
+
+	select {
+	case m.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (m *Manager) process(name string) Process {
+	for _, process := range m.processes {
+		if process.Name() == name {
+			return process
+		}
+	}
+	return nil
+}
+
+func (m *Manager) deliver(ctx context.Context, d *delivery) error {
+	var state *State
+	err := m.tx.RWTx(ctx, func(ctx context.Context) error {
+		steps, err := m.store.Load(ctx, d.process.Name(), d.correlationID)
+		if err != nil {
+			return err
+		}
+		state = replay(d.correlationID, steps)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	trigger := d.trigger()
+	if state.Done || state.handled[trigger] {
+		return nil
+	}
+
+	var decision *Decision
+	if d.wakeUp != nil {
+		// A wake-up that was cancelled or rescheduled since it was found
+		// due is stale.
+		if at, ok := state.WakeUps[d.wakeUp.Name]; !ok || !at.Equal(d.wakeUp.At) {
+			return nil
+		}
+		decision, err = d.process.WakeUp(ctx, state, *d.wakeUp)
+	} else {
+		decision, err = d.process.Handle(ctx, state, d.event)
+	}
+	if err != nil {
+		return err
+	}
+	if decision == nil {
+		decision = &Decision{}
+	}
+
+	for i, command := range decision.Commands {
+		key := fmt.Sprintf("%s/%s/%d", d.correlationID, trigger, i)
+		if err := command(commandbus.WithIdempotencyKey(ctx, "process:"+d.process.Name(), key)); err != nil {
+			return err
+		}
+	}
+
+	step := &dto.ProcessStepDTO{
+		Process:       d.process.Name(),
+		CorrelationID: d.correlationID,
+		Version:       state.Version + 1,
+		Trigger:       trigger,
+		Set:           decision.Set,
+		Cancel:        decision.Cancel,
+		Done:          decision.Done,
+		RecordedAt:    time.Now(),
+	}
+	if d.wakeUp != nil {
+		// A wake-up fires once, unless the step schedules it again.
+		step.Cancel = append([]string{d.wakeUp.Name}, step.Cancel...)
+	}
+	for _, wakeUp := range decision.Schedule {
+		step.Schedule = append(step.Schedule, dto.WakeUpDTO{
+			Process:       d.process.Name(),
+			CorrelationID: d.correlationID,
+			Name:          wakeUp.Name,
+			// Stores keep wake-up times to the microsecond; a due wake-up
+			// must match the one in the state exactly.
+			At: wakeUp.At.UTC().Truncate(time.Microsecond),
+		})
+	}
+
+	return m.tx.RWTx(ctx, func(ctx context.Context) error {
+		return m.store.Append(ctx, step)
+	})
+}
@@ -0,0 +1,271 @@
+package processmanager_test
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/processstore/dto"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/processmanager"
+)
+
+type fakeTransaction struct{}
+
+func (fakeTransaction) RWTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (fakeTransaction) AfterCommit(ctx context.Context, fn func() error) error {
+	return nil
+}
+
+func (fakeTransaction) InTx(ctx context.Context) bool {
+	return false
+}
+
+type memoryEventStore struct {
+	events []event.Event
+}
+
+func (m *memoryEventStore) SaveEvents(ctx context.Context, aggregateID uuid.UUID, events []event.Event) error {
+	return nil
+}
+
+func (m *memoryEventStore) LoadEvents(ctx context.Context, aggregateID uuid.UUID) ([]event.Event, error) {
+	return nil, nil
+}
+
+func (m *memoryEventStore) GetAllEvents(ctx context.Context) ([]event.Event, error) {
+	return m.events, nil
+}
+
+func (m *memoryEventStore) GetEventsAfter(ctx context.Context, position int64) ([]event.Event, error) {
+	return m.events[min(position, int64(len(m.events))):], nil
+}
+
+func (m *memoryEventStore) LastPosition(ctx context.Context) (int64, error) {
+	return int64(len(m.events)), nil
+}
+
+type recordingSubscriber struct {
+	handlers []func(context.Context, event.Event) error
+}
+
+func (r *recordingSubscriber) Subscribe(handler func(context.Context, event.Event) error) {
+	r.handlers = append(r.handlers, handler)
+}
+
+type memoryProcessStore struct {
+	steps       map[string][]*dto.ProcessStepDTO
+	wakeUps     map[string]map[string]*dto.WakeUpDTO
+	checkpoints map[string]int64
+}
+
+func newMemoryProcessStore() *memoryProcessStore {
+	return &memoryProcessStore{
+		steps:       map[string][]*dto.ProcessStepDTO{},
+		wakeUps:     map[string]map[string]*dto.WakeUpDTO{},
+		checkpoints: map[string]int64{},
+	}
+}
+
+func (m *memoryProcessStore) Load(ctx context.Context, process, correlationID string) ([]*dto.ProcessStepDTO, error) {
+	return m.steps[process+"/"+correlationID], nil
+}
+
+func (m *memoryProcessStore) Append(ctx context.Context, step *dto.ProcessStepDTO) error {
+	key := step.Process + "/" + step.CorrelationID
+	m.steps[key] = append(m.steps[key], step)
+	if m.wakeUps[key] == nil {
+		m.wakeUps[key] = map[string]*dto.WakeUpDTO{}
+	}
+	for _, name := range step.Cancel {
+		delete(m.wakeUps[key], name)
+	}
+	for _, wakeUp := range step.Schedule {
+		m.wakeUps[key][wakeUp.Name] = &wakeUp
+	}
+	if step.Done {
+		clear(m.wakeUps[key])
+	}
+	return nil
+}
+
+func (m *memoryProcessStore) DueWakeUps(ctx context.Context, now time.Time) ([]*dto.WakeUpDTO, error) {
+	var due []*dto.WakeUpDTO
+	for _, wakeUps := range m.wakeUps {
+		for wakeUp := range maps.Values(wakeUps) {
+			if !wakeUp.At.After(now) {
+				due = append(due, wakeUp)
+			}
+		}
+	}
+	return due, nil
+}
+
+func (m *memoryProcessStore) Checkpoint(ctx context.Context, process string) (int64, error) {
+	return m.checkpoints[process], nil
+}
+
+func (m *memoryProcessStore) SaveCheckpoint(ctx context.Context, process string, position int64) error {
+	m.checkpoints[process] = position
+	return nil
+}
+
+// reminderProcess reminds the owner of a new list once a day later, unless
+// the list got a todo by then.
+type reminderProcess struct {
+	reminded      []string
+	failures      int
+	eventFailures int
+}
+
+func (p *reminderProcess) Name() string {
+	return "reminder"
+}
+
+func (p *reminderProcess) Correlate(e event.Event) (string, bool) {
+	switch e.(type) {
+	case event.TodoListCreatedEvent, event.TodoAddedEvent:
+		return e.GetAggregateID().String(), true
+	}
+	return "", false
+}
+
+func (p *reminderProcess) Handle(ctx context.Context, state *processmanager.State, e event.Event) (*processmanager.Decision, error) {
+	if p.eventFailures > 0 {
+		p.eventFailures--
+		return nil, errors.New("process state unavailable")
+	}
+	switch e := e.(type) {
+	case event.TodoListCreatedEvent:
+		return &processmanager.Decision{
+			Set:      map[string]string{"owner": e.UserID.String()},
+			Schedule: []processmanager.WakeUp{{Name: "remind", At: e.Timestamp.Add(24 * time.Hour)}},
+		}, nil
+	case event.TodoAddedEvent:
+		return &processmanager.Decision{Done: true}, nil
+	}
+	return nil, nil
+}
+
+func (p *reminderProcess) WakeUp(ctx context.Context, state *processmanager.State, wakeUp processmanager.WakeUp) (*processmanager.Decision, error) {
+	owner := state.Values["owner"]
+	return &processmanager.Decision{
+		Commands: []processmanager.Command{func(ctx context.Context) error {
+			if p.failures > 0 {
+				p.failures--
+				return errors.New("notification service unavailable")
+			}
+			p.reminded = append(p.reminded, owner)
+			return nil
+		}},
+	}, nil
+}
+
+func TestManager(t *testing.T) {
+	created := time.Date(2025, 6, 1, 9, 0, 0, 0, time.UTC)
+	listID := uuid.New()
+	listCreated := event.TodoListCreatedEvent{AggregateID: listID, UserID: "user123", EventID: uuid.New(), Timestamp: created, Version: 1}
+	todoAdded := event.TodoAddedEvent{AggregateID: listID, UserID: "user123", EventID: uuid.New(), Timestamp: created.Add(time.Hour), Version: 2}
+
+	tests := map[string]struct {
+		stored         []event.Event
+		checkpoint     int64
+		published      []event.Event
+		failures       int
+		eventFailures  int
+		checks         []time.Time
+		wantReminded   []string
+		wantSteps      int
+		wantCheckpoint int64
+	}{
+		"fires a wake-up once it is due": {
+			published:    []event.Event{listCreated},
+			checks:       []time.Time{created.Add(time.Hour), created.Add(24 * time.Hour), created.Add(48 * time.Hour)},
+			wantReminded: []string{"user123"},
+			wantSteps:    2,
+		},
+		"catches up on stored events and ignores redelivered ones": {
+			stored:         []event.Event{listCreated},
+			published:      []event.Event{listCreated},
+			checks:         []time.Time{created.Add(24 * time.Hour)},
+			wantReminded:   []string{"user123"},
+			wantSteps:      2,
+			wantCheckpoint: 1,
+		},
+		"skips the stored events up to its checkpoint": {
+			stored:         []event.Event{listCreated, todoAdded},
+			checkpoint:     1,
+			checks:         []time.Time{created.Add(24 * time.Hour)},
+			wantSteps:      1,
+			wantCheckpoint: 2,
+		},
+		"advances its checkpoint once a failed event is delivered": {
+			stored:         []event.Event{listCreated},
+			eventFailures:  1,
+			checks:         []time.Time{created.Add(time.Hour)},
+			wantSteps:      1,
+			wantCheckpoint: 1,
+		},
+		"holds its checkpoint back after giving up on an event": {
+			stored:        []event.Event{listCreated},
+			eventFailures: 3,
+			checks:        []time.Time{created.Add(time.Hour), created.Add(2 * time.Hour), created.Add(3 * time.Hour)},
+		},
+		"drops the wake-ups of a completed instance": {
+			published: []event.Event{listCreated, todoAdded},
+			checks:    []time.Time{created.Add(24 * time.Hour)},
+			wantSteps: 2,
+		},
+		"retries a failed wake-up at the next check": {
+			published:    []event.Event{listCreated},
+			failures:     1,
+			checks:       []time.Time{created.Add(24 * time.Hour), created.Add(25 * time.Hour)},
+			wantReminded: []string{"user123"},
+			wantSteps:    2,
+		},
+		"gives up after the last attempt": {
+			published: []event.Event{listCreated},
+			failures:  3,
+			checks:    []time.Time{created.Add(24 * time.Hour), created.Add(25 * time.Hour), created.Add(26 * time.Hour), created.Add(27 * time.Hour)},
+			wantSteps: 2,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			process := &reminderProcess{failures: tt.failures, eventFailures: tt.eventFailures}
+			store := newMemoryProcessStore()
+			store.checkpoints["reminder"] = tt.checkpoint
+			manager := processmanager.NewManager(store, fakeTransaction{}, &memoryEventStore{events: tt.stored}, 3, process)
+			bus := &recordingSubscriber{}
+			require.NoError(t, manager.Start(ctx, bus))
+			cancel()
+
+			// Act
+			for _, e := range tt.published {
+				for _, handler := range bus.handlers {
+					require.NoError(t, handler(ctx, e))
+				}
+			}
+			manager.Drain(context.Background())
+			for _, now := range tt.checks {
+				require.NoError(t, manager.RunOnce(context.Background(), now))
+			}
+
+			// Assert
+			require.Equal(t, tt.wantReminded, process.reminded)
+			require.Len(t, store.steps["reminder/"+listID.String()], tt.wantSteps)
+			require.Equal(t, tt.wantCheckpoint, store.checkpoints["reminder"])
+		})
+	}
+}
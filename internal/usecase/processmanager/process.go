@@ -0,0 +1,91 @@
+package processmanager
+
+import (
+	"context"
+	"maps"
+	"time"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/processstore/dto"
+)
+
+// Process is a workflow that reacts to domain events by issuing commands,
+// possibly after waiting for a while. The Manager runs one instance of it per
+// correlation ID, and hands each instance the State rebuilt from the steps it
+// recorded so far.
+type Process interface {
+	// Name identifies the process. It scopes the process's instances and the
+	// idempotency keys of the commands it issues, so it must not change.
+	Name() string
+	// Correlate returns the instance e is for, or false if the process does
+	// not react to e.
+	Correlate(e event.Event) (string, bool)
+	// Handle decides how an instance reacts to e.
+	Handle(ctx context.Context, state *State, e event.Event) (*Decision, error)
+	// WakeUp decides how an instance reacts to a wake-up it scheduled. A
+	// timeout is a wake-up that is cancelled once what it waits for happens.
+	WakeUp(ctx context.Context, state *State, wakeUp WakeUp) (*Decision, error)
+}
+
+// Command issues one command, usually by running a command use case with
+// ctx. ctx carries an idempotency key of its own, so a command issued again
+// when a step is redelivered is not run twice.
+type Command func(ctx context.Context) error
+
+type WakeUp struct {
+	Name string
+	At   time.Time
+}
+
+// Decision is how an instance reacts to an event or a wake-up. The zero
+// Decision only records that the trigger was handled.
+type Decision struct {
+	// Commands run in order before the step is recorded.
+	Commands []Command
+	// Set stores values in the instance's state.
+	Set map[string]string
+	// Schedule schedules wake-ups, replacing pending ones with the same name.
+	Schedule []WakeUp
+	// Cancel drops pending wake-ups by name.
+	Cancel []string
+	// Done completes the instance: its pending wake-ups are dropped and later
+	// events are ignored.
+	Done bool
+}
+
+// State is what an instance recorded so far.
+type State struct {
+	CorrelationID string
+	Version       int
+	Values        map[string]string
+	// WakeUps are the pending wake-ups by name.
+	WakeUps map[string]time.Time
+	Done    bool
+
+	handled map[string]bool
+}
+
+func replay(correlationID string, steps []*dto.ProcessStepDTO) *State {
+	state := &State{
+		CorrelationID: correlationID,
+		Values:        make(map[string]string),
+		WakeUps:       make(map[string]time.Time),
+		handled:       make(map[string]bool),
+	}
+	for _, step := range steps {
+		state.Version = step.Version
+		state.handled[step.Trigger] = true
+		maps.Copy(state.Values, step.Set)
+		for _, name := range step.Cancel {
+			delete(state.WakeUps, name)
+		}
+		for _, wakeUp := range step.Schedule {
+			state.WakeUps[wakeUp.Name] = wakeUp.At
+		}
+		if step.Done {
+			state.Done = true
+			clear(state.WakeUps)
+		}
+	}
+	return state
+}
@@ -0,0 +1,170 @@
+package processmanager
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"slices"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/gateway"
+)
+
+const (
+	ownerKey           = "owner"
+	recurringKeyPrefix = "recurring:"
+)
+
+// rejectedCodes are the errors for which the list will not take an
+// occurrence however often it is retried.
+var rejectedCodes = []errors.ErrCode{
+	errors.InvalidParameter,
+	errors.UnpermittedOp,
+	errors.Forbidden,
+	errors.NotFound,
+	errors.Archived,
+	errors.Deleted,
+}
+
+// recurringTemplate is a recurring todo as the process keeps it in its state.
+type recurringTemplate struct {
+	Text      string `json:"text"`
+	Rule      string `json:"rule"`
+	StartDate string `json:"start_date"`
+	Priority  string `json:"priority"`
+}
+
+// RecurringTodoProcess adds the occurrences of the recurring todos of a list
+// through the regular add-todo use case, on behalf of the list owner. It runs
+// one instance per list and keeps one wake-up per recurring todo, at midnight
+// UTC of its next occurrence.
+//
+// An occurrence is only added on its own day: days missed while the
+// application was not running are not filled in. The aggregate ignores an
+// occurrence it already has, so a wake-up delivered again never adds a
+// duplicate.
+type RecurringTodoProcess struct {
+	addTodo command.TodoAddItemCommandInterface
+	clock   gateway.Clock
+}
+
+func NewRecurringTodoProcess(addTodo command.TodoAddItemCommandInterface, clock gateway.Clock) *RecurringTodoProcess {
+	return &RecurringTodoProcess{
+		addTodo: addTodo,
+		clock:   clock,
+	}
+}
+
+func (p *RecurringTodoProcess) Name() string {
+	return "recurring-todo"
+}
+
+func (p *RecurringTodoProcess) Correlate(e event.Event) (string, bool) {
+	switch e.(type) {
+	case event.TodoListCreatedEvent, event.RecurringTodoScheduledEvent, event.RecurringTodoCancelledEvent, event.TodoListDeletedEvent:
+		return e.GetAggregateID().String(), true
+	}
+	return "", false
+}
+
+func (p *RecurringTodoProcess) Handle(ctx context.Context, state *State, e event.Event) (*Decision, error) {
+	switch e := e.(type) {
+	case event.TodoListCreatedEvent:
+		return &Decision{Set: map[string]string{ownerKey: e.UserID.String()}}, nil
+	case event.RecurringTodoScheduledEvent:
+		template, err := json.Marshal(recurringTemplate{
+			Text:      e.TodoText.String(),
+			Rule:      e.Rule.String(),
+			StartDate: e.StartDate.String(),
+			Priority:  e.Priority.String(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		decision := &Decision{Set: map[string]string{recurringKeyPrefix + e.RecurrenceID.String(): string(template)}}
+		if next, ok := e.Rule.NextOccurrence(e.StartDate, value.DueDateOf(p.clock.Now())); ok {
+			decision.Schedule = []WakeUp{occurrenceWakeUp(e.RecurrenceID.String(), next)}
+		}
+		return decision, nil
+	case event.RecurringTodoCancelledEvent:
+		recurrenceID := e.RecurrenceID.String()
+		return &Decision{
+			Set:    map[string]string{recurringKeyPrefix + recurrenceID: ""},
+			Cancel: []string{recurrenceID},
+		}, nil
+	case event.TodoListDeletedEvent:
+		return &Decision{Done: true}, nil
+	}
+	return nil, nil
+}
+
+// WakeUp adds the occurrence of the recurring todo named by wakeUp if it is
+// due today, and schedules the next one.
+func (p *RecurringTodoProcess) WakeUp(ctx context.Context, state *State, wakeUp WakeUp) (*Decision, error) {
+	var template recurringTemplate
+	encoded := state.Values[recurringKeyPrefix+wakeUp.Name]
+	if encoded == "" {
+		return nil, nil
+	}
+	if err := json.Unmarshal([]byte(encoded), &template); err != nil {
+		return nil, err
+	}
+
+	day := value.DueDateOf(wakeUp.At)
+	today := value.DueDateOf(p.clock.Now())
+	from := today
+	decision := &Decision{}
+	if day == today {
+		decision.Commands = []Command{p.addOccurrence(state.CorrelationID, state.Values[ownerKey], wakeUp.Name, template, day)}
+		from = value.DueDateOf(wakeUp.At.AddDate(0, 0, 1))
+	}
+
+	rule := value.RecurrenceRule(template.Rule)
+	if next, ok := rule.NextOccurrence(value.DueDate(template.StartDate), from); ok {
+		decision.Schedule = []WakeUp{occurrenceWakeUp(wakeUp.Name, next)}
+	}
+	return decision, nil
+}
+
+// addOccurrence adds the occurrence on day. An occurrence the list rejects,
+// e.g. because it is archived, is skipped; other failures are retried by the
+// manager.
+func (p *RecurringTodoProcess) addOccurrence(listID, ownerID, recurrenceID string, template recurringTemplate, day value.DueDate) Command {
+	return func(ctx context.Context) error {
+		err := p.addTodo.Execute(ctx, &input.AddTodoInput{
+			AggregateID:  listID,
+			UserID:       ownerID,
+			Todo:         template.Text,
+			DueDate:      day.String(),
+			Priority:     template.Priority,
+			RecurrenceID: recurrenceID,
+		}, errorPresenter{})
+		if err != nil && slices.ContainsFunc(rejectedCodes, func(code errors.ErrCode) bool { return errors.IsCode(err, code) }) {
+			log.Printf("recurring todo %s of %s skipped %s: %v", recurrenceID, listID, day, err)
+			return nil
+		}
+		return err
+	}
+}
+
+func occurrenceWakeUp(recurrenceID string, day value.DueDate) WakeUp {
+	// Dates are validated when the recurring todo is scheduled.
+	at, _ := day.Time()
+	return WakeUp{Name: recurrenceID, At: at}
+}
+
+// errorPresenter hands the use case's error back to the process; there is no
+// client to present a success to.
+type errorPresenter struct{}
+
+func (errorPresenter) PresentSuccess(ctx context.Context, aggregateID string, version int, events []event.Event) error {
+	return nil
+}
+
+func (errorPresenter) PresentError(ctx context.Context, err error) error {
+	return err
+}
@@ -0,0 +1,151 @@
+package processmanager_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+	appErrors "github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/processmanager"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+type recordingAddTodo struct {
+	calls []input.AddTodoInput
+	errs  []error
+}
+
+func (r *recordingAddTodo) Execute(ctx context.Context, in *input.AddTodoInput, out presenter.CommandResultPresenter) error {
+	r.calls = append(r.calls, *in)
+	if len(r.errs) > 0 {
+		err := r.errs[0]
+		r.errs = r.errs[1:]
+		if err != nil {
+			return out.PresentError(ctx, err)
+		}
+	}
+	return out.PresentSuccess(ctx, in.AggregateID, 1, nil)
+}
+
+func TestRecurringTodoProcess(t *testing.T) {
+	// A Monday.
+	day := time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+	listID := uuid.New()
+	recurrenceID := uuid.New()
+	listCreated := event.TodoListCreatedEvent{AggregateID: listID, UserID: "owner", EventID: uuid.New(), Timestamp: day, Version: 1}
+	scheduled := event.RecurringTodoScheduledEvent{
+		AggregateID:  listID,
+		UserID:       "editor",
+		RecurrenceID: recurrenceID,
+		TodoText:     "Stand-up",
+		Rule:         "FREQ=DAILY;INTERVAL=1",
+		StartDate:    "2025-03-31",
+		Priority:     "high",
+		EventID:      uuid.New(),
+		Timestamp:    day.Add(8 * time.Hour),
+		Version:      2,
+	}
+	cancelled := event.RecurringTodoCancelledEvent{AggregateID: listID, UserID: "owner", RecurrenceID: recurrenceID, EventID: uuid.New(), Version: 3}
+	deleted := event.TodoListDeletedEvent{AggregateID: listID, UserID: "owner", EventID: uuid.New(), Version: 3}
+
+	occurrence := func(dueDate string) input.AddTodoInput {
+		return input.AddTodoInput{
+			AggregateID:  listID.String(),
+			UserID:       "owner",
+			Todo:         "Stand-up",
+			DueDate:      dueDate,
+			Priority:     "high",
+			RecurrenceID: recurrenceID.String(),
+		}
+	}
+
+	tests := map[string]struct {
+		published []event.Event
+		addErrs   []error
+		checks    []time.Time
+		wantCalls []input.AddTodoInput
+	}{
+		"adds each occurrence on its day on behalf of the owner": {
+			published: []event.Event{listCreated, scheduled},
+			checks:    []time.Time{day.Add(9 * time.Hour), day.Add(33 * time.Hour)},
+			wantCalls: []input.AddTodoInput{occurrence("2025-03-31"), occurrence("2025-04-01")},
+		},
+		"adds nothing before the first occurrence": {
+			published: []event.Event{listCreated, scheduled},
+			checks:    []time.Time{day.Add(-time.Hour)},
+		},
+		"does not fill in missed days": {
+			published: []event.Event{listCreated, scheduled},
+			checks:    []time.Time{day.Add(57 * time.Hour), day.Add(57*time.Hour + time.Minute)},
+			wantCalls: []input.AddTodoInput{occurrence("2025-04-02")},
+		},
+		"stops once the recurring todo is cancelled": {
+			published: []event.Event{listCreated, scheduled, cancelled},
+			checks:    []time.Time{day.Add(9 * time.Hour), day.Add(33 * time.Hour)},
+		},
+		"stops once the list is deleted": {
+			published: []event.Event{listCreated, scheduled, deleted},
+			checks:    []time.Time{day.Add(9 * time.Hour), day.Add(33 * time.Hour)},
+		},
+		"skips an occurrence the list rejects": {
+			published: []event.Event{listCreated, scheduled},
+			addErrs:   []error{appErrors.Archived.New("todo list is archived")},
+			checks:    []time.Time{day.Add(9 * time.Hour), day.Add(10 * time.Hour), day.Add(33 * time.Hour)},
+			wantCalls: []input.AddTodoInput{occurrence("2025-03-31"), occurrence("2025-04-01")},
+		},
+		"retries an occurrence that failed": {
+			published: []event.Event{listCreated, scheduled},
+			addErrs:   []error{errors.New("database unavailable")},
+			checks:    []time.Time{day.Add(9 * time.Hour), day.Add(10 * time.Hour)},
+			wantCalls: []input.AddTodoInput{occurrence("2025-03-31"), occurrence("2025-03-31")},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			clock := &fakeClock{now: scheduled.Timestamp}
+			addTodo := &recordingAddTodo{errs: tt.addErrs}
+			manager := processmanager.NewManager(newMemoryProcessStore(), fakeTransaction{}, &memoryEventStore{}, 3,
+				processmanager.NewRecurringTodoProcess(addTodo, clock),
+			)
+			bus := &recordingSubscriber{}
+			require.NoError(t, manager.Start(ctx, bus))
+			cancel()
+			for _, e := range tt.published {
+				for _, handler := range bus.handlers {
+					require.NoError(t, handler(ctx, e))
+				}
+			}
+			manager.Drain(context.Background())
+
+			// Act
+			for _, now := range tt.checks {
+				clock.now = now
+				require.NoError(t, manager.RunOnce(context.Background(), now))
+			}
+
+			// Assert
+			if tt.wantCalls == nil {
+				require.Empty(t, addTodo.calls)
+				return
+			}
+			require.Equal(t, tt.wantCalls, addTodo.calls)
+		})
+	}
+}
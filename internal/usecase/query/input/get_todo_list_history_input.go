@@ -0,0 +1,8 @@
+package input
+
+type GetTodoListHistoryInput struct {
+	AggregateID string
+	// UserID is the caller. Only the owner and collaborators may read the
+	// history.
+	UserID string
+}
@@ -1,5 +1,25 @@
 package input
 
+const (
+	TodoFilterOverdue  = "overdue"
+	TodoFilterDueToday = "due_today"
+
+	TodoSortPriority = "priority"
+)
+
 type GetTodoListInput struct {
 	AggregateID string
+	// UserID is the caller. Only the owner and collaborators may read the list.
+	UserID string
+	// MinVersion is the lowest aggregate version the caller is willing to
+	// read. Zero means any projected version is acceptable.
+	MinVersion int
+	// Filter narrows the returned items to TodoFilterOverdue or
+	// TodoFilterDueToday. Empty returns every item.
+	Filter string
+	// Sort orders the items by TodoSortPriority, keeping the stored order
+	// among equal priorities. Empty returns the stored order.
+	Sort string
+	// Tags keeps only the items carrying every one of these tags.
+	Tags []string
 }
@@ -0,0 +1,5 @@
+package input
+
+type ListScheduledCommandsInput struct {
+	UserID string
+}
@@ -0,0 +1,7 @@
+package input
+
+type ListUserTagsInput struct {
+	UserID string
+	// RequesterID is the caller, who may only list their own tags.
+	RequesterID string
+}
@@ -0,0 +1,17 @@
+package input
+
+type ListUserTodoListsInput struct {
+	UserID string
+	// RequesterID is the caller, who may only list their own todo lists.
+	RequesterID string
+	SortBy      string
+	Order       string
+	Limit       int
+	Offset      int
+	// Shared lists the todo lists UserID collaborates on instead of the ones
+	// it owns.
+	Shared bool
+	// IncludeArchived also lists archived todo lists, which are hidden by
+	// default.
+	IncludeArchived bool
+}
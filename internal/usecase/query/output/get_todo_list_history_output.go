@@ -0,0 +1,9 @@
+package output
+
+import "github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+
+type GetTodoListHistoryOutput struct {
+	AggregateID string
+	// Events are the list's events, oldest first.
+	Events []event.Event
+}
@@ -3,12 +3,46 @@ package output
 import "time"
 
 type GetTodoListOutput struct {
-	AggregateID string
-	UserID      string
-	Items       []TodoItem
-	UpdatedAt   time.Time
+	AggregateID    string
+	UserID         string
+	Title          string
+	Description    string
+	Archived       bool
+	Collaborators  []Collaborator
+	Items          []TodoItem
+	RecurringTodos []RecurringTodo
+	Version        int
+	UpdatedAt      time.Time
+}
+
+type Collaborator struct {
+	UserID string
+	Role   string
 }
 
 type TodoItem struct {
-	Text string
+	ID           string
+	Text         string
+	DueDate      string
+	Priority     string
+	Tags         []string
+	Overdue      bool
+	Completed    bool
+	Checklist    []ChecklistItem
+	RecurrenceID string
+}
+
+type ChecklistItem struct {
+	ID        string
+	Text      string
+	Completed bool
+}
+
+type RecurringTodo struct {
+	ID             string
+	Text           string
+	Rule           string
+	StartDate      string
+	Priority       string
+	LastOccurrence string
 }
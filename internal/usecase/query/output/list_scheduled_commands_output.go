@@ -0,0 +1,26 @@
+package output
+
+import "time"
+
+type ListScheduledCommandsOutput struct {
+	UserID   string
+	Commands []ScheduledCommand
+}
+
+type ScheduledCommand struct {
+	ID                string
+	Type              string
+	AggregateID       string
+	Title             string
+	Description       string
+	Todo              string
+	DueDate           string
+	Priority          string
+	RunAt             time.Time
+	Status            string
+	Attempts          int
+	LastError         string
+	ResultAggregateID string
+	ResultVersion     int
+	CreatedAt         time.Time
+}
@@ -0,0 +1,11 @@
+package output
+
+type ListUserTagsOutput struct {
+	UserID string
+	Tags   []TagCount
+}
+
+type TagCount struct {
+	Tag       string
+	TodoCount int
+}
@@ -0,0 +1,22 @@
+package output
+
+import "time"
+
+type ListUserTodoListsOutput struct {
+	UserID    string
+	TodoLists []TodoListSummary
+	Total     int
+	Limit     int
+	Offset    int
+}
+
+type TodoListSummary struct {
+	AggregateID string
+	OwnerID     string
+	Role        string
+	ItemCount   int
+	Archived    bool
+	Version     int
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
@@ -0,0 +1,72 @@
+package query
+
+import (
+	"context"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/schedulestore"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/schedulestore/dto"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/query/input"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/query/output"
+)
+
+type ScheduledCommandsQueryInterface interface {
+	Execute(ctx context.Context, input *input.ListScheduledCommandsInput, out presenter.ScheduledCommandsPresenter) error
+}
+
+type ScheduledCommandsQuery struct {
+	store schedulestore.ScheduledCommandStore
+	tx    repository.Transaction
+}
+
+func NewScheduledCommandsQuery(store schedulestore.ScheduledCommandStore, tx repository.Transaction) ScheduledCommandsQueryInterface {
+	return &ScheduledCommandsQuery{
+		store: store,
+		tx:    tx,
+	}
+}
+
+func (u *ScheduledCommandsQuery) Execute(ctx context.Context, input *input.ListScheduledCommandsInput, out presenter.ScheduledCommandsPresenter) error {
+	userID, err := value.NewUserID(input.UserID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	var cmds []*dto.ScheduledCommandDTO
+	err = u.tx.RWTx(ctx, func(ctx context.Context) error {
+		var err error
+		cmds, err = u.store.ListByUserID(ctx, userID.String())
+		return err
+	})
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	commands := make([]output.ScheduledCommand, 0, len(cmds))
+	for _, cmd := range cmds {
+		commands = append(commands, output.ScheduledCommand{
+			ID:                cmd.ID,
+			Type:              cmd.Type,
+			AggregateID:       cmd.AggregateID,
+			Title:             cmd.Title,
+			Description:       cmd.Description,
+			Todo:              cmd.Todo,
+			DueDate:           cmd.DueDate,
+			Priority:          cmd.Priority,
+			RunAt:             cmd.RunAt,
+			Status:            cmd.Status,
+			Attempts:          cmd.Attempts,
+			LastError:         cmd.LastError,
+			ResultAggregateID: cmd.ResultAggregateID,
+			ResultVersion:     cmd.ResultVersion,
+			CreatedAt:         cmd.CreatedAt,
+		})
+	}
+
+	return out.Present(ctx, &output.ListScheduledCommandsOutput{
+		UserID:   userID.String(),
+		Commands: commands,
+	})
+}
@@ -0,0 +1,67 @@
+package query
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/readmodelstore"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/query/input"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/query/output"
+)
+
+type TodoListHistoryQueryInterface interface {
+	Execute(ctx context.Context, input *input.GetTodoListHistoryInput, out presenter.TodoListHistoryPresenter) error
+}
+
+// TodoListHistoryQuery returns the events of a list from the event store.
+// Who may read them is decided by the read model, as for the list itself.
+type TodoListHistoryQuery struct {
+	store      readmodelstore.TodoListStore
+	eventStore repository.EventStore
+	tx         repository.Transaction
+}
+
+func NewTodoListHistoryQuery(store readmodelstore.TodoListStore, eventStore repository.EventStore, tx repository.Transaction) TodoListHistoryQueryInterface {
+	return &TodoListHistoryQuery{
+		store:      store,
+		eventStore: eventStore,
+		tx:         tx,
+	}
+}
+
+func (u *TodoListHistoryQuery) Execute(ctx context.Context, input *input.GetTodoListHistoryInput, out presenter.TodoListHistoryPresenter) error {
+	aggregateID, err := uuid.Parse(input.AggregateID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "aggregate_id must be a valid UUID"))
+	}
+
+	view, err := u.store.Get(ctx, aggregateID.String())
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+	if !view.IsMember(input.UserID) {
+		return out.PresentError(ctx, ErrTodoListAccessDenied)
+	}
+	if view.Deleted {
+		return out.PresentError(ctx, ErrTodoListGone)
+	}
+
+	var events []event.Event
+	err = u.tx.RWTx(ctx, func(ctx context.Context) error {
+		var err error
+		events, err = u.eventStore.LoadEvents(ctx, aggregateID)
+		return err
+	})
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.Present(ctx, &output.GetTodoListHistoryOutput{
+		AggregateID: aggregateID.String(),
+		Events:      events,
+	})
+}
@@ -0,0 +1,162 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/projector/todo"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/readmodelstore/dto"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/query"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/query/input"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/query/output"
+)
+
+type recordingHistoryPresenter struct {
+	presented *output.GetTodoListHistoryOutput
+}
+
+func (p *recordingHistoryPresenter) Present(ctx context.Context, out *output.GetTodoListHistoryOutput) error {
+	p.presented = out
+	return nil
+}
+
+func (p *recordingHistoryPresenter) PresentError(ctx context.Context, err error) error {
+	return err
+}
+
+// memoryEventStore keeps events per aggregate.
+type memoryEventStore struct {
+	events map[uuid.UUID][]event.Event
+}
+
+func (s *memoryEventStore) SaveEvents(ctx context.Context, aggregateID uuid.UUID, events []event.Event) error {
+	s.events[aggregateID] = append(s.events[aggregateID], events...)
+	return nil
+}
+
+func (s *memoryEventStore) LoadEvents(ctx context.Context, aggregateID uuid.UUID) ([]event.Event, error) {
+	return s.events[aggregateID], nil
+}
+
+func (s *memoryEventStore) GetAllEvents(ctx context.Context) ([]event.Event, error) {
+	var all []event.Event
+	for _, events := range s.events {
+		all = append(all, events...)
+	}
+	return all, nil
+}
+
+func (s *memoryEventStore) GetEventsAfter(ctx context.Context, position int64) ([]event.Event, error) {
+	return nil, nil
+}
+
+func (s *memoryEventStore) LastPosition(ctx context.Context) (int64, error) {
+	return 0, nil
+}
+
+type noopTransaction struct{}
+
+func (noopTransaction) RWTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (noopTransaction) AfterCommit(ctx context.Context, fn func() error) error {
+	return nil
+}
+
+func (noopTransaction) InTx(ctx context.Context) bool {
+	return false
+}
+
+func TestTodoListHistoryQuery_Execute(t *testing.T) {
+	aggregateID := uuid.MustParse("550e8400-e29b-41d4-a716-446655440000")
+
+	tests := map[string]struct {
+		aggregateID string
+		callerID    string
+		deleted     bool
+		wantCode    errors.ErrCode
+		wantError   error
+	}{
+		"owner reads the history": {
+			aggregateID: aggregateID.String(),
+			callerID:    "user123",
+		},
+		"collaborator reads the history": {
+			aggregateID: aggregateID.String(),
+			callerID:    "alice",
+		},
+		"stranger is forbidden": {
+			aggregateID: aggregateID.String(),
+			callerID:    "mallory",
+			wantError:   query.ErrTodoListAccessDenied,
+		},
+		"deleted list is gone": {
+			aggregateID: aggregateID.String(),
+			callerID:    "user123",
+			deleted:     true,
+			wantError:   query.ErrTodoListGone,
+		},
+		"invalid id is rejected": {
+			aggregateID: "not-a-uuid",
+			callerID:    "user123",
+			wantCode:    errors.InvalidParameter,
+		},
+		"unknown list is not found": {
+			aggregateID: uuid.New().String(),
+			callerID:    "user123",
+			wantCode:    errors.NotFound,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctx := context.Background()
+			store := todo.NewInMemoryTodoListViewRepository()
+			require.NoError(t, store.Upsert(ctx, aggregateID.String(), &dto.TodoListViewDTO{
+				AggregateID:   aggregateID.String(),
+				UserID:        "user123",
+				Collaborators: []dto.CollaboratorViewDTO{{UserID: "alice", Role: "viewer"}},
+				Deleted:       tt.deleted,
+				Version:       2,
+			}))
+			eventStore := &memoryEventStore{events: map[uuid.UUID][]event.Event{
+				aggregateID: {
+					event.TodoListCreatedEvent{AggregateID: aggregateID, UserID: "user123", EventID: uuid.New(), Version: 1},
+					event.TodoAddedEvent{AggregateID: aggregateID, UserID: "user123", EventID: uuid.New(), TodoText: "Buy milk", Version: 2},
+				},
+			}}
+			uc := query.NewTodoListHistoryQuery(store, eventStore, noopTransaction{})
+			presenter := &recordingHistoryPresenter{}
+
+			// Act
+			err := uc.Execute(ctx, &input.GetTodoListHistoryInput{
+				AggregateID: tt.aggregateID,
+				UserID:      tt.callerID,
+			}, presenter)
+
+			// Assert
+			if tt.wantError != nil {
+				require.ErrorIs(t, err, tt.wantError)
+				require.Nil(t, presenter.presented)
+				return
+			}
+			if tt.wantCode != "" {
+				require.True(t, errors.IsCode(err, tt.wantCode))
+				require.Nil(t, presenter.presented)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, aggregateID.String(), presenter.presented.AggregateID)
+			require.Len(t, presenter.presented.Events, 2)
+			require.Equal(t, 2, presenter.presented.Events[1].GetVersion())
+		})
+	}
+}
@@ -2,46 +2,241 @@ package query
 
 import (
 	"context"
+	"slices"
+	"time"
 
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/presenter"
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/readmodelstore"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/readmodelstore/dto"
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/query/input"
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/query/output"
 )
 
+const minVersionPollInterval = 10 * time.Millisecond
+
+var (
+	ErrTodoListAccessDenied = errors.Forbidden.New("you do not have access to this todo list")
+	ErrTodoListGone         = errors.Deleted.New("todo list has been deleted")
+	ErrInvalidTodoFilter    = errors.InvalidParameter.New("filter must be overdue or due_today")
+	ErrInvalidTodoSort      = errors.InvalidParameter.New("sort must be priority")
+)
+
 type TodoListQueryInterface interface {
 	Execute(ctx context.Context, input *input.GetTodoListInput, out presenter.TodoListPresenter) error
 }
 
 type TodoListQuery struct {
-	store readmodelstore.TodoListStore
+	store             readmodelstore.TodoListStore
+	minVersionTimeout time.Duration
 }
 
-func NewTodoListQuery(store readmodelstore.TodoListStore) TodoListQueryInterface {
+func NewTodoListQuery(store readmodelstore.TodoListStore, minVersionTimeout time.Duration) TodoListQueryInterface {
 	return &TodoListQuery{
-		store: store,
+		store:             store,
+		minVersionTimeout: minVersionTimeout,
 	}
 }
 
 func (u *TodoListQuery) Execute(ctx context.Context, input *input.GetTodoListInput, out presenter.TodoListPresenter) error {
-	view, err := u.store.Get(ctx, input.AggregateID)
+	if err := validateFilter(input.Filter); err != nil {
+		return out.PresentError(ctx, err)
+	}
+	if err := validateSort(input.Sort); err != nil {
+		return out.PresentError(ctx, err)
+	}
+	tags, err := parseTags(input.Tags)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	view, err := u.waitForVersion(ctx, input.AggregateID, input.MinVersion)
 	if err != nil {
+		if input.MinVersion > 0 && errors.IsCode(err, errors.NotFound) {
+			return out.PresentStale(ctx, &output.GetTodoListOutput{
+				AggregateID: input.AggregateID,
+				Items:       []output.TodoItem{},
+			})
+		}
 		return out.PresentNotFound(ctx, err)
 	}
 
+	if !view.IsMember(input.UserID) {
+		return out.PresentError(ctx, ErrTodoListAccessDenied)
+	}
+	if view.Deleted {
+		return out.PresentError(ctx, ErrTodoListGone)
+	}
+
+	outputData := toOutput(view, input.Filter, tags, time.Now())
+	if input.Sort != "" {
+		sortByPriority(outputData.Items)
+	}
+	if view.Version < input.MinVersion {
+		return out.PresentStale(ctx, outputData)
+	}
+
+	return out.Present(ctx, outputData)
+}
+
+// waitForVersion reads the projection until it has caught up with minVersion
+// or minVersionTimeout elapses, returning the latest view it saw.
+func (u *TodoListQuery) waitForVersion(ctx context.Context, aggregateID string, minVersion int) (*dto.TodoListViewDTO, error) {
+	view, err := u.store.Get(ctx, aggregateID)
+	if minVersion <= 0 || u.caughtUp(view, err, minVersion) {
+		return view, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, u.minVersionTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(minVersionPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return view, err
+		case <-ticker.C:
+			view, err = u.store.Get(ctx, aggregateID)
+			if u.caughtUp(view, err, minVersion) {
+				return view, err
+			}
+		}
+	}
+}
+
+func (u *TodoListQuery) caughtUp(view *dto.TodoListViewDTO, err error, minVersion int) bool {
+	if err != nil {
+		return !errors.IsCode(err, errors.NotFound)
+	}
+	return view.Version >= minVersion
+}
+
+func validateFilter(filter string) error {
+	switch filter {
+	case "", input.TodoFilterOverdue, input.TodoFilterDueToday:
+		return nil
+	default:
+		return ErrInvalidTodoFilter
+	}
+}
+
+func validateSort(sort string) error {
+	switch sort {
+	case "", input.TodoSortPriority:
+		return nil
+	default:
+		return ErrInvalidTodoSort
+	}
+}
+
+func parseTags(raw []string) ([]string, error) {
+	tags := make([]string, 0, len(raw))
+	for _, r := range raw {
+		tag, err := value.NewTag(r)
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, tag.String())
+	}
+	return tags, nil
+}
+
+// toOutput maps the view to the query output, keeping only the items that
+// pass filter and carry every tag in tags. Overdue is judged against now rather than the projected flag,
+// so a read does not depend on the scheduler having run.
+func toOutput(view *dto.TodoListViewDTO, filter string, tags []string, now time.Time) *output.GetTodoListOutput {
 	items := make([]output.TodoItem, 0, len(view.Items))
 	for _, item := range view.Items {
+		dueDate := value.DueDate(item.DueDate)
+		overdue := !item.Completed && dueDate.IsOverdue(now)
+
+		switch {
+		case filter == input.TodoFilterOverdue && !overdue:
+			continue
+		case filter == input.TodoFilterDueToday && !dueDate.IsDueOn(now):
+			continue
+		case !hasAllTags(item.Tags, tags):
+			continue
+		}
+
 		items = append(items, output.TodoItem{
-			Text: item.Text,
+			ID:           item.ID,
+			Text:         item.Text,
+			DueDate:      item.DueDate,
+			Priority:     value.Priority(item.Priority).OrDefault().String(),
+			Tags:         append([]string{}, item.Tags...),
+			Completed:    item.Completed,
+			Checklist:    toChecklistOutput(item.Checklist),
+			Overdue:      overdue,
+			RecurrenceID: item.RecurrenceID,
 		})
 	}
 
-	outputData := &output.GetTodoListOutput{
-		AggregateID: view.AggregateID,
-		UserID:      view.UserID,
-		Items:       items,
-		UpdatedAt:   view.UpdatedAt,
+	collaborators := make([]output.Collaborator, 0, len(view.Collaborators))
+	for _, c := range view.Collaborators {
+		collaborators = append(collaborators, output.Collaborator{
+			UserID: c.UserID,
+			Role:   c.Role,
+		})
 	}
 
-	return out.Present(ctx, outputData)
+	return &output.GetTodoListOutput{
+		AggregateID:    view.AggregateID,
+		UserID:         view.UserID,
+		Title:          view.Title,
+		Description:    view.Description,
+		Archived:       view.Archived,
+		Collaborators:  collaborators,
+		Items:          items,
+		RecurringTodos: toRecurringOutput(view.RecurringTodos),
+		Version:        view.Version,
+		UpdatedAt:      view.UpdatedAt,
+	}
+}
+
+func toRecurringOutput(recurring []dto.RecurringTodoViewDTO) []output.RecurringTodo {
+	todos := make([]output.RecurringTodo, 0, len(recurring))
+	for _, r := range recurring {
+		todos = append(todos, output.RecurringTodo{
+			ID:             r.ID,
+			Text:           r.Text,
+			Rule:           r.Rule,
+			StartDate:      r.StartDate,
+			Priority:       r.Priority,
+			LastOccurrence: r.LastOccurrence,
+		})
+	}
+	return todos
+}
+
+func toChecklistOutput(checklist []dto.ChecklistItemViewDTO) []output.ChecklistItem {
+	items := make([]output.ChecklistItem, 0, len(checklist))
+	for _, c := range checklist {
+		items = append(items, output.ChecklistItem{
+			ID:        c.ID,
+			Text:      c.Text,
+			Completed: c.Completed,
+		})
+	}
+	return items
+}
+
+func hasAllTags(itemTags, tags []string) bool {
+	for _, tag := range tags {
+		if !slices.Contains(itemTags, tag) {
+			return false
+		}
+	}
+	return true
+}
+
+// sortByPriority orders items from high to low priority. The sort is stable,
+// so the stored order still decides between todos of equal priority.
+func sortByPriority(items []output.TodoItem) {
+	slices.SortStableFunc(items, func(a, b output.TodoItem) int {
+		return value.Priority(a.Priority).Compare(value.Priority(b.Priority))
+	})
 }
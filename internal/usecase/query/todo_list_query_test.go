@@ -0,0 +1,404 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/projector/todo"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/readmodelstore/dto"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/query"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/query/input"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/query/output"
+)
+
+type recordingTodoListPresenter struct {
+	presented *output.GetTodoListOutput
+	stale     bool
+	notFound  bool
+}
+
+func (p *recordingTodoListPresenter) Present(ctx context.Context, out *output.GetTodoListOutput) error {
+	p.presented = out
+	return nil
+}
+
+func (p *recordingTodoListPresenter) PresentStale(ctx context.Context, out *output.GetTodoListOutput) error {
+	p.presented = out
+	p.stale = true
+	return nil
+}
+
+func (p *recordingTodoListPresenter) PresentNotFound(ctx context.Context, err error) error {
+	p.notFound = true
+	return nil
+}
+
+func (p *recordingTodoListPresenter) PresentError(ctx context.Context, err error) error {
+	return err
+}
+
+func TestTodoListQuery_Execute_MinVersion(t *testing.T) {
+	const aggregateID = "550e8400-e29b-41d4-a716-446655440000"
+
+	tests := map[string]struct {
+		projectedVersion int
+		catchUpVersion   int
+		minVersion       int
+		wantVersion      int
+		wantStale        bool
+		wantNotFound     bool
+	}{
+		"no min version returns projection as is": {
+			projectedVersion: 1,
+			wantVersion:      1,
+		},
+		"projection already at min version": {
+			projectedVersion: 2,
+			minVersion:       2,
+			wantVersion:      2,
+		},
+		"waits for projection to catch up": {
+			projectedVersion: 1,
+			catchUpVersion:   3,
+			minVersion:       3,
+			wantVersion:      3,
+		},
+		"reports stale when projection never catches up": {
+			projectedVersion: 1,
+			minVersion:       5,
+			wantVersion:      1,
+			wantStale:        true,
+		},
+		"reports stale when list is not projected yet": {
+			minVersion: 1,
+			wantStale:  true,
+		},
+		"reports not found without min version": {
+			wantNotFound: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctx := context.Background()
+			store := todo.NewInMemoryTodoListViewRepository()
+			if tt.projectedVersion > 0 {
+				require.NoError(t, store.Upsert(ctx, aggregateID, &dto.TodoListViewDTO{
+					AggregateID: aggregateID,
+					UserID:      "user123",
+					Version:     tt.projectedVersion,
+				}))
+			}
+			if tt.catchUpVersion > 0 {
+				go func() {
+					time.Sleep(30 * time.Millisecond)
+					_ = store.Upsert(ctx, aggregateID, &dto.TodoListViewDTO{
+						AggregateID: aggregateID,
+						UserID:      "user123",
+						Version:     tt.catchUpVersion,
+					})
+				}()
+			}
+			uc := query.NewTodoListQuery(store, 200*time.Millisecond)
+			presenter := &recordingTodoListPresenter{}
+
+			// Act
+			err := uc.Execute(ctx, &input.GetTodoListInput{
+				AggregateID: aggregateID,
+				UserID:      "user123",
+				MinVersion:  tt.minVersion,
+			}, presenter)
+
+			// Assert
+			require.NoError(t, err)
+			require.Equal(t, tt.wantNotFound, presenter.notFound)
+			require.Equal(t, tt.wantStale, presenter.stale)
+			if !tt.wantNotFound {
+				require.Equal(t, tt.wantVersion, presenter.presented.Version)
+			}
+		})
+	}
+}
+
+func TestTodoListQuery_Execute_Membership(t *testing.T) {
+	const aggregateID = "550e8400-e29b-41d4-a716-446655440000"
+
+	tests := map[string]struct {
+		callerID  string
+		wantError error
+	}{
+		"owner can read": {
+			callerID: "user123",
+		},
+		"collaborator can read": {
+			callerID: "alice",
+		},
+		"stranger is forbidden": {
+			callerID:  "mallory",
+			wantError: query.ErrTodoListAccessDenied,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctx := context.Background()
+			store := todo.NewInMemoryTodoListViewRepository()
+			require.NoError(t, store.Upsert(ctx, aggregateID, &dto.TodoListViewDTO{
+				AggregateID:   aggregateID,
+				UserID:        "user123",
+				Collaborators: []dto.CollaboratorViewDTO{{UserID: "alice", Role: "viewer"}},
+				Version:       2,
+			}))
+			uc := query.NewTodoListQuery(store, 0)
+			presenter := &recordingTodoListPresenter{}
+
+			// Act
+			err := uc.Execute(ctx, &input.GetTodoListInput{
+				AggregateID: aggregateID,
+				UserID:      tt.callerID,
+			}, presenter)
+
+			// Assert
+			if tt.wantError != nil {
+				require.ErrorIs(t, err, tt.wantError)
+				require.Nil(t, presenter.presented)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, presenter.presented.Collaborators, 1)
+		})
+	}
+}
+
+func TestTodoListQuery_Execute_Deleted(t *testing.T) {
+	// Arrange
+	const aggregateID = "550e8400-e29b-41d4-a716-446655440000"
+	ctx := context.Background()
+	store := todo.NewInMemoryTodoListViewRepository()
+	require.NoError(t, store.Upsert(ctx, aggregateID, &dto.TodoListViewDTO{
+		AggregateID: aggregateID,
+		UserID:      "user123",
+		Deleted:     true,
+		Version:     3,
+	}))
+	uc := query.NewTodoListQuery(store, 0)
+	presenter := &recordingTodoListPresenter{}
+
+	// Act
+	err := uc.Execute(ctx, &input.GetTodoListInput{AggregateID: aggregateID, UserID: "user123"}, presenter)
+
+	// Assert
+	require.ErrorIs(t, err, query.ErrTodoListGone)
+	require.Nil(t, presenter.presented)
+	require.False(t, presenter.notFound)
+}
+
+func TestTodoListQuery_Execute_Filter(t *testing.T) {
+	const aggregateID = "550e8400-e29b-41d4-a716-446655440000"
+	today := time.Now().UTC()
+
+	tests := map[string]struct {
+		filter    string
+		wantIDs   []string
+		wantError error
+	}{
+		"no filter returns every item": {
+			wantIDs: []string{"overdue", "today", "later", "undated"},
+		},
+		"overdue": {
+			filter:  input.TodoFilterOverdue,
+			wantIDs: []string{"overdue"},
+		},
+		"due today": {
+			filter:  input.TodoFilterDueToday,
+			wantIDs: []string{"today"},
+		},
+		"unknown filter": {
+			filter:    "someday",
+			wantError: query.ErrInvalidTodoFilter,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctx := context.Background()
+			store := todo.NewInMemoryTodoListViewRepository()
+			require.NoError(t, store.Upsert(ctx, aggregateID, &dto.TodoListViewDTO{
+				AggregateID: aggregateID,
+				UserID:      "user123",
+				Items: []dto.TodoItemViewDTO{
+					{ID: "overdue", DueDate: today.AddDate(0, 0, -1).Format(time.DateOnly)},
+					{ID: "today", DueDate: today.Format(time.DateOnly)},
+					{ID: "later", DueDate: today.AddDate(0, 0, 1).Format(time.DateOnly)},
+					{ID: "undated"},
+				},
+				Version: 5,
+			}))
+			uc := query.NewTodoListQuery(store, 0)
+			presenter := &recordingTodoListPresenter{}
+
+			// Act
+			err := uc.Execute(ctx, &input.GetTodoListInput{
+				AggregateID: aggregateID,
+				UserID:      "user123",
+				Filter:      tt.filter,
+			}, presenter)
+
+			// Assert
+			if tt.wantError != nil {
+				require.ErrorIs(t, err, tt.wantError)
+				return
+			}
+			require.NoError(t, err)
+			got := make([]string, 0, len(presenter.presented.Items))
+			for _, item := range presenter.presented.Items {
+				got = append(got, item.ID)
+			}
+			require.Equal(t, tt.wantIDs, got)
+		})
+	}
+}
+
+func TestTodoListQuery_Execute_Sort(t *testing.T) {
+	const aggregateID = "550e8400-e29b-41d4-a716-446655440000"
+
+	tests := map[string]struct {
+		sort      string
+		wantIDs   []string
+		wantError error
+	}{
+		"stored order by default": {
+			wantIDs: []string{"low", "high-1", "legacy", "high-2"},
+		},
+		"priority keeps stored order among equals": {
+			sort:    input.TodoSortPriority,
+			wantIDs: []string{"high-1", "high-2", "legacy", "low"},
+		},
+		"unknown sort": {
+			sort:      "text",
+			wantError: query.ErrInvalidTodoSort,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctx := context.Background()
+			store := todo.NewInMemoryTodoListViewRepository()
+			require.NoError(t, store.Upsert(ctx, aggregateID, &dto.TodoListViewDTO{
+				AggregateID: aggregateID,
+				UserID:      "user123",
+				Items: []dto.TodoItemViewDTO{
+					{ID: "low", Priority: "low"},
+					{ID: "high-1", Priority: "high"},
+					{ID: "legacy"},
+					{ID: "high-2", Priority: "high"},
+				},
+				Version: 5,
+			}))
+			uc := query.NewTodoListQuery(store, 0)
+			presenter := &recordingTodoListPresenter{}
+
+			// Act
+			err := uc.Execute(ctx, &input.GetTodoListInput{
+				AggregateID: aggregateID,
+				UserID:      "user123",
+				Sort:        tt.sort,
+			}, presenter)
+
+			// Assert
+			if tt.wantError != nil {
+				require.ErrorIs(t, err, tt.wantError)
+				return
+			}
+			require.NoError(t, err)
+			got := make([]string, 0, len(presenter.presented.Items))
+			for _, item := range presenter.presented.Items {
+				got = append(got, item.ID)
+			}
+			require.Equal(t, tt.wantIDs, got)
+			require.Equal(t, "medium", presenter.presented.Items[2].Priority)
+		})
+	}
+}
+
+func TestTodoListQuery_Execute_Tags(t *testing.T) {
+	const aggregateID = "550e8400-e29b-41d4-a716-446655440000"
+
+	tests := map[string]struct {
+		tags      []string
+		wantIDs   []string
+		wantError error
+	}{
+		"no tag returns every item": {
+			wantIDs: []string{"both", "home", "none"},
+		},
+		"single tag": {
+			tags:    []string{"Home"},
+			wantIDs: []string{"both", "home"},
+		},
+		"every tag must match": {
+			tags:    []string{"home", "urgent"},
+			wantIDs: []string{"both"},
+		},
+		"invalid tag": {
+			tags:      []string{"#home"},
+			wantError: value.ErrTagInvalid,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctx := context.Background()
+			store := todo.NewInMemoryTodoListViewRepository()
+			require.NoError(t, store.Upsert(ctx, aggregateID, &dto.TodoListViewDTO{
+				AggregateID: aggregateID,
+				UserID:      "user123",
+				Items: []dto.TodoItemViewDTO{
+					{ID: "both", Tags: []string{"urgent", "home"}},
+					{ID: "home", Tags: []string{"home"}},
+					{ID: "none"},
+				},
+				Version: 5,
+			}))
+			uc := query.NewTodoListQuery(store, 0)
+			presenter := &recordingTodoListPresenter{}
+
+			// Act
+			err := uc.Execute(ctx, &input.GetTodoListInput{
+				AggregateID: aggregateID,
+				UserID:      "user123",
+				Tags:        tt.tags,
+			}, presenter)
+
+			// Assert
+			if tt.wantError != nil {
+				require.ErrorIs(t, err, tt.wantError)
+				return
+			}
+			require.NoError(t, err)
+			got := make([]string, 0, len(presenter.presented.Items))
+			for _, item := range presenter.presented.Items {
+				got = append(got, item.ID)
+			}
+			require.Equal(t, tt.wantIDs, got)
+		})
+	}
+}
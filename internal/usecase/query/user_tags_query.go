@@ -0,0 +1,56 @@
+package query
+
+import (
+	"context"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/readmodelstore"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/query/input"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/query/output"
+)
+
+var ErrUserTagsAccessDenied = errors.Forbidden.New("you can only list your own tags")
+
+type UserTagsQueryInterface interface {
+	Execute(ctx context.Context, input *input.ListUserTagsInput, out presenter.UserTagsPresenter) error
+}
+
+type UserTagsQuery struct {
+	store readmodelstore.TagIndexStore
+}
+
+func NewUserTagsQuery(store readmodelstore.TagIndexStore) UserTagsQueryInterface {
+	return &UserTagsQuery{
+		store: store,
+	}
+}
+
+func (u *UserTagsQuery) Execute(ctx context.Context, input *input.ListUserTagsInput, out presenter.UserTagsPresenter) error {
+	userID, err := value.NewUserID(input.UserID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+	if userID.String() != input.RequesterID {
+		return out.PresentError(ctx, ErrUserTagsAccessDenied)
+	}
+
+	counts, err := u.store.ListByUserID(ctx, userID.String())
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	tags := make([]output.TagCount, 0, len(counts))
+	for _, c := range counts {
+		tags = append(tags, output.TagCount{
+			Tag:       c.Tag,
+			TodoCount: c.TodoCount,
+		})
+	}
+
+	return out.Present(ctx, &output.ListUserTagsOutput{
+		UserID: userID.String(),
+		Tags:   tags,
+	})
+}
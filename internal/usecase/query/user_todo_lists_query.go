@@ -0,0 +1,130 @@
+package query
+
+import (
+	"context"
+
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/readmodelstore"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/readmodelstore/dto"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/query/input"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/query/output"
+)
+
+const (
+	defaultUserTodoListsLimit = 20
+	maxUserTodoListsLimit     = 100
+)
+
+var (
+	ErrInvalidSortBy = errors.InvalidParameter.New("sort must be created_at or updated_at")
+	ErrInvalidOrder  = errors.InvalidParameter.New("order must be asc or desc")
+	ErrInvalidLimit  = errors.InvalidParameter.New("limit must be between 1 and 100")
+	ErrInvalidOffset = errors.InvalidParameter.New("offset cannot be negative")
+
+	ErrUserTodoListsAccessDenied = errors.Forbidden.New("you can only list your own todo lists")
+)
+
+type UserTodoListsQueryInterface interface {
+	Execute(ctx context.Context, input *input.ListUserTodoListsInput, out presenter.UserTodoListsPresenter) error
+}
+
+type UserTodoListsQuery struct {
+	store readmodelstore.UserTodoListStore
+}
+
+func NewUserTodoListsQuery(store readmodelstore.UserTodoListStore) UserTodoListsQueryInterface {
+	return &UserTodoListsQuery{
+		store: store,
+	}
+}
+
+func (u *UserTodoListsQuery) Execute(ctx context.Context, input *input.ListUserTodoListsInput, out presenter.UserTodoListsPresenter) error {
+	q, err := u.buildQuery(input)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+	if q.UserID != input.RequesterID {
+		return out.PresentError(ctx, ErrUserTodoListsAccessDenied)
+	}
+
+	list := u.store.ListByUserID
+	if input.Shared {
+		list = u.store.ListSharedWithUserID
+	}
+
+	page, err := list(ctx, q)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	lists := make([]output.TodoListSummary, 0, len(page.TodoLists))
+	for _, l := range page.TodoLists {
+		lists = append(lists, output.TodoListSummary{
+			AggregateID: l.AggregateID,
+			OwnerID:     l.UserID,
+			Role:        l.RoleOf(q.UserID),
+			ItemCount:   l.ItemCount,
+			Archived:    l.Archived,
+			Version:     l.Version,
+			CreatedAt:   l.CreatedAt,
+			UpdatedAt:   l.UpdatedAt,
+		})
+	}
+
+	return out.Present(ctx, &output.ListUserTodoListsOutput{
+		UserID:    q.UserID,
+		TodoLists: lists,
+		Total:     page.Total,
+		Limit:     q.Limit,
+		Offset:    q.Offset,
+	})
+}
+
+func (u *UserTodoListsQuery) buildQuery(input *input.ListUserTodoListsInput) (dto.UserTodoListQuery, error) {
+	userID, err := value.NewUserID(input.UserID)
+	if err != nil {
+		return dto.UserTodoListQuery{}, err
+	}
+
+	sortBy := input.SortBy
+	switch sortBy {
+	case "":
+		sortBy = dto.SortByCreatedAt
+	case dto.SortByCreatedAt, dto.SortByUpdatedAt:
+	default:
+		return dto.UserTodoListQuery{}, ErrInvalidSortBy
+	}
+
+	var descending bool
+	switch input.Order {
+	case "", "desc":
+		descending = true
+	case "asc":
+		descending = false
+	default:
+		return dto.UserTodoListQuery{}, ErrInvalidOrder
+	}
+
+	limit := input.Limit
+	if limit == 0 {
+		limit = defaultUserTodoListsLimit
+	}
+	if limit < 1 || limit > maxUserTodoListsLimit {
+		return dto.UserTodoListQuery{}, ErrInvalidLimit
+	}
+
+	if input.Offset < 0 {
+		return dto.UserTodoListQuery{}, ErrInvalidOffset
+	}
+
+	return dto.UserTodoListQuery{
+		UserID:          userID.String(),
+		SortBy:          sortBy,
+		Descending:      descending,
+		IncludeArchived: input.IncludeArchived,
+		Limit:           limit,
+		Offset:          input.Offset,
+	}, nil
+}
@@ -0,0 +1,86 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/projector/userlists"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/ports/readmodelstore/dto"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/query"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/query/input"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/usecase/query/output"
+)
+
+type recordingUserTodoListsPresenter struct {
+	presented *output.ListUserTodoListsOutput
+}
+
+func (p *recordingUserTodoListsPresenter) Present(ctx context.Context, out *output.ListUserTodoListsOutput) error {
+	p.presented = out
+	return nil
+}
+
+func (p *recordingUserTodoListsPresenter) PresentError(ctx context.Context, err error) error {
+	return err
+}
+
+func TestUserTodoListsQuery_Execute(t *testing.T) {
+	tests := map[string]struct {
+		input     input.ListUserTodoListsInput
+		wantLists []string
+		wantError error
+	}{
+		"owner lists their own todo lists": {
+			input:     input.ListUserTodoListsInput{UserID: "alice", RequesterID: "alice"},
+			wantLists: []string{"list-a"},
+		},
+		"another user's todo lists are forbidden": {
+			input:     input.ListUserTodoListsInput{UserID: "alice", RequesterID: "mallory"},
+			wantError: query.ErrUserTodoListsAccessDenied,
+		},
+		"collaborator lists the todo lists shared with them": {
+			input:     input.ListUserTodoListsInput{UserID: "bob", RequesterID: "bob", Shared: true},
+			wantLists: []string{"list-a"},
+		},
+		"todo lists shared with another user are forbidden": {
+			input:     input.ListUserTodoListsInput{UserID: "bob", RequesterID: "mallory", Shared: true},
+			wantError: query.ErrUserTodoListsAccessDenied,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctx := context.Background()
+			store := userlists.NewInMemoryUserTodoListRepository()
+			require.NoError(t, store.Upsert(ctx, &dto.UserTodoListDTO{
+				AggregateID:   "list-a",
+				UserID:        "alice",
+				Collaborators: []dto.CollaboratorViewDTO{{UserID: "bob", Role: "viewer"}},
+				CreatedAt:     time.Date(2025, 3, 31, 9, 0, 0, 0, time.UTC),
+			}))
+			uc := query.NewUserTodoListsQuery(store)
+			presenter := &recordingUserTodoListsPresenter{}
+
+			// Act
+			err := uc.Execute(ctx, &tt.input, presenter)
+
+			// Assert
+			if tt.wantError != nil {
+				require.ErrorIs(t, err, tt.wantError)
+				require.Nil(t, presenter.presented)
+				return
+			}
+			require.NoError(t, err)
+			lists := make([]string, 0, len(presenter.presented.TodoLists))
+			for _, l := range presenter.presented.TodoLists {
+				lists = append(lists, l.AggregateID)
+			}
+			require.Equal(t, tt.wantLists, lists)
+		})
+	}
+}
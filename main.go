@@ -2,14 +2,20 @@ package main
 
 import (
 	"context"
+	"expvar"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
 
 	"github.com/tomoki-yamamura/eventsourcing-todo/container"
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/config"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/auth"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/graphqlserver"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/grpcserver"
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/handler/command"
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/handler/query"
+	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/openapi"
 	"github.com/tomoki-yamamura/eventsourcing-todo/internal/infrastructure/router"
 )
 
@@ -37,15 +43,124 @@ func main() {
 		log.Fatalf("Failed to start projector: %v", err)
 	}
 
+	if err := cont.UserTodoListsProjector.Start(ctx, cont.EventBus); err != nil {
+		log.Fatalf("Failed to start user todo lists projector: %v", err)
+	}
+
+	if err := cont.TagIndexProjector.Start(ctx, cont.EventBus); err != nil {
+		log.Fatalf("Failed to start tag index projector: %v", err)
+	}
+
+	// The dispatcher resolves list owners from the read model, so it must
+	// subscribe after the projector.
+	if err := cont.WebhookDispatcher.Start(ctx, cont.EventBus); err != nil {
+		log.Fatalf("Failed to start webhook dispatcher: %v", err)
+	}
+
+	// API streams follow lists from the time they are opened.
+	if err := cont.EventStream.Start(ctx, cont.EventBus); err != nil {
+		log.Fatalf("Failed to start event stream: %v", err)
+	}
+
+	// The schedulers read due dates and recurring todos from the
+	// projection, so they start once the projectors are subscribed.
+	cont.OverdueScheduler.Start(ctx)
+	cont.ScheduledCommandScheduler.Start(ctx)
+
+	// The process manager catches up on events stored while the application
+	// was down, then follows the bus.
+	if err := cont.ProcessManager.Start(ctx, cont.EventBus); err != nil {
+		log.Fatalf("Failed to start process manager: %v", err)
+	}
+	cont.WakeUpScheduler.Start(ctx)
+
+	// Authentication
+	keys, err := auth.NewKeySet(cfg.AuthConfig)
+	if err != nil {
+		log.Fatalf("Failed to load JWT keys: %v", err)
+	}
+	authMiddleware := auth.NewJWTMiddleware(keys, cfg.AuthConfig)
+
+	// Request validation against the OpenAPI document
+	apiDoc, err := openapi.Load(ctx)
+	if err != nil {
+		log.Fatalf("Failed to load OpenAPI document: %v", err)
+	}
+	validationMiddleware := openapi.NewValidationMiddleware(apiDoc)
+
 	// Handler layer setup (CQRS)
 	createCommandHandler := command.NewTodoListCreateCommandHandler(cont.TodoListCreateCommand)
+	renameCommandHandler := command.NewTodoListRenameCommandHandler(cont.TodoListRenameCommand)
+	lifecycleHandler := command.NewTodoListLifecycleCommandHandler(
+		cont.TodoListArchiveCommand,
+		cont.TodoListRestoreCommand,
+		cont.TodoListDeleteCommand,
+	)
+	undoHandler := command.NewTodoListUndoCommandHandler(cont.TodoListUndoCommand)
 	addCommandHandler := command.NewTodoAddItemCommandHandler(cont.TodoAddItemCommand)
+	batchHandler := command.NewTodoBatchCommandHandler(cont.TodoBatchCommand)
+	setDueDateHandler := command.NewTodoSetDueDateCommandHandler(cont.TodoSetDueDateCommand)
+	orderingHandler := command.NewTodoItemOrderingCommandHandler(cont.TodoSetPriorityCommand, cont.TodoReorderItemsCommand)
+	moveHandler := command.NewTodoItemMoveCommandHandler(cont.TodoMoveItemCommand)
+	tagHandler := command.NewTodoItemTagCommandHandler(cont.TodoTagCommand, cont.TodoUntagCommand)
+	completionHandler := command.NewTodoItemCompletionCommandHandler(cont.TodoSetCompletionCommand)
+	checklistHandler := command.NewTodoChecklistCommandHandler(
+		cont.TodoAddChecklistItemCommand,
+		cont.TodoCompleteChecklistItemCommand,
+		cont.TodoRemoveChecklistItemCommand,
+	)
+	recurringHandler := command.NewTodoRecurringCommandHandler(cont.TodoScheduleRecurringCommand, cont.TodoCancelRecurringCommand)
+	collaboratorHandler := command.NewTodoListCollaboratorCommandHandler(
+		cont.TodoListInviteCollaboratorCommand,
+		cont.TodoListChangeCollaboratorRoleCommand,
+		cont.TodoListRemoveCollaboratorCommand,
+	)
+	webhookHandler := command.NewWebhookSubscribeCommandHandler(cont.WebhookSubscribe)
+	scheduledHandler := command.NewScheduledCommandHandler(cont.ScheduledCommandCreateCommand, cont.ScheduledCommandCancelCommand)
 	queryHandler := query.NewTodoListQueryHandler(cont.QueryUseCase)
+	userListsHandler := query.NewUserTodoListsQueryHandler(cont.UserTodoListsQuery)
+	userTagsHandler := query.NewUserTagsQueryHandler(cont.UserTagsQuery)
+	scheduledQueryHandler := query.NewScheduledCommandsQueryHandler(cont.ScheduledCommandsQuery)
+	graphqlHandler, err := graphqlserver.NewHandler(graphqlserver.NewResolver(
+		cont.TodoListCreateCommand,
+		cont.TodoAddItemCommand,
+		cont.QueryUseCase,
+		cont.UserTodoListsQuery,
+		cont.TodoListHistoryQuery,
+		cont.EventStream,
+	))
+	if err != nil {
+		log.Fatalf("Failed to set up GraphQL: %v", err)
+	}
+
+	// Command metrics are served at /debug/vars on the internal debug
+	// listener, not on the API port.
+	expvar.Publish("commands", cont.CommandMetrics)
+	debugMux := http.NewServeMux()
+	debugMux.Handle("GET /debug/vars", expvar.Handler())
+	fmt.Printf("Debug server starting on %s\n", cfg.DebugAddr)
+	go func() {
+		log.Fatal(http.ListenAndServe(cfg.DebugAddr, debugMux))
+	}()
 
 	// Router setup
-	appRouter := router.NewRouter(createCommandHandler, addCommandHandler, queryHandler)
+	appRouter := router.NewRouter(authMiddleware.Handler, validationMiddleware.Handler, createCommandHandler, renameCommandHandler, lifecycleHandler, undoHandler, addCommandHandler, batchHandler, setDueDateHandler, orderingHandler, moveHandler, tagHandler, completionHandler, checklistHandler, recurringHandler, collaboratorHandler, webhookHandler, scheduledHandler, queryHandler, userListsHandler, userTagsHandler, scheduledQueryHandler, graphqlHandler)
 	mux := appRouter.SetupRoutes()
 
+	// gRPC server
+	todoServer := grpcserver.NewTodoServer(cont.TodoListCreateCommand, cont.TodoAddItemCommand, cont.QueryUseCase, cont.EventStream)
+	grpcServer := grpcserver.NewServer(todoServer, authMiddleware)
+
+	grpcPort := ":" + cfg.GRPCPort
+	listener, err := net.Listen("tcp", grpcPort)
+	if err != nil {
+		log.Fatalf("Failed to listen on gRPC port %s: %v", grpcPort, err)
+	}
+	fmt.Printf("gRPC server starting on port %s\n", grpcPort)
+	go func() {
+		log.Fatal(grpcServer.Serve(listener))
+	}()
+
 	// Start server
 	port := ":" + cfg.HTTPPort
 	fmt.Printf("Server starting on port %s\n", port)